@@ -0,0 +1,18 @@
+data "langsmith_alert_rule" "baseline" {
+  session_id = var.project_id
+  name       = "p95 latency baseline"
+}
+
+# A stricter alert that fires at half the baseline's threshold.
+resource "langsmith_alert_rule" "strict" {
+  session_id     = var.project_id
+  name           = "p95 latency strict"
+  description    = "Latency well past the baseline"
+  type           = "threshold"
+  aggregation    = data.langsmith_alert_rule.baseline.aggregation
+  attribute      = data.langsmith_alert_rule.baseline.attribute
+  operator       = data.langsmith_alert_rule.baseline.operator
+  window_minutes = data.langsmith_alert_rule.baseline.window_minutes
+  threshold      = data.langsmith_alert_rule.baseline.threshold * 0.5
+  actions        = data.langsmith_alert_rule.baseline.actions
+}
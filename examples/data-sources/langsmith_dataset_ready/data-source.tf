@@ -0,0 +1,14 @@
+data "langsmith_dataset_ready" "example" {
+  dataset_id   = langsmith_dataset.example.id
+  min_examples = 100
+  timeout      = "15m"
+}
+
+resource "terraform_data" "evaluation" {
+  lifecycle {
+    precondition {
+      condition     = data.langsmith_dataset_ready.example.ready
+      error_message = "The dataset only has ${data.langsmith_dataset_ready.example.example_count} examples."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+# Read back a golden example seeded elsewhere, to assert on its outputs.
+data "langsmith_example" "golden" {
+  id = "9b2d4e6f-1a3c-4e5b-8d7f-0a1b2c3d4e5f"
+}
+
+output "golden_expected_answer" {
+  value = jsondecode(data.langsmith_example.golden.outputs)
+}
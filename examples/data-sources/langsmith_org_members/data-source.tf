@@ -0,0 +1,11 @@
+# Everyone in the organization, for a governance report.
+data "langsmith_org_members" "all" {}
+
+# Just the organization admins.
+data "langsmith_org_members" "admins" {
+  role_id = "00000000-0000-4000-8000-000000000001"
+}
+
+output "org_admin_emails" {
+  value = data.langsmith_org_members.admins.members[*].email
+}
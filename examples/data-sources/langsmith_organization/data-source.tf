@@ -7,3 +7,10 @@ output "org_name" {
 output "org_tier" {
   value = data.langsmith_organization.current.tier
 }
+
+# Only set up SSO where the plan allows it.
+resource "langsmith_sso_settings" "okta" {
+  count = data.langsmith_organization.current.sso_enabled ? 1 : 0
+
+  metadata_url = "https://example.okta.com/app/exk123/sso/saml/metadata"
+}
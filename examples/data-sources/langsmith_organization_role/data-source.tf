@@ -0,0 +1,18 @@
+data "langsmith_organization_role" "catalog" {}
+
+locals {
+  known_permissions = [for p in data.langsmith_organization_role.catalog.permissions : p.permission]
+  wanted            = ["datasets:read", "runs:read"]
+}
+
+resource "langsmith_org_role" "auditor" {
+  display_name = "Auditor"
+  permissions  = jsonencode(local.wanted)
+
+  lifecycle {
+    precondition {
+      condition     = alltrue([for p in local.wanted : contains(local.known_permissions, p)])
+      error_message = "Every permission must appear in the organization's permission catalog."
+    }
+  }
+}
@@ -0,0 +1,9 @@
+data "langsmith_organization_usage" "current" {}
+
+output "traces_this_period" {
+  value = data.langsmith_organization_usage.current.traces_this_period
+}
+
+output "seats_available" {
+  value = data.langsmith_organization_usage.current.seats_available
+}
@@ -0,0 +1,8 @@
+# Look up the shared playground setup by name instead of copying its JSON.
+data "langsmith_playground_settings" "standard" {
+  name = "standard-gpt-4o"
+}
+
+output "standard_playground_settings" {
+  value = jsondecode(data.langsmith_playground_settings.standard.settings)
+}
@@ -0,0 +1,9 @@
+# Every support-bot prompt tagged for production.
+data "langsmith_prompts" "support" {
+  query = "support-bot-"
+  tags  = ["production"]
+}
+
+output "support_prompts" {
+  value = { for p in data.langsmith_prompts.support.prompts : p.full_name => p.last_commit_hash }
+}
@@ -0,0 +1,8 @@
+data "langsmith_run_rule" "errors" {
+  session_id   = var.project_id
+  display_name = "Send errors to review"
+}
+
+output "review_queue_id" {
+  value = data.langsmith_run_rule.errors.add_to_annotation_queue_id
+}
@@ -0,0 +1,8 @@
+data "langsmith_run_rules" "active" {
+  session_id = var.project_id
+  is_enabled = true
+}
+
+output "rules_feeding_datasets" {
+  value = [for rule in data.langsmith_run_rules.active.rules : rule.display_name if rule.add_to_dataset_id != null]
+}
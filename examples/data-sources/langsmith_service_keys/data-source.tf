@@ -0,0 +1,8 @@
+# Find the key being rotated out, so it can be retired by ID.
+data "langsmith_service_keys" "old_ci" {
+  description = "ci (2024)"
+}
+
+output "old_ci_key_id" {
+  value = data.langsmith_service_keys.old_ci.service_keys[0].id
+}
@@ -0,0 +1,9 @@
+data "langsmith_sso_settings" "current" {}
+
+output "sso_metadata_url" {
+  value = data.langsmith_sso_settings.current.metadata_url
+}
+
+output "sso_default_workspace_ids" {
+  value = data.langsmith_sso_settings.current.default_workspace_ids
+}
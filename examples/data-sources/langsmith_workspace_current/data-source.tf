@@ -0,0 +1,5 @@
+data "langsmith_workspace_current" "this" {}
+
+output "tenant_id" {
+  value = data.langsmith_workspace_current.this.id
+}
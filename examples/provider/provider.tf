@@ -1,5 +1,8 @@
 provider "langsmith" {
   api_key   = var.langsmith_api_key
-  api_url   = "https://api.smith.langchain.com"
+  endpoint  = "https://api.smith.langchain.com"
   tenant_id = var.langsmith_tenant_id # Required for org-scoped API keys
+
+  # Stay under the API rate limit when applying many resources at once.
+  requests_per_second = 5
 }
@@ -0,0 +1,21 @@
+resource "langsmith_project" "production" {
+  name = "production"
+}
+
+resource "langsmith_alert_rule" "slow_responses" {
+  session_id     = langsmith_project.production.id
+  name           = "slow-responses"
+  description    = "Average latency over five seconds"
+  type           = "threshold"
+  aggregation    = "avg"
+  attribute      = "latency"
+  operator       = "gte"
+  window_minutes = 5
+  threshold      = 5000
+
+  # Moving the alert to another project replaces it. Create the new alert
+  # before destroying the old one so the project is never left unwatched.
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -3,3 +3,28 @@ resource "langsmith_annotation_queue" "example" {
   description            = "Queue for human review of LLM outputs"
   num_reviewers_per_item = 2
 }
+
+resource "langsmith_annotation_queue" "graded" {
+  name                = "graded-review"
+  reservation_minutes = 15
+  rubric_instructions = "Judge each answer against the reference."
+
+  rubric_item {
+    feedback_key = "verdict"
+    type         = "categorical"
+    instructions = "Is the answer right?"
+    categories   = ["correct", "partially correct", "incorrect"]
+  }
+
+  rubric_item {
+    feedback_key = "helpfulness"
+    type         = "continuous"
+    min          = 0
+    max          = 5
+  }
+
+  rubric_item {
+    feedback_key = "notes"
+    type         = "freeform"
+  }
+}
@@ -0,0 +1,16 @@
+resource "langsmith_annotation_queue" "review" {
+  name = "weekly-review"
+}
+
+# Queue a handful of runs, each with its own reviewer.
+variable "review_assignments" {
+  type = map(string) # run ID => reviewer user ID
+}
+
+resource "langsmith_annotation_queue_run" "assigned" {
+  for_each = var.review_assignments
+
+  queue_id         = langsmith_annotation_queue.review.id
+  run_id           = each.key
+  assignee_user_id = each.value
+}
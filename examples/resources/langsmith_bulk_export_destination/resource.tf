@@ -7,3 +7,19 @@ resource "langsmith_bulk_export_destination" "example" {
   access_key_id     = var.aws_access_key_id
   secret_access_key = var.aws_secret_access_key
 }
+
+resource "langsmith_bulk_export_destination" "gcs" {
+  display_name         = "my-gcs-export"
+  destination_type     = "gcs"
+  bucket_name          = "my-langsmith-exports"
+  prefix               = "traces/"
+  service_account_json = file("${path.module}/service-account.json")
+}
+
+resource "langsmith_bulk_export_destination" "azure" {
+  display_name     = "my-azure-export"
+  destination_type = "azure"
+  account_name     = "mylangsmithexports"
+  container        = "traces"
+  account_key      = var.azure_storage_account_key
+}
@@ -0,0 +1,10 @@
+# The same latency chart for every project in the outfit.
+resource "langsmith_chart" "latency" {
+  for_each = toset(var.project_ids)
+
+  session_id  = each.value
+  metric      = "latency"
+  aggregation = "avg"
+  filter      = "eq(is_root, true)"
+  group_by    = "name"
+}
@@ -0,0 +1,8 @@
+resource "langsmith_comparison" "example" {
+  name                 = "prompt v1 vs. v2"
+  reference_dataset_id = langsmith_dataset.example.id
+  experiment_ids = [
+    langsmith_project.experiment_v1.id,
+    langsmith_project.experiment_v2.id,
+  ]
+}
@@ -0,0 +1,17 @@
+resource "langsmith_dashboard" "example" {
+  title       = "Chatbot health"
+  description = "Latency and error rate for the production chatbot."
+
+  charts = jsonencode([
+    {
+      title      = "Latency"
+      chart_type = "line"
+      series     = [{ name = "p50", metric = "latency_p50", project_id = langsmith_project.example.id }]
+    },
+    {
+      title      = "Error rate"
+      chart_type = "line"
+      series     = [{ name = "errors", metric = "error_rate", project_id = langsmith_project.example.id }]
+    },
+  ])
+}
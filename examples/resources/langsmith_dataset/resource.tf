@@ -3,3 +3,7 @@ resource "langsmith_dataset" "example" {
   description = "A dataset for evaluation"
   data_type   = "kv"
 }
+
+# Existing datasets can be imported by ID or by name:
+#
+#   terraform import langsmith_dataset.example name:my-dataset
@@ -0,0 +1,12 @@
+resource "langsmith_dataset_examples" "fixtures" {
+  dataset_id = langsmith_dataset.example.id
+
+  examples = [
+    for case in jsondecode(file("${path.module}/fixtures.json")) : {
+      key     = case.name
+      inputs  = jsonencode(case.inputs)
+      outputs = jsonencode(case.outputs)
+      split   = "test"
+    }
+  ]
+}
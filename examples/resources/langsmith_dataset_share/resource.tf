@@ -0,0 +1,13 @@
+resource "langsmith_dataset" "benchmark" {
+  name        = "public-benchmark"
+  description = "Evaluation set we publish alongside our results"
+}
+
+# Anyone with the link can view the dataset until this is destroyed.
+resource "langsmith_dataset_share" "benchmark" {
+  dataset_id = langsmith_dataset.benchmark.id
+}
+
+output "benchmark_url" {
+  value = langsmith_dataset_share.benchmark.public_url
+}
@@ -0,0 +1,17 @@
+resource "langsmith_dataset" "golden" {
+  name = "golden-dataset"
+}
+
+resource "langsmith_dataset_splits" "golden" {
+  dataset_id = langsmith_dataset.golden.id
+  splits     = ["train", "test", "validation"]
+}
+
+resource "langsmith_example" "refund" {
+  dataset_id = langsmith_dataset.golden.id
+  inputs     = jsonencode({ question = "Can I get a refund?" })
+  outputs    = jsonencode({ answer = "Within 30 days of purchase." })
+  split      = "test"
+
+  depends_on = [langsmith_dataset_splits.golden]
+}
@@ -0,0 +1,5 @@
+resource "langsmith_dataset_tag" "prod" {
+  dataset_id = langsmith_dataset.example.id
+  tag_name   = "prod"
+  as_of      = "2025-06-01T00:00:00Z"
+}
@@ -3,3 +3,16 @@ resource "langsmith_example" "example" {
   inputs     = jsonencode({ question = "What is LangSmith?" })
   outputs    = jsonencode({ answer = "LangSmith is an LLM observability platform." })
 }
+
+resource "langsmith_example" "with_attachments" {
+  dataset_id = langsmith_dataset.example.id
+  inputs     = jsonencode({ question = "Whose brand is on this steer?" })
+
+  attachments = [
+    {
+      name      = "brand"
+      mime_type = "image/png"
+      file_path = "${path.module}/brand.png"
+    },
+  ]
+}
@@ -0,0 +1,16 @@
+variable "golden_run_id" {
+  type        = string
+  description = "The ID of a run with a known-good answer."
+}
+
+# A golden score for an evaluation pipeline to measure against.
+resource "langsmith_feedback" "golden" {
+  run_id  = var.golden_run_id
+  key     = "correctness"
+  score   = 1
+  comment = "Matches the reference answer"
+
+  correction = jsonencode({
+    answer = "Dodge City, Kansas"
+  })
+}
@@ -4,3 +4,18 @@ resource "langsmith_feedback_config" "example" {
   min           = 0
   max           = 1
 }
+
+resource "langsmith_feedback_config" "helpfulness" {
+  feedback_key  = "helpfulness"
+  feedback_type = "categorical"
+
+  category {
+    value = 1
+    label = "helpful"
+  }
+
+  category {
+    value = 0
+    label = "unhelpful"
+  }
+}
@@ -0,0 +1,20 @@
+resource "langsmith_project" "production" {
+  name = "production"
+}
+
+resource "langsmith_filter_view" "errors" {
+  session_id  = langsmith_project.production.id
+  name        = "errors"
+  description = "Runs that ended in an error"
+  filter      = "eq(status, \"error\")"
+}
+
+resource "langsmith_filter_view" "slow_traces" {
+  session_id = langsmith_project.production.id
+  name       = "slow traces"
+  filter     = "and(eq(is_root, true), gt(latency, 10))"
+
+  display_settings = jsonencode({
+    columns = ["name", "latency", "total_tokens"]
+  })
+}
@@ -5,3 +5,19 @@ resource "langsmith_model_price_map" "example" {
   completion_cost = 0.00001
   model_provider  = "openai"
 }
+
+# A price change from a given date. Giving it its own resource keeps the
+# earlier price in place for runs before start_time.
+resource "langsmith_model_price_map" "price_cut" {
+  name            = "gpt-4o"
+  match_pattern   = "gpt-4o.*"
+  prompt_cost     = 0.000002
+  completion_cost = 0.000008
+  model_provider  = "openai"
+  start_time      = "2025-09-01T00:00:00Z"
+}
+
+# Existing entries can be imported by ID or by name. When several entries
+# share the name, the one with the newest start_time is imported:
+#
+#   terraform import langsmith_model_price_map.example name:gpt-4o
@@ -0,0 +1,17 @@
+resource "langsmith_model_price_map_history" "gpt_4o" {
+  name           = "gpt-4o"
+  match_pattern  = "gpt-4o.*"
+  model_provider = "openai"
+
+  versions = [
+    {
+      prompt_cost     = 0.000005
+      completion_cost = 0.000015
+    },
+    {
+      start_time      = "2024-08-06T00:00:00Z"
+      prompt_cost     = 0.0000025
+      completion_cost = 0.00001
+    },
+  ]
+}
@@ -0,0 +1,13 @@
+# The langsmith_organization_role data source lists the permissions that can
+# be granted; anything not in it fails at plan time.
+resource "langsmith_org_role" "reviewer" {
+  display_name = "Reviewer"
+  description  = "Reads projects and datasets, and annotates runs"
+
+  permission = [
+    "projects:read",
+    "datasets:read",
+    "annotation-queues:read",
+    "annotation-queues:update",
+  ]
+}
@@ -0,0 +1,14 @@
+resource "langsmith_organization_invite" "example" {
+  email            = "new.hire@example.com"
+  role_id          = var.org_user_role_id
+  retain_on_accept = true
+}
+
+# Once the invite is accepted, the new member's user_id can be fed into a
+# workspace membership.
+resource "langsmith_workspace_member" "example" {
+  count = langsmith_organization_invite.example.status == "accepted" ? 1 : 0
+
+  user_id = langsmith_organization_invite.example.user_id
+  role_id = var.workspace_viewer_role_id
+}
@@ -0,0 +1,6 @@
+# Only SSO sign-ins, and only from company addresses. Set up and test
+# langsmith_sso_settings before turning enforce_sso on.
+resource "langsmith_organization_settings" "this" {
+  enforce_sso     = true
+  allowed_domains = ["example.com", "example.co.uk"]
+}
@@ -2,3 +2,15 @@ resource "langsmith_project" "example" {
   name        = "my-project"
   description = "A project for tracing LLM runs"
 }
+
+# Standard tags applied across several projects.
+locals {
+  standard_tags = ["team-ml", "production"]
+}
+
+resource "langsmith_project" "services" {
+  for_each = toset(["chatbot", "summarizer"])
+
+  name = each.key
+  tags = concat(local.standard_tags, [each.key])
+}
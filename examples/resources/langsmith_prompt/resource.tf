@@ -3,3 +3,12 @@ resource "langsmith_prompt" "example" {
   is_public   = false
   description = "A reusable prompt template"
 }
+
+# Making an existing private prompt public takes allow_publish as well, so
+# internal prompts don't get published by a stray is_public = true.
+resource "langsmith_prompt" "shared" {
+  repo_handle   = "shared-greeter"
+  is_public     = true
+  allow_publish = true
+  description   = "A prompt shared with the world"
+}
@@ -0,0 +1,12 @@
+resource "langsmith_prompt_tag" "production" {
+  repo_handle = langsmith_prompt.example.repo_handle
+  tag_name    = "production"
+  commit_hash = "a1b2c3d4"
+}
+
+# Keep staging on the newest commit; each apply after a new commit moves it up.
+resource "langsmith_prompt_tag" "staging" {
+  repo_handle = langsmith_prompt.example.repo_handle
+  tag_name    = "staging"
+  commit_hash = "latest"
+}
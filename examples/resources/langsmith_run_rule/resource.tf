@@ -4,3 +4,39 @@ resource "langsmith_run_rule" "example" {
   session_id    = langsmith_project.example.id
   is_enabled    = true
 }
+
+resource "langsmith_run_rule" "graded" {
+  display_name  = "grade-answers"
+  sampling_rate = 0.25
+  session_id    = langsmith_project.example.id
+
+  evaluator {
+    evaluator_type = "structured"
+    model          = jsonencode({ model = "gpt-4o", temperature = 0 })
+    prompt         = jsonencode([["system", "Grade the answer for correctness."], ["human", "{input}\n\n{output}"]])
+    variable_mapping = {
+      input  = "input"
+      output = "output"
+    }
+  }
+
+  webhook {
+    url = "https://hooks.example.com/langsmith/runs"
+    headers = {
+      X-Source = "langsmith"
+    }
+  }
+}
+
+# Grade past runs too. Apply waits until the backfill finishes; moving
+# backfill_from later on runs it again from the new date.
+resource "langsmith_run_rule" "graded_history" {
+  display_name  = "grade-history"
+  sampling_rate = 1
+  session_id    = langsmith_project.example.id
+
+  backfill_from     = "2025-01-01T00:00:00Z"
+  trigger_backfill  = true
+  wait_for_backfill = true
+  backfill_timeout  = "1h"
+}
@@ -0,0 +1,10 @@
+# Provider keys for the playground and LLM-as-judge evaluators.
+resource "langsmith_secret" "openai" {
+  key   = "OPENAI_API_KEY"
+  value = var.openai_api_key
+}
+
+resource "langsmith_secret" "anthropic" {
+  key   = "ANTHROPIC_API_KEY"
+  value = var.anthropic_api_key
+}
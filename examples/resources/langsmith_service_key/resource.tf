@@ -2,3 +2,36 @@ resource "langsmith_service_key" "example" {
   description = "API key for CI/CD pipeline"
   read_only   = false
 }
+
+# A key rotated every 90 days without an outage: the new key is created, and
+# everything that uses it updated, before the old one is revoked ten minutes
+# later.
+resource "time_rotating" "ci_key" {
+  rotation_days = 90
+}
+
+resource "langsmith_service_key" "rotating" {
+  description         = "API key for CI/CD pipeline"
+  revoke_grace_period = "10m"
+
+  keepers = {
+    rotation = time_rotating.ci_key.id
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+# Flag a key that's due to expire within two weeks.
+resource "langsmith_service_key" "expiring" {
+  description = "API key for the quarterly audit"
+  expires_at  = "2026-12-31T00:00:00Z"
+}
+
+check "service_key_expiry" {
+  assert {
+    condition     = langsmith_service_key.expiring.days_until_expiry == null || langsmith_service_key.expiring.days_until_expiry > 14
+    error_message = "The audit service key expires in ${langsmith_service_key.expiring.days_until_expiry} days; rotate it."
+  }
+}
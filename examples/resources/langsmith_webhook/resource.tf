@@ -1,4 +1,16 @@
 resource "langsmith_webhook" "example" {
   url      = "https://example.com/webhook"
-  triggers = ["on_commit"]
+  triggers = ["commit"]
+}
+
+# Sign payloads so the receiver can check they came from LangSmith.
+variable "webhook_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "langsmith_webhook" "signed" {
+  url      = "https://example.com/webhook"
+  triggers = ["commit"]
+  secret   = var.webhook_secret
 }
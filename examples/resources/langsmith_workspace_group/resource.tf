@@ -0,0 +1,10 @@
+# Give the whole support team read access, however many hands it takes.
+resource "langsmith_workspace_group" "support" {
+  name     = "support"
+  role_id  = var.workspace_viewer_role_id
+  user_ids = toset(var.support_user_ids)
+}
+
+# Adopt everyone who already holds a role:
+#
+#   terraform import langsmith_workspace_group.support <role_id>/support
@@ -0,0 +1,10 @@
+# Add an organization member to the workspace by email -- no need to hunt
+# down their user ID.
+resource "langsmith_workspace_member" "example" {
+  email   = "kitty@example.com"
+  role_id = var.workspace_viewer_role_id
+}
+
+# Existing members can be imported by member ID or by email:
+#
+#   terraform import langsmith_workspace_member.example kitty@example.com
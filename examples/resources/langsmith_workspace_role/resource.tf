@@ -0,0 +1,15 @@
+resource "langsmith_workspace_role" "annotator" {
+  display_name = "Annotator"
+  description  = "Reviews runs in annotation queues"
+
+  permissions = [
+    "projects:read",
+    "annotation-queues:read",
+    "annotation-queues:update",
+  ]
+}
+
+resource "langsmith_workspace_member" "reviewer" {
+  email   = "festus@dodgecity.example"
+  role_id = langsmith_workspace_role.annotator.id
+}
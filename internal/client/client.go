@@ -6,14 +6,115 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
+const (
+	// DefaultMaxRetries is how many times a transient failure gets another
+	// go before the client gives up the chase.
+	DefaultMaxRetries = 4
+
+	// DefaultRetryMaxBackoff caps the wait between retries.
+	DefaultRetryMaxBackoff = 30 * time.Second
+
+	// retryBaseBackoff is the first wait; each further attempt doubles it.
+	retryBaseBackoff = 500 * time.Millisecond
+
+	// DefaultRequestTimeout bounds a single HTTP round trip, from dialing
+	// to reading the last byte of the response.
+	DefaultRequestTimeout = 120 * time.Second
+
+	// DefaultUserAgent identifies requests from a client the provider hasn't
+	// put its own name on.
+	DefaultUserAgent = "terraform-provider-langsmith"
+
+	// maxErrorBodyLength caps how much of an unexplained error body makes it
+	// into an error message.
+	maxErrorBodyLength = 1024
+
+	// DefaultPageSize is the limit requested per page when walking a list
+	// endpoint with GetAllPages.
+	DefaultPageSize = 100
+
+	// maxIdleConnsPerHost is how many kept-alive connections to the API wait
+	// around between requests. Terraform runs ten operations at once by
+	// default, well past net/http's two, and every request over the limit
+	// would otherwise dial, handshake, and hang up on its own.
+	maxIdleConnsPerHost = 32
+
+	// idleConnTimeout closes a kept-alive connection before the load
+	// balancers in front of the API are likely to, so a request isn't sent
+	// down a connection the other end has already dropped.
+	idleConnTimeout = 30 * time.Second
+)
+
+// ErrStopPaging can be returned from a GetAllPages callback to end the walk
+// early once it has found what it came for. GetAllPages treats it as success.
+var ErrStopPaging = errors.New("stop paging")
+
+// tenantIDKey is the context key WithTenantID files its workspace under.
+type tenantIDKey struct{}
+
+// WithTenantID returns a context whose requests go to the given workspace
+// instead of the client's own TenantID, so one client can ride herd on
+// several workspaces. An empty tenantID leaves the client's TenantID in
+// charge.
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+// tenantID picks the workspace a request goes to: the one WithTenantID put
+// on ctx, or else the client's own.
+func (c *Client) tenantID(ctx context.Context) string {
+	if v, ok := ctx.Value(tenantIDKey{}).(string); ok && v != "" {
+		return v
+	}
+	return c.TenantID
+}
+
+// CurrentTenantID reports the workspace requests made with ctx go to: the
+// one set by WithTenantID or the client's TenantID, or failing both, the API
+// key's own workspace, which is looked up once and remembered.
+func (c *Client) CurrentTenantID(ctx context.Context) (string, error) {
+	if tenantID := c.tenantID(ctx); tenantID != "" {
+		return tenantID, nil
+	}
+
+	c.keyTenantMu.Lock()
+	defer c.keyTenantMu.Unlock()
+	if c.keyTenantID != "" {
+		return c.keyTenantID, nil
+	}
+
+	var workspace struct {
+		ID string `json:"id"`
+	}
+	if err := c.Get(ctx, "/api/v1/workspaces/current", nil, &workspace); err != nil {
+		return "", fmt.Errorf("looking up the current workspace: %w", err)
+	}
+	if workspace.ID == "" {
+		return "", fmt.Errorf("looking up the current workspace: no workspace ID in the response")
+	}
+	c.keyTenantID = workspace.ID
+	return c.keyTenantID, nil
+}
+
 // Client is the LangSmith API client — the trusty horse that carries every
 // request across the wire to the LangSmith frontier.
 type Client struct {
@@ -21,73 +122,355 @@ type Client struct {
 	APIKey     string
 	TenantID   string
 	HTTPClient *http.Client
+
+	// MaxRetries is the number of times a request is retried after a 429,
+	// 502, 503, 504, or network error. Zero disables retries.
+	MaxRetries int
+
+	// RetryMaxBackoff caps the exponential backoff between retries,
+	// including any wait requested through a Retry-After header.
+	RetryMaxBackoff time.Duration
+
+	// UserAgent is sent on every request, so the provider's traffic can be
+	// told apart from SDK traffic in LangSmith's access logs.
+	UserAgent string
+
+	// IdempotencyKeys, when set, sends an Idempotency-Key header with every
+	// Create, so a create the API already carried out before the connection
+	// dropped isn't carried out twice when it's retried.
+	IdempotencyKeys bool
+
+	// OrganizationID, when set, is sent as X-Organization-Id on every
+	// request, so the org-level endpoints under /orgs/current answer for
+	// that organization instead of the API key's default one.
+	OrganizationID string
+
+	// DefaultHeaders are sent on every request, e.g. for a proxy in front of
+	// a self-hosted deployment. Reserved headers among them are dropped, so
+	// they can never stand in for the client's own auth.
+	DefaultHeaders http.Header
+
+	// limiter throttles outgoing requests when set through SetRateLimit.
+	// Nil means no limit.
+	limiter *rateLimiter
+
+	// keyTenantID caches the API key's own workspace once CurrentTenantID
+	// has asked for it.
+	keyTenantMu sync.Mutex
+	keyTenantID string
 }
 
 // NewClient saddles up a fresh LangSmith API client with the given base URL,
-// API key, and optional tenant ID.
+// API key, and optional tenant ID. The client is safe for concurrent use, and
+// its connections are pooled and kept alive, so the provider hands the one
+// client to every resource.
 func NewClient(baseURL, apiKey, tenantID string) *Client {
 	return &Client{
 		BaseURL:  baseURL,
 		APIKey:   apiKey,
 		TenantID: tenantID,
 		HTTPClient: &http.Client{
-			Timeout: 120 * time.Second,
+			Timeout:   DefaultRequestTimeout,
+			Transport: newTransport(),
 		},
+		MaxRetries:      DefaultMaxRetries,
+		RetryMaxBackoff: DefaultRetryMaxBackoff,
+		UserAgent:       DefaultUserAgent,
+		IdempotencyKeys: true,
 	}
 }
 
-func (c *Client) doRequest(ctx context.Context, method, path string, query url.Values, body interface{}, result interface{}) error {
-	var bodyReader io.Reader
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
+// reservedHeaders are the headers the client sets itself, which
+// DefaultHeaders may not override, in canonical form.
+var reservedHeaders = map[string]bool{
+	"Authorization":     true,
+	"Cookie":            true,
+	"X-Api-Key":         true,
+	"X-Tenant-Id":       true,
+	"X-Organization-Id": true,
+	"Content-Type":      true,
+	"Accept":            true,
+	"User-Agent":        true,
+	"Idempotency-Key":   true,
+}
+
+// IsReservedHeader reports whether name, in any case, is a header the client
+// sets itself and won't take from DefaultHeaders.
+func IsReservedHeader(name string) bool {
+	return reservedHeaders[http.CanonicalHeaderKey(name)]
+}
+
+// newTransport returns the connection pool a client's requests share: the
+// standard library's defaults, with room for a parallel apply's worth of
+// connections to stay open between requests.
+func newTransport() *http.Transport {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, ForceAttemptHTTP2: true}
+	if base, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = base.Clone()
+	}
+	transport.MaxIdleConns = 100
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.IdleConnTimeout = idleConnTimeout
+	return transport
+}
+
+// ConfigureTLS sets how the client checks the API's certificate. caCertFile,
+// when set, names a PEM bundle whose certificates are trusted alongside the
+// system's own, for deployments signed by an internal CA. insecureSkipVerify
+// turns off certificate checks altogether, which is only fit for testing.
+func (c *Client) ConfigureTLS(caCertFile string, insecureSkipVerify bool) error {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return fmt.Errorf("reading CA bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
 		if err != nil {
-			return fmt.Errorf("marshaling request body: %w", err)
+			pool = x509.NewCertPool()
 		}
-		bodyReader = bytes.NewReader(jsonBody)
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no PEM certificates found in %s", caCertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := newTransport()
+	if c.HTTPClient.Transport != nil {
+		current, ok := c.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			return fmt.Errorf("unexpected transport %T", c.HTTPClient.Transport)
+		}
+		transport = current.Clone()
 	}
+	transport.TLSClientConfig = tlsConfig
+	c.HTTPClient.Transport = transport
+	return nil
+}
 
+// SetRateLimit caps the client at requestsPerSecond requests, retries
+// included, shared by every resource that rides on it. Short bursts of up to
+// one second's worth go out at once; beyond that, requests wait their turn.
+// Zero or less removes the limit.
+func (c *Client) SetRateLimit(requestsPerSecond float64) {
+	if requestsPerSecond <= 0 {
+		c.limiter = nil
+		return
+	}
+	c.limiter = newRateLimiter(requestsPerSecond)
+}
+
+// doRequest sends a request, retrying transient failures. ctx bounds the whole
+// exchange, retries included: once it's cancelled or its deadline passes, the
+// request in flight is abandoned and no further attempts are made. Each
+// attempt is separately bounded by HTTPClient.Timeout.
+func (c *Client) doRequest(ctx context.Context, method, path string, query url.Values, body interface{}, result interface{}) error {
+	jsonBody, err := marshalBody(body)
+	if err != nil {
+		return err
+	}
+
+	return c.doRawRequest(ctx, method, path, query, jsonBody, "application/json", nil, result)
+}
+
+// marshalBody encodes a JSON request body. A nil body stays nil.
+func marshalBody(body interface{}) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling request body: %w", err)
+	}
+	return jsonBody, nil
+}
+
+// doRawRequest is doRequest for a body that's already encoded, sent with the
+// given content type and any extra headers.
+func (c *Client) doRawRequest(ctx context.Context, method, path string, query url.Values, rawBody []byte, contentType string, header http.Header, result interface{}) error {
 	reqURL := c.BaseURL + path
 	if len(query) > 0 {
 		reqURL += "?" + query.Encode()
 	}
 
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		respBody, retryAfter, err := c.send(ctx, method, reqURL, rawBody, contentType, header)
+		if err == nil {
+			if result != nil && len(respBody) > 0 {
+				if err := json.Unmarshal(respBody, result); err != nil {
+					return fmt.Errorf("unmarshaling response: %w", err)
+				}
+			}
+			return nil
+		}
+
+		if attempt >= c.MaxRetries || !isRetryable(ctx, err) {
+			return err
+		}
+
+		// No sense waiting out a backoff the caller's deadline won't see the
+		// end of.
+		wait := c.backoff(attempt, retryAfter)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (last error: %s)", ctx.Err(), err)
+		case <-time.After(wait):
+		}
+	}
+}
+
+// send makes a single round trip. It hands back the response body on success,
+// or an error along with any wait the server asked for via Retry-After.
+func (c *Client) send(ctx context.Context, method, reqURL string, rawBody []byte, contentType string, header http.Header) ([]byte, time.Duration, error) {
+	var bodyReader io.Reader
+	if rawBody != nil {
+		bodyReader = bytes.NewReader(rawBody)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, method, reqURL, bodyReader)
 	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
+		return nil, 0, fmt.Errorf("creating request: %w", err)
 	}
 
+	for k, v := range c.DefaultHeaders {
+		if !IsReservedHeader(k) {
+			req.Header[http.CanonicalHeaderKey(k)] = v
+		}
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
 	req.Header.Set("X-API-Key", c.APIKey)
-	if c.TenantID != "" {
-		req.Header.Set("X-Tenant-Id", c.TenantID)
+	if tenantID := c.tenantID(ctx); tenantID != "" {
+		req.Header.Set("X-Tenant-Id", tenantID)
+	}
+	if c.OrganizationID != "" {
+		req.Header.Set("X-Organization-Id", c.OrganizationID)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("executing request: %w", err)
+		c.logRequest(ctx, req, rawBody, nil, nil, time.Since(start), err)
+		return nil, 0, &networkError{err: err}
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("reading response body: %w", err)
+		c.logRequest(ctx, req, rawBody, nil, nil, time.Since(start), err)
+		return nil, 0, &networkError{err: fmt.Errorf("reading response body: %w", err)}
 	}
+	c.logRequest(ctx, req, rawBody, resp, respBody, time.Since(start), nil)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return &APIError{
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), &APIError{
 			StatusCode: resp.StatusCode,
 			Body:       string(respBody),
+			Message:    errorMessage(respBody),
 		}
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("unmarshaling response: %w", err)
+	return respBody, 0, nil
+}
+
+// backoff works out how long to wait before the next attempt: exponential
+// with jitter, unless the server named its own price with Retry-After.
+// Either way it never exceeds RetryMaxBackoff.
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	maxBackoff := c.RetryMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+
+	if retryAfter > 0 {
+		return min(retryAfter, maxBackoff)
+	}
+
+	wait := retryBaseBackoff << attempt
+	if wait <= 0 || wait > maxBackoff {
+		wait = maxBackoff
+	}
+
+	// Full jitter on the upper half keeps a posse of parallel requests from
+	// all riding back into town at the same moment.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// networkError marks a failure to get an HTTP response at all — a dropped
+// connection, a reset, a timeout on the wire.
+type networkError struct {
+	err error
+}
+
+func (e *networkError) Error() string {
+	return fmt.Sprintf("executing request: %s", e.err)
+}
+
+func (e *networkError) Unwrap() error {
+	return e.err
+}
+
+// isRetryable decides whether a failed request deserves another try. Only
+// clearly transient trouble qualifies: rate limiting, gateway errors, and
+// network failures that weren't caused by the caller giving up.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
 		}
+		return false
 	}
 
-	return nil
+	var netErr *networkError
+	return errors.As(err, &netErr)
+}
+
+// parseRetryAfter reads a Retry-After header in either of its forms —
+// delay-seconds or an HTTP date. Anything unreadable counts as no request.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
 }
 
 // Get rides out with an HTTP GET request and brings back whatever the API has to say.
@@ -95,11 +478,145 @@ func (c *Client) Get(ctx context.Context, path string, query url.Values, result
 	return c.doRequest(ctx, http.MethodGet, path, query, nil, result)
 }
 
+// GetAllPages walks a list endpoint page by page using offset/limit query
+// parameters, handing each page's raw JSON to appendPage. The callback decodes
+// the page into whatever shape the endpoint returns, keeps what it needs, and
+// reports how many items the page held. The ride ends when a page comes back
+// empty, shorter than the limit the server echoes in its envelope, or
+// identical to the last one (an endpoint that ignores paging), or when
+// appendPage returns ErrStopPaging. A short page alone isn't the end of the
+// trail, since some servers cap pages below the limit asked for.
+func (c *Client) GetAllPages(ctx context.Context, path string, query url.Values, appendPage func(page json.RawMessage) (int, error)) error {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("limit", strconv.Itoa(DefaultPageSize))
+
+	var previous json.RawMessage
+	for offset := 0; ; {
+		q.Set("offset", strconv.Itoa(offset))
+
+		var page json.RawMessage
+		if err := c.Get(ctx, path, q, &page); err != nil {
+			return err
+		}
+		if previous != nil && bytes.Equal(page, previous) {
+			return nil
+		}
+
+		n, err := appendPage(page)
+		if errors.Is(err, ErrStopPaging) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("decoding page at offset %d: %w", offset, err)
+		}
+		if n == 0 || n < echoedLimit(page) {
+			return nil
+		}
+
+		previous = page
+		offset += n
+	}
+}
+
+// echoedLimit reads the page size a server reports back in a page envelope
+// such as {"items": [...], "limit": 50}, or 0 when the page doesn't say.
+func echoedLimit(page json.RawMessage) int {
+	var envelope struct {
+		Limit int `json:"limit"`
+	}
+	if json.Unmarshal(page, &envelope) != nil {
+		return 0
+	}
+	return envelope.Limit
+}
+
+// GetAll walks a list endpoint whose pages are JSON arrays of T, as
+// GetAllPages does, and hands back every item it rounded up.
+func GetAll[T any](ctx context.Context, c *Client, path string, query url.Values) ([]T, error) {
+	return GetAllIn(ctx, c, path, query, func(page *[]T) []T { return *page })
+}
+
+// GetAllIn is GetAll for endpoints that wrap each page in an envelope of
+// type P, such as {"members": [...]}; items picks the page's items out of it.
+func GetAllIn[P, T any](ctx context.Context, c *Client, path string, query url.Values, items func(page *P) []T) ([]T, error) {
+	var all []T
+	err := c.GetAllPages(ctx, path, query, func(raw json.RawMessage) (int, error) {
+		var page P
+		if err := json.Unmarshal(raw, &page); err != nil {
+			return 0, err
+		}
+		batch := items(&page)
+		all = append(all, batch...)
+		return len(batch), nil
+	})
+	return all, err
+}
+
 // Post sends an HTTP POST request — staking a new claim on the LangSmith API.
+// Plenty of POSTs aren't creates, and may rightly be sent twice with the same
+// body, so Post carries no idempotency key; creates go through Create.
 func (c *Client) Post(ctx context.Context, path string, body interface{}, result interface{}) error {
 	return c.doRequest(ctx, http.MethodPost, path, nil, body, result)
 }
 
+// Create sends an HTTP POST that brings a new object into being. Unless
+// IdempotencyKeys is off, it carries an Idempotency-Key worked out from the
+// workspace, the path, which names the kind of object, and the body, so the
+// same planned create carries the same key every time it's sent: across the
+// client's own retries, and across an apply run again after a create timed
+// out with its answer lost. The API carries it out only once.
+func (c *Client) Create(ctx context.Context, path string, body interface{}, result interface{}) error {
+	jsonBody, err := marshalBody(body)
+	if err != nil {
+		return err
+	}
+
+	var header http.Header
+	if c.IdempotencyKeys {
+		key, err := idempotencyKey(c.tenantID(ctx), path, jsonBody)
+		if err != nil {
+			return err
+		}
+		header = http.Header{"Idempotency-Key": {key}}
+	}
+	return c.doRawRequest(ctx, http.MethodPost, path, nil, jsonBody, "application/json", header, result)
+}
+
+// idempotencyNamespace is the version 5 UUID namespace idempotency keys are
+// minted in.
+var idempotencyNamespace = [16]byte{0xaa, 0x53, 0x16, 0x2b, 0x28, 0xcf, 0x53, 0xb3, 0xa0, 0x8b, 0x15, 0x1d, 0x86, 0x92, 0x42, 0xe2}
+
+// idempotencyKey returns a version 5 UUID over the workspace, the path, and
+// the body in canonical JSON, with object keys sorted, so the same create
+// always comes out with the same key however its body was put together.
+func idempotencyKey(tenantID, path string, body []byte) (string, error) {
+	var canonical []byte
+	if len(body) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			return "", fmt.Errorf("generating idempotency key: %w", err)
+		}
+		var err error
+		if canonical, err = json.Marshal(v); err != nil {
+			return "", fmt.Errorf("generating idempotency key: %w", err)
+		}
+	}
+
+	h := sha1.New()
+	h.Write(idempotencyNamespace[:])
+	h.Write([]byte(tenantID + "\n" + path + "\n"))
+	h.Write(canonical)
+	b := h.Sum(nil)[:16]
+	b[6] = (b[6] & 0x0f) | 0x50
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
 // PostWithQuery sends an HTTP POST with query parameters riding shotgun.
 func (c *Client) PostWithQuery(ctx context.Context, path string, query url.Values, body interface{}, result interface{}) error {
 	return c.doRequest(ctx, http.MethodPost, path, query, body, result)
@@ -115,6 +632,67 @@ func (c *Client) Put(ctx context.Context, path string, body interface{}, result
 	return c.doRequest(ctx, http.MethodPut, path, nil, body, result)
 }
 
+// MultipartPart is one part of a multipart/form-data request body.
+type MultipartPart struct {
+	// Name is the form field name of the part.
+	Name string
+
+	// Filename, when set, marks the part as a file upload.
+	Filename string
+
+	// ContentType is the part's media type. Empty means application/json.
+	ContentType string
+
+	Content []byte
+}
+
+// PostMultipart sends an HTTP POST with a multipart/form-data body, for the
+// endpoints that take files along with their JSON.
+func (c *Client) PostMultipart(ctx context.Context, path string, parts []MultipartPart, result interface{}) error {
+	return c.doMultipartRequest(ctx, http.MethodPost, path, parts, result)
+}
+
+// PatchMultipart sends an HTTP PATCH with a multipart/form-data body.
+func (c *Client) PatchMultipart(ctx context.Context, path string, parts []MultipartPart, result interface{}) error {
+	return c.doMultipartRequest(ctx, http.MethodPatch, path, parts, result)
+}
+
+// doMultipartRequest encodes the parts once, so every retry sends the same
+// body.
+func (c *Client) doMultipartRequest(ctx context.Context, method, path string, parts []MultipartPart, result interface{}) error {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	for _, part := range parts {
+		contentType := part.ContentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+
+		disposition := fmt.Sprintf("form-data; name=%q", part.Name)
+		if part.Filename != "" {
+			disposition += fmt.Sprintf("; filename=%q", part.Filename)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", disposition)
+		header.Set("Content-Type", contentType)
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return fmt.Errorf("creating multipart part %q: %w", part.Name, err)
+		}
+		if _, err := pw.Write(part.Content); err != nil {
+			return fmt.Errorf("writing multipart part %q: %w", part.Name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("closing multipart body: %w", err)
+	}
+
+	return c.doRawRequest(ctx, method, path, nil, buf.Bytes(), w.FormDataContentType(), nil, result)
+}
+
 // Delete sends an HTTP DELETE request. No trial, no appeal.
 func (c *Client) Delete(ctx context.Context, path string) error {
 	return c.doRequest(ctx, http.MethodDelete, path, nil, nil, nil)
@@ -132,14 +710,70 @@ func (c *Client) DeleteWithBody(ctx context.Context, path string, body interface
 }
 
 // APIError represents trouble from the LangSmith API — the kind Doc Adams
-// would shake his head at. Carries the HTTP status code and raw response body.
+// would shake his head at. Carries the HTTP status code, the raw response
+// body, and the reason the API gave, when it gave one.
 type APIError struct {
 	StatusCode int
 	Body       string
+
+	// Message is the explanation pulled from the body's detail or message
+	// field. Empty when the body doesn't carry one.
+	Message string
 }
 
+// Error reports the API's own explanation when there is one, and otherwise
+// the body, cut short if it runs long.
 func (e *APIError) Error() string {
-	return fmt.Sprintf("LangSmith API error (status %d): %s", e.StatusCode, e.Body)
+	if e.Message != "" {
+		return fmt.Sprintf("LangSmith API error (status %d): %s", e.StatusCode, e.Message)
+	}
+
+	body := e.Body
+	if len(body) > maxErrorBodyLength {
+		body = fmt.Sprintf("%s... (%d more bytes)", body[:maxErrorBodyLength], len(body)-maxErrorBodyLength)
+	}
+	return fmt.Sprintf("LangSmith API error (status %d): %s", e.StatusCode, body)
+}
+
+// errorMessage digs the reason out of an error body. The API answers with
+// {"detail": "..."}, or for a request that fails validation, a detail list
+// of {"loc": [...], "msg": "..."} entries, one per bad field; a few endpoints
+// use {"message": "..."} instead.
+func errorMessage(body []byte) string {
+	var parsed struct {
+		Detail  json.RawMessage `json:"detail"`
+		Message string          `json:"message"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return ""
+	}
+
+	var detail string
+	if err := json.Unmarshal(parsed.Detail, &detail); err == nil && detail != "" {
+		return detail
+	}
+
+	var fields []struct {
+		Loc []interface{} `json:"loc"`
+		Msg string        `json:"msg"`
+	}
+	if err := json.Unmarshal(parsed.Detail, &fields); err == nil && len(fields) > 0 {
+		msgs := make([]string, 0, len(fields))
+		for _, f := range fields {
+			loc := make([]string, 0, len(f.Loc))
+			for _, part := range f.Loc {
+				loc = append(loc, fmt.Sprint(part))
+			}
+			if len(loc) > 0 {
+				msgs = append(msgs, strings.Join(loc, ".")+": "+f.Msg)
+			} else {
+				msgs = append(msgs, f.Msg)
+			}
+		}
+		return strings.Join(msgs, "; ")
+	}
+
+	return parsed.Message
 }
 
 // IsNotFound checks whether the error is a 404 — the resource has skipped town
@@ -150,3 +784,75 @@ func IsNotFound(err error) bool {
 	}
 	return false
 }
+
+// IsConflict checks whether the error is a 409 — somebody already staked that
+// claim, most often because a create already went through.
+func IsConflict(err error) bool {
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.StatusCode == 409
+	}
+	return false
+}
+
+// IsRateLimited checks whether the error is a 429. The client retries those
+// on its own, so one that reaches the caller has outlasted every retry.
+func IsRateLimited(err error) bool {
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.StatusCode == 429
+	}
+	return false
+}
+
+// rateLimiter is a token bucket. Tokens drip in at rate per second up to
+// burst; each request takes one, and waits for it when the bucket runs dry.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a full bucket holding a second's worth of requests,
+// and never less than one.
+func newRateLimiter(rate float64) *rateLimiter {
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{rate: rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// wait takes a token, blocking until one is due or ctx is done. A token
+// claimed for a request that's abandoned is put back.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,917 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// TestClient_retriesTransientErrors sends a request into a server that throws
+// a couple of tantrums before behaving, and expects the client to ride it out.
+func TestClient_retriesTransientErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"id":"abc"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.RetryMaxBackoff = time.Millisecond
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := c.Post(context.Background(), "/things", map[string]string{"name": "x"}, &result); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.ID != "abc" {
+		t.Errorf("expected id abc, got %q", result.ID)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+// TestClient_doesNotRetryClientErrors makes sure a plain bad request is
+// answered once and left alone.
+func TestClient_doesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.RetryMaxBackoff = time.Millisecond
+
+	err := c.Get(context.Background(), "/things", nil, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+// TestClient_givesUpAfterMaxRetries checks the client stops once it's used up
+// its retries and reports the last failure.
+func TestClient_givesUpAfterMaxRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.MaxRetries = 2
+	c.RetryMaxBackoff = time.Millisecond
+
+	err := c.Delete(context.Background(), "/things/1")
+	apiErr, ok := err.(*APIError)
+	if !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected a 503 APIError, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got := parseRetryAfter("7"); got != 7*time.Second {
+		t.Errorf("expected 7s, got %s", got)
+	}
+	if got := parseRetryAfter("soon"); got != 0 {
+		t.Errorf("expected 0 for garbage, got %s", got)
+	}
+	future := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if got := parseRetryAfter(future); got <= 0 || got > time.Hour {
+		t.Errorf("expected a positive wait up to an hour, got %s", got)
+	}
+}
+
+// TestClient_GetAllPages drives a roster of 250 items through 100-item pages
+// and checks every head is counted exactly once.
+func TestClient_GetAllPages(t *testing.T) {
+	const total = 250
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if r.URL.Query().Get("session") != "abc" {
+			t.Errorf("expected caller's query to be preserved, got %q", r.URL.RawQuery)
+		}
+		items := []int{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, i)
+		}
+		_ = json.NewEncoder(w).Encode(items)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	var all []int
+	err := c.GetAllPages(context.Background(), "/items", url.Values{"session": {"abc"}}, func(page json.RawMessage) (int, error) {
+		var batch []int
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return 0, err
+		}
+		all = append(all, batch...)
+		return len(batch), nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(all) != total {
+		t.Fatalf("expected %d items, got %d", total, len(all))
+	}
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("expected item %d at position %d, got %d", i, i, v)
+		}
+	}
+}
+
+// TestClient_GetAll checks the typed helpers gather every item, whether the
+// pages are bare arrays or wrapped in an envelope.
+func TestClient_GetAll(t *testing.T) {
+	const total = 150
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		items := []int{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, i)
+		}
+		if r.URL.Path == "/wrapped" {
+			_ = json.NewEncoder(w).Encode(map[string][]int{"items": items})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(items)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	bare, err := GetAll[int](context.Background(), c, "/bare", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	wrapped, err := GetAllIn(context.Background(), c, "/wrapped", nil, func(page *struct{ Items []int }) []int { return page.Items })
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for name, all := range map[string][]int{"bare": bare, "wrapped": wrapped} {
+		if len(all) != total || all[0] != 0 || all[total-1] != total-1 {
+			t.Errorf("%s: expected %d items in order, got %d", name, total, len(all))
+		}
+	}
+}
+
+// TestClient_GetAllPages_cappedPages checks a server that hands back fewer
+// items per page than asked for is still walked to the end, and that a page
+// shorter than the limit the server echoes ends the walk without an extra call.
+func TestClient_GetAllPages_cappedPages(t *testing.T) {
+	const total, limit = 120, 50
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		items := []int{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, i)
+		}
+		if r.URL.Path == "/echoed" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": items, "limit": limit})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(items)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	bare, err := GetAll[int](context.Background(), c, "/bare", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(bare) != total || bare[total-1] != total-1 {
+		t.Errorf("expected %d items, got %d", total, len(bare))
+	}
+	if got := atomic.LoadInt32(&calls); got != 4 {
+		t.Errorf("expected 3 pages and an empty one, got %d calls", got)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	echoed, err := GetAllIn(context.Background(), c, "/echoed", nil, func(page *struct{ Items []int }) []int { return page.Items })
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(echoed) != total {
+		t.Errorf("expected %d items, got %d", total, len(echoed))
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected the short page under the echoed limit to end the walk, got %d calls", got)
+	}
+}
+
+// TestClient_GetAllPages_ignoredPaging makes sure an endpoint that ignores
+// offset/limit and always returns the full list doesn't loop forever.
+func TestClient_GetAllPages_ignoredPaging(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		items := make([]int, DefaultPageSize)
+		_ = json.NewEncoder(w).Encode(items)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	pages := 0
+	err := c.GetAllPages(context.Background(), "/items", nil, func(page json.RawMessage) (int, error) {
+		pages++
+		var batch []int
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return 0, err
+		}
+		return len(batch), nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if pages != 1 || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("expected 1 page from 2 calls, got %d pages from %d calls", pages, calls)
+	}
+}
+
+// TestClient_GetAllPages_stopEarly checks ErrStopPaging ends the walk cleanly.
+func TestClient_GetAllPages_stopEarly(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_ = json.NewEncoder(w).Encode(make([]int, DefaultPageSize))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	err := c.GetAllPages(context.Background(), "/items", nil, func(page json.RawMessage) (int, error) {
+		return DefaultPageSize, ErrStopPaging
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+// TestClient_ConfigureTLS checks a server with a certificate the system
+// doesn't know is turned away, until its CA is trusted or checks are off.
+func TestClient_ConfigureTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(emptyFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(c *Client) error {
+		c.MaxRetries = 0
+		var result map[string]interface{}
+		return c.Get(context.Background(), "/info", nil, &result)
+	}
+
+	if err := get(NewClient(srv.URL, "key", "")); err == nil {
+		t.Error("expected an unknown certificate to be rejected")
+	}
+
+	trusted := NewClient(srv.URL, "key", "")
+	if err := trusted.ConfigureTLS(caFile, false); err != nil {
+		t.Fatalf("configuring CA bundle: %s", err)
+	}
+	if err := get(trusted); err != nil {
+		t.Errorf("expected the CA bundle to be trusted: %s", err)
+	}
+
+	insecure := NewClient(srv.URL, "key", "")
+	if err := insecure.ConfigureTLS("", true); err != nil {
+		t.Fatalf("configuring insecure TLS: %s", err)
+	}
+	if err := get(insecure); err != nil {
+		t.Errorf("expected verification to be skipped: %s", err)
+	}
+
+	if err := NewClient(srv.URL, "key", "").ConfigureTLS(emptyFile, false); err == nil {
+		t.Error("expected a bundle with no certificates to be rejected")
+	}
+
+	if transport, ok := trusted.HTTPClient.Transport.(*http.Transport); !ok || transport.MaxIdleConnsPerHost != maxIdleConnsPerHost {
+		t.Errorf("expected TLS settings to keep the connection pool's size, got %+v", trusted.HTTPClient.Transport)
+	}
+}
+
+// TestClient_parallelRequests runs a parallel apply's worth of requests
+// through one client, each worker in its own workspace, and checks every
+// answer reaches the worker that asked for it over a handful of reused
+// connections. Run it with -race to catch any state the workers share.
+func TestClient_parallelRequests(t *testing.T) {
+	const workers, requests = 10, 50
+
+	var conns int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A moment's work keeps the workers' requests overlapping, the way
+		// they do against the real API.
+		time.Sleep(time.Millisecond)
+		_ = json.NewEncoder(w).Encode(map[string]string{"tenant": r.Header.Get("X-Tenant-Id"), "path": r.URL.Path})
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&conns, 1)
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "default")
+	c.SetRateLimit(100000)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*requests)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			tenant := fmt.Sprintf("tenant-%d", w)
+			ctx := WithTenantID(context.Background(), tenant)
+			for i := 0; i < requests; i++ {
+				path := fmt.Sprintf("/things/%d/%d", w, i)
+				var result map[string]string
+				var err error
+				if i%2 == 0 {
+					err = c.Get(ctx, path, nil, &result)
+				} else {
+					err = c.Post(ctx, path, map[string]int{"i": i}, &result)
+				}
+				if err != nil {
+					errs <- err
+					continue
+				}
+				if result["tenant"] != tenant || result["path"] != path {
+					errs <- fmt.Errorf("worker %d request %d got someone else's answer: %v", w, i, result)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if got := atomic.LoadInt32(&conns); got > workers {
+		t.Errorf("expected at most %d connections for %d workers, got %d", workers, workers, got)
+	}
+}
+
+// TestClient_respectsContextDeadline checks a caller's deadline cuts a slow
+// request short, and that no retry is started it couldn't wait out.
+func TestClient_respectsContextDeadline(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.Get(ctx, "/slow", nil, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected to give up well before the Retry-After, took %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected no retry past the deadline, got %d calls", got)
+	}
+
+	// Without the Retry-After, the retry starts and is cut off by the deadline.
+	c.RetryMaxBackoff = time.Millisecond
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel2()
+	atomic.StoreInt32(&calls, 0)
+	if err := c.Get(ctx2, "/slow", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to end the request, got %v", err)
+	}
+}
+
+// TestClient_requestTimeout checks HTTPClient.Timeout bounds a single request.
+func TestClient_requestTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.HTTPClient.Timeout = 50 * time.Millisecond
+	c.MaxRetries = 0
+
+	start := time.Now()
+	if err := c.Get(context.Background(), "/slow", nil, nil); err == nil {
+		t.Fatal("expected a timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the request to time out quickly, took %s", elapsed)
+	}
+}
+
+// TestClient_PostMultipart checks each part arrives with its name, filename
+// and content type, and that a retry sends the whole body again.
+func TestClient_PostMultipart(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		reader, err := r.MultipartReader()
+		if err != nil {
+			t.Errorf("reading multipart body: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var got []string
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			content, _ := io.ReadAll(part)
+			got = append(got, part.FormName()+"|"+part.FileName()+"|"+part.Header.Get("Content-Type")+"|"+string(content))
+		}
+
+		want := []string{
+			`ex1.inputs||application/json|{"q":"who"}`,
+			"ex1.attachment.badge|badge.png|image/png|\x89PNG",
+		}
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("unexpected parts:\n%s", strings.Join(got, "\n"))
+		}
+		_, _ = w.Write([]byte(`{"count":1}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.RetryMaxBackoff = time.Millisecond
+
+	var result struct {
+		Count int `json:"count"`
+	}
+	err := c.PostMultipart(context.Background(), "/examples", []MultipartPart{
+		{Name: "ex1.inputs", Content: []byte(`{"q":"who"}`)},
+		{Name: "ex1.attachment.badge", Filename: "badge.png", ContentType: "image/png", Content: []byte("\x89PNG")},
+	}, &result)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.Count != 1 {
+		t.Errorf("expected count 1, got %d", result.Count)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected 2 calls, got %d", got)
+	}
+}
+
+// TestClient_SetRateLimit checks requests beyond the burst are spaced out to
+// the configured rate, and that a caller's deadline ends the wait.
+func TestClient_SetRateLimit(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.SetRateLimit(20)
+
+	// The first 20 requests spend the burst; the next 10 wait about 50ms each.
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		if err := c.Get(context.Background(), "/x", nil, nil); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected 30 requests at 20/s to take about 500ms, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	c.SetRateLimit(0.1)
+	before := atomic.LoadInt32(&calls)
+	_ = c.Get(ctx, "/x", nil, nil)
+	if err := c.Get(ctx, "/x", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to end the wait, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls) - before; got != 1 {
+		t.Errorf("expected one request through the drained bucket, got %d", got)
+	}
+
+	c.SetRateLimit(0)
+	for i := 0; i < 50; i++ {
+		if err := c.Get(context.Background(), "/x", nil, nil); err != nil {
+			t.Fatalf("unlimited request %d: %v", i, err)
+		}
+	}
+}
+
+// TestClient_userAgent checks every request carries the client's User-Agent.
+func TestClient_userAgent(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	if err := c.Get(context.Background(), "/x", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got != DefaultUserAgent {
+		t.Errorf("expected the default User-Agent, got %q", got)
+	}
+
+	c.UserAgent = "terraform-provider-langsmith/1.2.3 Terraform/1.9.0"
+	if err := c.Delete(context.Background(), "/x"); err != nil {
+		t.Fatal(err)
+	}
+	if got != c.UserAgent {
+		t.Errorf("expected %q, got %q", c.UserAgent, got)
+	}
+}
+
+// TestClient_defaultHeaders checks default headers go out on every request,
+// and a reserved one slipped in anyway doesn't override the client's own.
+func TestClient_defaultHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.DefaultHeaders = http.Header{
+		"X-Gateway-Team": {"platform"},
+		"x-api-key":      {"stolen"},
+		"Authorization":  {"Bearer stolen"},
+	}
+	if err := c.Create(context.Background(), "/x", map[string]string{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if v := got.Get("X-Gateway-Team"); v != "platform" {
+		t.Errorf("expected the default header to be sent, got %q", v)
+	}
+	if v := got.Values("X-Api-Key"); len(v) != 1 || v[0] != "key" {
+		t.Errorf("expected the API key to stand, got %q", v)
+	}
+	if v := got.Get("Authorization"); v != "" {
+		t.Errorf("expected no Authorization header, got %q", v)
+	}
+	if got.Get("Idempotency-Key") == "" {
+		t.Error("expected the create to keep its idempotency key")
+	}
+}
+
+// TestClient_organizationID checks the organization header rides along only
+// when the client is pointed at an organization.
+func TestClient_organizationID(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	if err := c.Get(context.Background(), "/api/v1/orgs/current", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got["X-Organization-Id"]; ok {
+		t.Errorf("expected no organization header, got %q", got.Get("X-Organization-Id"))
+	}
+
+	c.OrganizationID = "org-2"
+	c.DefaultHeaders = http.Header{"X-Organization-Id": {"org-3"}}
+	if err := c.Get(context.Background(), "/api/v1/orgs/current", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if v := got.Values("X-Organization-Id"); len(v) != 1 || v[0] != "org-2" {
+		t.Errorf("expected the configured organization, got %q", v)
+	}
+}
+
+// TestClient_idempotencyKey checks a create keeps its key across the client's
+// own retries and when sent again with the same body, however it's laid out,
+// that a different body, path, or workspace gets a different key, and that
+// plain posts and turned-off keys leave the header out.
+func TestClient_idempotencyKey(t *testing.T) {
+	var keys []string
+	failed := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if !failed {
+			failed = true
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	c.RetryMaxBackoff = time.Millisecond
+	ctx := context.Background()
+
+	creates := []struct {
+		ctx  context.Context
+		path string
+		body interface{}
+	}{
+		{ctx, "/things", map[string]interface{}{"name": "x", "size": 12345678901234567}},
+		{ctx, "/things", json.RawMessage(`{ "size": 12345678901234567, "name": "x" }`)},
+		{ctx, "/things", map[string]interface{}{"name": "y", "size": 12345678901234567}},
+		{ctx, "/widgets", map[string]interface{}{"name": "x", "size": 12345678901234567}},
+		{WithTenantID(ctx, "other"), "/things", map[string]interface{}{"name": "x", "size": 12345678901234567}},
+	}
+	for _, create := range creates {
+		if err := c.Create(create.ctx, create.path, create.body, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if len(keys) != 6 || len(keys[0]) != 36 || keys[0][14] != '5' {
+		t.Fatalf("expected six version 5 UUID keys, got %q", keys)
+	}
+	if keys[1] != keys[0] {
+		t.Errorf("expected a retried create to keep its key, got %q", keys)
+	}
+	if keys[2] != keys[0] {
+		t.Errorf("expected the same create sent again to get the same key, got %q", keys)
+	}
+	if keys[3] == keys[0] || keys[4] == keys[0] || keys[5] == keys[0] {
+		t.Errorf("expected a different body, path, or workspace to get a different key, got %q", keys)
+	}
+
+	keys = nil
+	if err := c.Post(ctx, "/things", map[string]string{"name": "x"}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c.IdempotencyKeys = false
+	if err := c.Create(ctx, "/things", map[string]string{"name": "x"}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(keys) != 2 || keys[0] != "" || keys[1] != "" {
+		t.Errorf("expected no key on a plain post or once turned off, got %q", keys)
+	}
+}
+
+// TestClient_WithTenantID checks a tenant put on the context overrides the
+// client's own for that request only.
+func TestClient_WithTenantID(t *testing.T) {
+	var tenants []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Header.Get("X-Tenant-Id"))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "t1")
+	ctx := context.Background()
+	for _, reqCtx := range []context.Context{ctx, WithTenantID(ctx, "t2"), WithTenantID(ctx, ""), ctx} {
+		if err := c.Get(reqCtx, "/things", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if want := []string{"t1", "t2", "t1", "t1"}; strings.Join(tenants, ",") != strings.Join(want, ",") {
+		t.Errorf("expected tenants %q, got %q", want, tenants)
+	}
+}
+
+// TestClient_CurrentTenantID checks a known workspace is reported without
+// asking, and that the API key's own is looked up just the once.
+func TestClient_CurrentTenantID(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/workspaces/current" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"id": "own"}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	for name, tc := range map[string]struct {
+		c    *Client
+		ctx  context.Context
+		want string
+	}{
+		"provider tenant":    {c: NewClient(srv.URL, "key", "t1"), ctx: ctx, want: "t1"},
+		"workspace override": {c: NewClient(srv.URL, "key", "t1"), ctx: WithTenantID(ctx, "t2"), want: "t2"},
+	} {
+		if got, err := tc.c.CurrentTenantID(tc.ctx); err != nil || got != tc.want {
+			t.Errorf("%s: expected %s, got %q (%v)", name, tc.want, got, err)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 0 {
+		t.Errorf("expected no lookups for a known workspace, got %d", n)
+	}
+
+	c := NewClient(srv.URL, "key", "")
+	for i := 0; i < 3; i++ {
+		if got, err := c.CurrentTenantID(ctx); err != nil || got != "own" {
+			t.Errorf("expected the key's own workspace, got %q (%v)", got, err)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected one lookup, got %d", n)
+	}
+}
+
+// TestClient_debugLogging checks each round trip is logged with its method,
+// path and status, and that the API key and secret fields never are.
+func TestClient_debugLogging(t *testing.T) {
+	const apiKey = "lsv2_pt_deputy-badge-0451"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "d1", "credentials": {"secret_access_key": "hidden"}, "echo": "` + apiKey + `"}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	c := NewClient(srv.URL, apiKey, "")
+	body := map[string]interface{}{"name": "posse", "access_key_id": "AKIA-WANTED", "password": "hunter2"}
+	if err := c.Post(ctx, "/api/v1/bulk-exports/destinations", body, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Post(ctx, "/api/v1/workspaces/current/secrets", []map[string]string{{"key": "OPENAI_API_KEY", "value": "sk-hidden"}}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	logged := buf.String()
+	for _, secret := range []string{apiKey, "AKIA-WANTED", "hunter2", "hidden", "sk-hidden"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("expected %q to be kept out of the log, got %s", secret, logged)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected a log entry per request, got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry["@level"] != "debug" || entry["method"] != "POST" || entry["status"] != float64(http.StatusOK) {
+		t.Errorf("unexpected log entry %v", entry)
+	}
+	if !strings.HasSuffix(fmt.Sprint(entry["url"]), "/api/v1/bulk-exports/destinations") || !strings.Contains(fmt.Sprint(entry["request_body"]), `"name":"posse"`) {
+		t.Errorf("expected the URL and the harmless parts of the body, got %v", entry)
+	}
+}
+
+// TestClient_debugLoggingHeaders checks header values are masked both on the
+// wire and inside a body, such as a webhook's, whatever the header's name,
+// and that every default header is masked.
+func TestClient_debugLoggingHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	c := NewClient(srv.URL, "key", "")
+	c.DefaultHeaders = http.Header{"Proxy-Authorization": {"Basic cHJveHk="}, "X-Gateway-Key": {"gw-hidden"}, "x-gateway-team": {"platform"}}
+	webhook := map[string]interface{}{
+		"url":      "https://hooks.example.com/langsmith",
+		"triggers": []string{"commit"},
+		"headers":  map[string]string{"Authorization": "Bearer hook-hidden", "X-Route": "route-hidden"},
+	}
+	if err := c.Post(ctx, "/api/v1/prompt-webhooks", webhook, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	logged := buf.String()
+	for _, secret := range []string{"cHJveHk=", "gw-hidden", "platform", "hook-hidden", "route-hidden"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("expected %q to be kept out of the log, got %s", secret, logged)
+		}
+	}
+	for _, harmless := range []string{"X-Gateway-Team", "hooks.example.com"} {
+		if !strings.Contains(logged, harmless) {
+			t.Errorf("expected %q in the log, got %s", harmless, logged)
+		}
+	}
+}
+
+// TestIsConflict_IsRateLimited checks each helper picks out its own status
+// and nothing else.
+func TestIsConflict_IsRateLimited(t *testing.T) {
+	conflict := &APIError{StatusCode: 409}
+	limited := &APIError{StatusCode: 429}
+	missing := &APIError{StatusCode: 404}
+
+	if !IsConflict(conflict) || IsConflict(limited) || IsConflict(missing) || IsConflict(errors.New("409")) || IsConflict(nil) {
+		t.Error("expected IsConflict to match a 409 alone")
+	}
+	if !IsRateLimited(limited) || IsRateLimited(conflict) || IsRateLimited(missing) || IsRateLimited(nil) {
+		t.Error("expected IsRateLimited to match a 429 alone")
+	}
+}
+
+// TestAPIError_message checks an error names the API's reason when the body
+// gives one, and cuts an unexplained body short.
+func TestAPIError_message(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		want string
+	}{
+		"detail":     {`{"detail": "Dataset not found"}`, "LangSmith API error (status 422): Dataset not found"},
+		"validation": {`{"detail": [{"loc": ["body", "rule", "window_minutes"], "msg": "field required", "type": "missing"}, {"loc": ["body", "name"], "msg": "too long"}]}`, "LangSmith API error (status 422): body.rule.window_minutes: field required; body.name: too long"},
+		"message":    {`{"message": "Invalid API key"}`, "LangSmith API error (status 422): Invalid API key"},
+		"plain":      {`Bad Gateway`, "LangSmith API error (status 422): Bad Gateway"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			err := NewClient(srv.URL, "key", "").Post(context.Background(), "/x", map[string]string{}, nil)
+			if err == nil || err.Error() != tc.want {
+				t.Errorf("expected %q, got %v", tc.want, err)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.Body != tc.body {
+				t.Errorf("expected the raw body to be kept, got %v", err)
+			}
+		})
+	}
+
+	long := &APIError{StatusCode: 500, Body: strings.Repeat("x", 3000)}
+	if msg := long.Error(); len(msg) > 1100 || !strings.HasSuffix(msg, "... (1976 more bytes)") {
+		t.Errorf("expected a long body to be cut short, got %d bytes ending %q", len(msg), msg[len(msg)-30:])
+	}
+}
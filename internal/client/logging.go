@@ -0,0 +1,174 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBody caps how much of a request or response body lands in the
+// debug log, so a big dataset upload doesn't bury everything else.
+const maxLoggedBody = 4096
+
+// redacted stands in for anything too sensitive to log.
+const redacted = "***"
+
+// sensitiveFieldParts mark a JSON field or a header as a secret wherever it
+// turns up: any name containing one of these is masked. Header names are
+// matched with their dashes read as underscores.
+var sensitiveFieldParts = []string{"secret", "password", "token", "credential", "private", "authorization", "cookie", "api_key", "access_key", "account_key"}
+
+// headerFields hold a map of HTTP headers in a body, such as a webhook's.
+// Their names say nothing of what they carry, so every value is masked.
+var headerFields = map[string]bool{"headers": true, "default_headers": true}
+
+// sensitivePaths carry secrets in plain-named fields, like a workspace
+// secret's value or a service key's key, so their bodies aren't logged at all.
+var sensitivePaths = []string{"/secrets", "/service-keys"}
+
+// logRequest writes one round trip to the debug log: method, URL, status,
+// how long it took, the headers sent, and both bodies with anything secret
+// masked. The API key is masked wherever it might appear.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, duration time.Duration, err error) {
+	if c.APIKey != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, c.APIKey)
+		ctx = tflog.MaskMessageStrings(ctx, c.APIKey)
+	}
+
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"url":         redactURL(req.URL),
+		"duration_ms": duration.Milliseconds(),
+		"headers":     c.redactHeaders(req.Header),
+	}
+	if reqBody != nil {
+		fields["request_body"] = redactBody(req.URL.Path, req.Header.Get("Content-Type"), reqBody)
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "LangSmith API request failed", fields)
+		return
+	}
+
+	fields["status"] = resp.StatusCode
+	if len(respBody) > 0 {
+		fields["response_body"] = redactBody(req.URL.Path, resp.Header.Get("Content-Type"), respBody)
+	}
+	tflog.Debug(ctx, "LangSmith API request", fields)
+}
+
+// redactURL masks query parameters that look like secrets.
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	for k := range query {
+		if isSensitiveField(k) {
+			query.Set(k, redacted)
+		}
+	}
+
+	masked := *u
+	masked.RawQuery = query.Encode()
+	return masked.String()
+}
+
+// redactHeaders flattens the headers for logging, masking the sensitive ones
+// and every one of DefaultHeaders, which may well carry a gateway's
+// credentials under an innocent name.
+func (c *Client) redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for k, v := range header {
+		if c.isDefaultHeader(k) || isSensitiveHeader(k) {
+			out[k] = redacted
+			continue
+		}
+		out[k] = strings.Join(v, ", ")
+	}
+	return out
+}
+
+// redactBody renders a body for the log. JSON has its secret fields masked;
+// anything else, like a multipart upload, is summed up by its size.
+func redactBody(path, contentType string, body []byte) string {
+	for _, p := range sensitivePaths {
+		if strings.Contains(path, p) {
+			return fmt.Sprintf("<%d bytes, redacted>", len(body))
+		}
+	}
+
+	var v interface{}
+	if json.Unmarshal(body, &v) != nil {
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	}
+
+	out, err := json.Marshal(redactJSON(v))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes, unprintable>", len(body))
+	}
+	if len(out) > maxLoggedBody {
+		return string(out[:maxLoggedBody]) + fmt.Sprintf("... (%d bytes total)", len(out))
+	}
+	return string(out)
+}
+
+// redactJSON walks a decoded JSON document, masking the value of every
+// sensitive field it finds along the way.
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if isSensitiveField(k) {
+				v[k] = redacted
+				continue
+			}
+			if headers, ok := field.(map[string]interface{}); ok && headerFields[strings.ToLower(k)] {
+				for name := range headers {
+					headers[name] = redacted
+				}
+				continue
+			}
+			v[k] = redactJSON(field)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = redactJSON(elem)
+		}
+	}
+	return v
+}
+
+// isSensitiveField reports whether a field name marks a secret.
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSensitiveHeader reports whether a header carries a secret: one named like
+// a sensitive field, or any *-Key header.
+func isSensitiveHeader(name string) bool {
+	name = strings.ReplaceAll(strings.ToLower(name), "-", "_")
+	return isSensitiveField(name) || strings.HasSuffix(name, "_key")
+}
+
+// isDefaultHeader reports whether name, in any case, is one of DefaultHeaders.
+func (c *Client) isDefaultHeader(name string) bool {
+	for k := range c.DefaultHeaders {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
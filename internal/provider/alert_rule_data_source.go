@@ -0,0 +1,203 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ datasource.DataSource                     = &AlertRuleDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &AlertRuleDataSource{}
+)
+
+// NewAlertRuleDataSource returns a new AlertRuleDataSource, for reading the
+// watch orders on an alert someone else posted.
+func NewAlertRuleDataSource() datasource.DataSource {
+	return &AlertRuleDataSource{}
+}
+
+// AlertRuleDataSource reads an existing alert rule on a project by ID or
+// name, so a module can build on an alert it doesn't manage -- say, setting
+// a new threshold off an existing baseline.
+type AlertRuleDataSource struct {
+	client *client.Client
+}
+
+// AlertRuleDataSourceModel holds the lookup keys and what the rule watches.
+type AlertRuleDataSourceModel struct {
+	SessionID     types.String  `tfsdk:"session_id"`
+	ID            types.String  `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	Type          types.String  `tfsdk:"type"`
+	Aggregation   types.String  `tfsdk:"aggregation"`
+	Attribute     types.String  `tfsdk:"attribute"`
+	Operator      types.String  `tfsdk:"operator"`
+	WindowMinutes types.Int64   `tfsdk:"window_minutes"`
+	Threshold     types.Float64 `tfsdk:"threshold"`
+	Actions       types.String  `tfsdk:"actions"`
+	WorkspaceID   types.String  `tfsdk:"workspace_id"`
+}
+
+func (d *AlertRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rule"
+}
+
+func (d *AlertRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to look up an alert rule on a project by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"session_id": schema.StringAttribute{
+				MarkdownDescription: "The project/session ID the alert rule is attached to.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the alert rule. Exactly one of `id` or `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the alert rule. Exactly one of `id` or `name` must be specified; it's an error for the name to match more than one rule on the project.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The alert rule type (`threshold` or `change`).",
+				Computed:            true,
+			},
+			"aggregation": schema.StringAttribute{
+				MarkdownDescription: "The aggregation method (`avg`, `sum`, or `pct`).",
+				Computed:            true,
+			},
+			"attribute": schema.StringAttribute{
+				MarkdownDescription: "The metric attribute the rule monitors.",
+				Computed:            true,
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "The comparison operator (`gte` or `lte`).",
+				Computed:            true,
+			},
+			"window_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The monitoring window in minutes.",
+				Computed:            true,
+			},
+			"threshold": schema.Float64Attribute{
+				MarkdownDescription: "The threshold value. Null for `change` rules.",
+				Computed:            true,
+			},
+			"actions": schema.StringAttribute{
+				MarkdownDescription: "A JSON-encoded array of the actions fired when the alert triggers.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AlertRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *AlertRuleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *AlertRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertRuleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	sessionID := data.SessionID.ValueString()
+
+	var result alertRuleResponse
+	if !data.ID.IsNull() {
+		apiPath := fmt.Sprintf("/v1/platform/alerts/%s/%s", sessionID, data.ID.ValueString())
+		if err := d.client.Get(ctx, apiPath, nil, &result); err != nil {
+			resp.Diagnostics.AddError("Error reading alert rule", err.Error())
+			return
+		}
+	} else {
+		var rules []alertRuleResponse
+		if err := d.client.Get(ctx, fmt.Sprintf("/v1/platform/alerts/%s", sessionID), nil, &rules); err != nil {
+			resp.Diagnostics.AddError("Error reading alert rules", err.Error())
+			return
+		}
+
+		var matches []alertRuleResponse
+		for _, rule := range rules {
+			if rule.Rule.Name == data.Name.ValueString() {
+				matches = append(matches, rule)
+			}
+		}
+		switch len(matches) {
+		case 1:
+			result = matches[0]
+		case 0:
+			resp.Diagnostics.AddError(
+				"Alert Rule Not Found",
+				fmt.Sprintf("No alert rule named %q on project %s.", data.Name.ValueString(), sessionID),
+			)
+			return
+		default:
+			resp.Diagnostics.AddError(
+				"Ambiguous Alert Rule Name",
+				fmt.Sprintf("Found %d alert rules named %q on project %s; look the rule up by id instead.", len(matches), data.Name.ValueString(), sessionID),
+			)
+			return
+		}
+	}
+
+	data.ID = types.StringValue(result.Rule.ID)
+	data.Name = types.StringValue(result.Rule.Name)
+	data.Type = types.StringValue(result.Rule.Type)
+	data.Aggregation = types.StringValue(result.Rule.Aggregation)
+	data.Attribute = types.StringValue(result.Rule.Attribute)
+	data.Operator = types.StringValue(result.Rule.Operator)
+	data.WindowMinutes = types.Int64Value(result.Rule.WindowMinutes)
+
+	if result.Rule.Threshold != nil {
+		data.Threshold = types.Float64Value(*result.Rule.Threshold)
+	} else {
+		data.Threshold = types.Float64Null()
+	}
+
+	if len(result.Actions) > 0 && string(result.Actions) != "null" {
+		data.Actions = types.StringValue(string(result.Actions))
+	} else {
+		data.Actions = types.StringValue("[]")
+	}
+
+	tflog.Trace(ctx, "read alert rule data source", map[string]interface{}{"id": result.Rule.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
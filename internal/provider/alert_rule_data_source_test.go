@@ -0,0 +1,64 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestAlertRuleDataSource_read checks a rule can be found by ID or by a name
+// unique to its project, and that a shared or unknown name is an error.
+func TestAlertRuleDataSource_read(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/platform/alerts/s1/a1":
+			_, _ = w.Write([]byte(`{"rule": {"id": "a1", "name": "baseline", "type": "threshold", "aggregation": "avg", "attribute": "latency", "operator": "gte", "window_minutes": 15, "threshold": 2.5}, "actions": [{"target": "email", "config": {}}]}`))
+		case "/v1/platform/alerts/s1":
+			_, _ = w.Write([]byte(`[
+				{"rule": {"id": "a1", "name": "baseline", "type": "threshold", "aggregation": "avg", "attribute": "latency", "operator": "gte", "window_minutes": 15, "threshold": 2.5}, "actions": []},
+				{"rule": {"id": "a2", "name": "errors", "type": "change", "aggregation": "sum", "attribute": "error_count", "operator": "gte", "window_minutes": 5, "threshold": null}, "actions": []},
+				{"rule": {"id": "a3", "name": "errors", "type": "change", "aggregation": "sum", "attribute": "error_count", "operator": "gte", "window_minutes": 60, "threshold": null}, "actions": []}
+			]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	d := &AlertRuleDataSource{client: client.NewClient(srv.URL, "key", "")}
+	read := func(id, name types.String) (AlertRuleDataSourceModel, bool) {
+		resp := testDataSourceRead(t, d, &AlertRuleDataSourceModel{SessionID: types.StringValue("s1"), ID: id, Name: name})
+		var got AlertRuleDataSourceModel
+		resp.State.Get(context.Background(), &got)
+		return got, resp.Diagnostics.HasError()
+	}
+
+	byID, failed := read(types.StringValue("a1"), types.StringNull())
+	if failed || byID.Name.ValueString() != "baseline" || byID.Threshold.ValueFloat64() != 2.5 || byID.WindowMinutes.ValueInt64() != 15 {
+		t.Errorf("unexpected rule by id %+v", byID)
+	}
+	if byID.Actions.ValueString() != `[{"target": "email", "config": {}}]` {
+		t.Errorf("unexpected actions %s", byID.Actions)
+	}
+
+	byName, failed := read(types.StringNull(), types.StringValue("baseline"))
+	if failed || byName.ID.ValueString() != "a1" || byName.Aggregation.ValueString() != "avg" {
+		t.Errorf("unexpected rule by name %+v", byName)
+	}
+
+	if _, failed := read(types.StringNull(), types.StringValue("errors")); !failed {
+		t.Error("expected an error for a name shared by two rules")
+	}
+	if _, failed := read(types.StringNull(), types.StringValue("nobody")); !failed {
+		t.Error("expected an error for a name matching no rule")
+	}
+}
@@ -4,17 +4,21 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -22,10 +26,15 @@ import (
 )
 
 var (
-	_ resource.Resource                = &AlertRuleResource{}
-	_ resource.ResourceWithImportState = &AlertRuleResource{}
+	_ resource.Resource                     = &AlertRuleResource{}
+	_ resource.ResourceWithImportState      = &AlertRuleResource{}
+	_ resource.ResourceWithConfigValidators = &AlertRuleResource{}
 )
 
+// metricAggregations are the ways LangSmith can roll a run metric up over a
+// window, shared by alert rules and charts.
+var metricAggregations = []string{"avg", "sum", "pct"}
+
 // NewAlertRuleResource returns a new AlertRuleResource -- Marshal Dillon posting
 // a new deputy to keep watch over your LangSmith projects.
 func NewAlertRuleResource() resource.Resource {
@@ -42,23 +51,39 @@ type AlertRuleResource struct {
 // AlertRuleResourceModel holds the Terraform state for an alert rule,
 // from its name and thresholds down to the actions it fires when trouble rides in.
 type AlertRuleResourceModel struct {
-	ID                     types.String  `tfsdk:"id"`
-	SessionID              types.String  `tfsdk:"session_id"`
-	Name                   types.String  `tfsdk:"name"`
-	Description            types.String  `tfsdk:"description"`
-	Type                   types.String  `tfsdk:"type"`
-	Aggregation            types.String  `tfsdk:"aggregation"`
-	Attribute              types.String  `tfsdk:"attribute"`
-	Operator               types.String  `tfsdk:"operator"`
-	WindowMinutes          types.Int64   `tfsdk:"window_minutes"`
-	Threshold              types.Float64 `tfsdk:"threshold"`
-	ThresholdMultiplier    types.Float64 `tfsdk:"threshold_multiplier"`
-	ThresholdWindowMinutes types.Int64   `tfsdk:"threshold_window_minutes"`
-	Filter                 types.String  `tfsdk:"filter"`
-	DenominatorFilter      types.String  `tfsdk:"denominator_filter"`
-	Actions                types.String  `tfsdk:"actions"`
-	CreatedAt              types.String  `tfsdk:"created_at"`
-	UpdatedAt              types.String  `tfsdk:"updated_at"`
+	ID                     types.String           `tfsdk:"id"`
+	SessionID              types.String           `tfsdk:"session_id"`
+	Name                   types.String           `tfsdk:"name"`
+	Description            types.String           `tfsdk:"description"`
+	Type                   types.String           `tfsdk:"type"`
+	Aggregation            types.String           `tfsdk:"aggregation"`
+	Attribute              types.String           `tfsdk:"attribute"`
+	Operator               types.String           `tfsdk:"operator"`
+	WindowMinutes          types.Int64            `tfsdk:"window_minutes"`
+	Threshold              types.Float64          `tfsdk:"threshold"`
+	ThresholdMultiplier    types.Float64          `tfsdk:"threshold_multiplier"`
+	ThresholdWindowMinutes types.Int64            `tfsdk:"threshold_window_minutes"`
+	Filter                 types.String           `tfsdk:"filter"`
+	NormalizedFilter       types.String           `tfsdk:"normalized_filter"`
+	DenominatorFilter      types.String           `tfsdk:"denominator_filter"`
+	Actions                types.String           `tfsdk:"actions"`
+	Action                 []alertRuleActionModel `tfsdk:"action"`
+	CreatedAt              types.String           `tfsdk:"created_at"`
+	UpdatedAt              types.String           `tfsdk:"updated_at"`
+	WorkspaceID            types.String           `tfsdk:"workspace_id"`
+}
+
+// alertRuleActionModel is one typed action block: where the alarm goes, and
+// the settings that go with it.
+type alertRuleActionModel struct {
+	Target types.String `tfsdk:"target"`
+	Config types.Map    `tfsdk:"config"`
+}
+
+// alertRuleAction is an action as the API knows it.
+type alertRuleAction struct {
+	Target string                     `json:"target"`
+	Config map[string]json.RawMessage `json:"config"`
 }
 
 // alertRuleRequest is the payload we send to the API when staking a new alert
@@ -119,8 +144,10 @@ func (r *AlertRuleResource) Metadata(ctx context.Context, req resource.MetadataR
 
 func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a LangSmith alert rule for monitoring project metrics.",
+		MarkdownDescription: "Manages a LangSmith alert rule for monitoring project metrics. " +
+			"An alert can't move between projects, so changing `session_id` replaces it; set `create_before_destroy` in a `lifecycle` block to have the new alert watching before the old one is removed.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the alert rule.",
 				Computed:            true,
@@ -129,7 +156,7 @@ func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"session_id": schema.StringAttribute{
-				MarkdownDescription: "The project/session ID to attach the alert to.",
+				MarkdownDescription: "The project/session ID to attach the alert to. Changing it forces a new alert rule.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -146,46 +173,73 @@ func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaReque
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The alert rule type (`threshold` or `change`).",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("threshold", "change"),
+				},
 			},
 			"aggregation": schema.StringAttribute{
 				MarkdownDescription: "The aggregation method (`avg`, `sum`, or `pct`).",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricAggregations...),
+				},
 			},
 			"attribute": schema.StringAttribute{
 				MarkdownDescription: "The metric attribute to monitor (`latency`, `error_count`, `feedback_score`, `run_latency`, or `run_count`).",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("latency", "error_count", "feedback_score", "run_latency", "run_count"),
+				},
 			},
 			"operator": schema.StringAttribute{
 				MarkdownDescription: "The comparison operator (`gte` or `lte`).",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("gte", "lte"),
+				},
 			},
 			"window_minutes": schema.Int64Attribute{
 				MarkdownDescription: "The monitoring window in minutes.",
 				Required:            true,
 			},
 			"threshold": schema.Float64Attribute{
-				MarkdownDescription: "The threshold value for threshold-type rules.",
+				MarkdownDescription: "The threshold value. Required when `type` is `threshold`.",
 				Optional:            true,
 			},
 			"threshold_multiplier": schema.Float64Attribute{
-				MarkdownDescription: "The multiplier for change-type rules.",
+				MarkdownDescription: "The multiplier for change-type rules. Required when `type` is `change`.",
 				Optional:            true,
 			},
 			"threshold_window_minutes": schema.Int64Attribute{
-				MarkdownDescription: "The comparison window in minutes for change-type rules.",
+				MarkdownDescription: "The comparison window in minutes for change-type rules. Required when `type` is `change`.",
 				Optional:            true,
 			},
 			"filter": schema.StringAttribute{
-				MarkdownDescription: "A run filter expression.",
+				MarkdownDescription: "A run filter expression. Rules on the `feedback_score` attribute should narrow this to a feedback key, e.g. `eq(feedback_key, \"correctness\")`; without one the score is averaged across every key and the alert may never fire.",
 				Optional:            true,
 			},
+			"normalized_filter": schema.StringAttribute{
+				MarkdownDescription: "The `filter` expression in canonical form: whitespace collapsed, operator names lowercased, strings double-quoted, and the arguments of `and`/`or` sorted. Filters that mean the same thing have the same `normalized_filter`, so modules can compare them reliably.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					canonicalFilterOf(path.Root("filter")),
+				},
+			},
 			"denominator_filter": schema.StringAttribute{
 				MarkdownDescription: "A denominator filter for `pct` aggregation.",
 				Optional:            true,
 			},
 			"actions": schema.StringAttribute{
-				MarkdownDescription: "A JSON-encoded array of action objects, e.g. `[{\"target\": \"email\", \"config\": {...}}]`.",
-				Required:            true,
+				MarkdownDescription: "A JSON-encoded array of action objects, e.g. `[{\"target\": \"email\", \"config\": {...}}]`. " +
+					"Deprecated: use `action` blocks instead. Always reflects the actions the API holds, however they were configured; " +
+					"removing it along with every `action` block clears the rule's actions.",
+				DeprecationMessage: "Use action blocks instead. The actions attribute will be removed in a future major version.",
+				Optional:           true,
+				Computed:           true,
+				PlanModifiers: []planmodifier.String{
+					clearedWithoutBlocks("action", types.StringValue("[]")),
+					jsonNormalize(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the alert rule was created.",
@@ -196,6 +250,28 @@ func (r *AlertRuleResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"action": schema.ListNestedBlock{
+				MarkdownDescription: "An action fired when the alert triggers. Repeat the block for several actions; leave it out, along with `actions`, for an alert with none.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"target": schema.StringAttribute{
+							MarkdownDescription: "Where the alert is sent: `email`, `webhook`, or `pagerduty`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("email", "webhook", "pagerduty"),
+							},
+						},
+						"config": schema.MapAttribute{
+							MarkdownDescription: "Settings for the target, such as `url` for a webhook or `integration_key` for PagerDuty. " +
+								"Values that are JSON objects or arrays, e.g. `jsonencode([\"oncall@example.com\"])`, are sent as JSON.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -204,16 +280,16 @@ func (r *AlertRuleResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -223,6 +299,8 @@ func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body, diags := buildAlertRuleRequest(&data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -232,7 +310,7 @@ func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateReque
 	apiPath := fmt.Sprintf("/v1/platform/alerts/%s", data.SessionID.ValueString())
 
 	var result alertRuleResponse
-	err := r.client.Post(ctx, apiPath, body, &result)
+	err := r.client.Create(ctx, apiPath, body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating alert rule", err.Error())
 		return
@@ -241,8 +319,13 @@ func (r *AlertRuleResource) Create(ctx context.Context, req resource.CreateReque
 	// The create response returns session_id as null, so we preserve the
 	// value from the plan. The GET endpoint returns it properly.
 	sessionID := data.SessionID.ValueString()
+	planned, plannedActions := data.Action, data.Actions
 	mapAlertRuleResponseToState(&data, &result)
 	data.SessionID = types.StringValue(sessionID)
+	data.Action = planned
+	if !plannedActions.IsUnknown() && jsonSemanticallyEqual(plannedActions.ValueString(), data.Actions.ValueString()) {
+		data.Actions = plannedActions
+	}
 	tflog.Trace(ctx, "created alert rule resource", map[string]interface{}{"id": result.Rule.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -255,6 +338,8 @@ func (r *AlertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	apiPath := fmt.Sprintf("/v1/platform/alerts/%s/%s",
 		data.SessionID.ValueString(), data.ID.ValueString())
 
@@ -281,6 +366,8 @@ func (r *AlertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body, diags := buildAlertRuleRequest(&data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -297,7 +384,14 @@ func (r *AlertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// The action blocks stay as planned, and so does actions JSON the API
+	// only re-spaced; Read picks up any drift.
+	planned, plannedActions := data.Action, data.Actions
 	mapAlertRuleResponseToState(&data, &result)
+	data.Action = planned
+	if !plannedActions.IsUnknown() && jsonSemanticallyEqual(plannedActions.ValueString(), data.Actions.ValueString()) {
+		data.Actions = plannedActions
+	}
 	tflog.Trace(ctx, "updated alert rule resource", map[string]interface{}{"id": result.Rule.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -310,11 +404,15 @@ func (r *AlertRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	apiPath := fmt.Sprintf("/v1/platform/alerts/%s/%s",
 		data.SessionID.ValueString(), data.ID.ValueString())
 
+	// The old rule may already be gone by the time a create_before_destroy
+	// replacement gets around to it, e.g. when its project went first.
 	err := r.client.Delete(ctx, apiPath)
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error deleting alert rule", err.Error())
 		return
 	}
@@ -322,21 +420,149 @@ func (r *AlertRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 	tflog.Trace(ctx, "deleted alert rule resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+func (r *AlertRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		alertRuleTypeFieldsValidator{},
+		alertRuleActionsValidator{},
+		alertRuleFeedbackFilterValidator{},
+	}
+}
+
+// alertRuleTypeFieldsValidator makes sure each rule type brings the fields it
+// can't ride without: a threshold for `threshold` rules, and a multiplier and
+// comparison window for `change` rules. Better to catch it at plan time than
+// to take a 422 at apply.
+type alertRuleTypeFieldsValidator struct{}
+
+func (v alertRuleTypeFieldsValidator) Description(ctx context.Context) string {
+	return "threshold rules require threshold; change rules require threshold_multiplier and threshold_window_minutes"
+}
+
+func (v alertRuleTypeFieldsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`threshold` rules require `threshold`; `change` rules require `threshold_multiplier` and `threshold_window_minutes`"
+}
+
+func (v alertRuleTypeFieldsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ruleType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &ruleType)...)
+	if resp.Diagnostics.HasError() || ruleType.IsNull() || ruleType.IsUnknown() {
+		return
+	}
+
+	var required []string
+	switch ruleType.ValueString() {
+	case "threshold":
+		required = []string{"threshold"}
+	case "change":
+		required = []string{"threshold_multiplier", "threshold_window_minutes"}
+	default:
+		return
+	}
+
+	for _, name := range required {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute For Alert Rule Type",
+				fmt.Sprintf("The attribute %q is required when type is %q.", name, ruleType.ValueString()),
+			)
+		}
+	}
+}
+
+// alertRuleActionsValidator makes sure actions are given one way, not both:
+// typed action blocks or the deprecated actions JSON.
+type alertRuleActionsValidator struct{}
+
+func (v alertRuleActionsValidator) Description(ctx context.Context) string {
+	return "action blocks and actions can't both be set"
+}
+
+func (v alertRuleActionsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`action` blocks and `actions` can't both be set"
+}
+
+func (v alertRuleActionsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var actions types.String
+	var blocks types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("actions"), &actions)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action"), &blocks)...)
+	if resp.Diagnostics.HasError() || actions.IsNull() || blocks.IsNull() {
+		return
+	}
+
+	if blocks.IsUnknown() || len(blocks.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("actions"),
+			"Conflicting Alert Actions",
+			"Set either action blocks or the deprecated actions attribute, not both.",
+		)
+	}
+}
+
+// alertRuleFeedbackFilterValidator warns when a feedback_score rule doesn't
+// name a feedback key in its filter. Broad filters are legal, so it's a
+// warning shot rather than an arrest.
+type alertRuleFeedbackFilterValidator struct{}
+
+func (v alertRuleFeedbackFilterValidator) Description(ctx context.Context) string {
+	return "rules on the feedback_score attribute should filter on a feedback key"
+}
+
+func (v alertRuleFeedbackFilterValidator) MarkdownDescription(ctx context.Context) string {
+	return "rules on the `feedback_score` attribute should filter on a `feedback_key`"
+}
+
+func (v alertRuleFeedbackFilterValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var attribute, filter types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attribute"), &attribute)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter"), &filter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attribute.IsUnknown() || attribute.ValueString() != "feedback_score" || filter.IsUnknown() {
+		return
+	}
+
+	if filter.IsNull() || !strings.Contains(filter.ValueString(), "feedback_key") {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("filter"),
+			"Feedback Score Alert Without Feedback Key",
+			"This alert rule monitors feedback_score but its filter doesn't select a feedback key, "+
+				"so scores from every feedback key are lumped together and the alert may never fire. "+
+				"Add a filter such as eq(feedback_key, \"correctness\") to watch a single key.",
+		)
+	}
+}
+
 // ImportState handles importing an alert rule resource.
 // The import ID format is "session_id/alert_rule_id" -- two halves of the trail
-// that lead us right to the outlaw we are looking for.
+// that lead us right to the outlaw we are looking for. A trailing "/actions"
+// imports a rule whose config still uses the deprecated actions JSON, leaving
+// its action blocks empty so the first plan doesn't trade one for the other.
 func (r *AlertRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" || (len(parts) == 3 && parts[2] != "actions") {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'session_id/alert_rule_id', got: %s", req.ID),
+			fmt.Sprintf("Expected import ID in the format 'session_id/alert_rule_id' or 'session_id/alert_rule_id/actions', got: %s", req.ID),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("session_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	if len(parts) == 3 {
+		// Any known actions mark the rule as on the JSON; Read fills in the real ones.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("actions"), "[]")...)
+	}
 }
 
 // buildAlertRuleRequest assembles the request body from the Terraform plan data,
@@ -378,19 +604,149 @@ func buildAlertRuleRequest(data *AlertRuleResourceModel) (*alertRuleRequest, dia
 		body.Rule.DenominatorFilter = &v
 	}
 
-	actionsJSON := data.Actions.ValueString()
-	if !json.Valid([]byte(actionsJSON)) {
-		diags.AddError(
-			"Invalid Actions JSON",
-			"The actions field must contain valid JSON. Even Festus could tell this ain't right.",
-		)
-		return nil, diags
+	switch {
+	case len(data.Action) > 0:
+		actions, err := buildAlertRuleActions(data.Action)
+		if err != nil {
+			diags.AddError("Invalid Alert Action", err.Error())
+			return nil, diags
+		}
+		body.Actions = actions
+	case !data.Actions.IsNull() && !data.Actions.IsUnknown():
+		actionsJSON := data.Actions.ValueString()
+		if !json.Valid([]byte(actionsJSON)) {
+			diags.AddError(
+				"Invalid Actions JSON",
+				"The actions field must contain valid JSON. Even Festus could tell this ain't right.",
+			)
+			return nil, diags
+		}
+		body.Actions = json.RawMessage(actionsJSON)
+	default:
+		body.Actions = json.RawMessage("[]")
 	}
-	body.Actions = json.RawMessage(actionsJSON)
 
 	return body, diags
 }
 
+// buildAlertRuleActions assembles the actions JSON from typed action blocks.
+// Config values that are already JSON are sent as that JSON; every other value
+// is sent as a string.
+func buildAlertRuleActions(blocks []alertRuleActionModel) (json.RawMessage, error) {
+	actions := make([]alertRuleAction, 0, len(blocks))
+	for i, block := range blocks {
+		action := alertRuleAction{
+			Target: block.Target.ValueString(),
+			Config: map[string]json.RawMessage{},
+		}
+
+		for key, value := range block.Config.Elements() {
+			str, ok := value.(types.String)
+			if !ok || str.IsNull() || str.IsUnknown() {
+				continue
+			}
+
+			encoded, err := actionConfigJSON(str.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("action %d, config %q: %w", i, key, err)
+			}
+			action.Config[key] = encoded
+		}
+
+		actions = append(actions, action)
+	}
+
+	return json.Marshal(actions)
+}
+
+// decomposeAlertRuleActions breaks the API's actions back down into typed
+// blocks, the reverse of buildAlertRuleActions. String values come back as
+// themselves, unless they'd read as JSON; anything else comes back as its JSON.
+func decomposeAlertRuleActions(raw json.RawMessage) ([]alertRuleActionModel, error) {
+	var actions []alertRuleAction
+	if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &actions); err != nil {
+			return nil, err
+		}
+	}
+
+	blocks := make([]alertRuleActionModel, 0, len(actions))
+	for _, action := range actions {
+		config := types.MapNull(types.StringType)
+		if len(action.Config) > 0 {
+			values := make(map[string]attr.Value, len(action.Config))
+			for key, value := range action.Config {
+				str, err := actionConfigString(value)
+				if err != nil {
+					return nil, err
+				}
+				values[key] = types.StringValue(str)
+			}
+			config = types.MapValueMust(types.StringType, values)
+		}
+
+		blocks = append(blocks, alertRuleActionModel{
+			Target: types.StringValue(action.Target),
+			Config: config,
+		})
+	}
+
+	return blocks, nil
+}
+
+// blockValueJSON encodes a string value from a typed block for the API. A
+// value holding a JSON object or array is sent as that JSON; anything else is
+// sent as a string.
+func blockValueJSON(value string) (json.RawMessage, error) {
+	v := strings.TrimSpace(value)
+	if (strings.HasPrefix(v, "{") || strings.HasPrefix(v, "[")) && json.Valid([]byte(v)) {
+		return json.RawMessage(v), nil
+	}
+	return json.Marshal(value)
+}
+
+// blockValueString is the reverse of blockValueJSON: a JSON string comes back
+// as itself, and anything else as its compacted JSON.
+func blockValueString(raw json.RawMessage) (string, error) {
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil {
+		return str, nil
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return "", err
+	}
+	return compact.String(), nil
+}
+
+// actionConfigJSON encodes a value from an action block's config for the API.
+// A value that's already JSON -- an object, array, number, true, false, null,
+// or a quoted string -- is sent as that JSON, so a webhook's `timeout = 5`
+// arrives as the number it was. Anything else is sent as a string.
+func actionConfigJSON(value string) (json.RawMessage, error) {
+	if v := strings.TrimSpace(value); json.Valid([]byte(v)) {
+		return json.RawMessage(v), nil
+	}
+	return json.Marshal(value)
+}
+
+// actionConfigString is the reverse of actionConfigJSON. A JSON string comes
+// back as itself, unless it would read as JSON, in which case it keeps its
+// quotes; anything else comes back as its compacted JSON.
+func actionConfigString(raw json.RawMessage) (string, error) {
+	var str string
+	if err := json.Unmarshal(raw, &str); err == nil && !json.Valid([]byte(strings.TrimSpace(str))) {
+		return str, nil
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return "", err
+	}
+	return compact.String(), nil
+}
+
 // mapAlertRuleResponseToState rounds up the API response values and brands them
 // into the Terraform state model. Optional fields that came back empty get set to
 // null -- no sense reporting ghost cattle to the marshal.
@@ -429,8 +785,10 @@ func mapAlertRuleResponseToState(data *AlertRuleResourceModel, result *alertRule
 
 	if result.Rule.Filter != nil {
 		data.Filter = types.StringValue(*result.Rule.Filter)
+		data.NormalizedFilter = types.StringValue(canonicalizeFilter(*result.Rule.Filter))
 	} else {
 		data.Filter = types.StringNull()
+		data.NormalizedFilter = types.StringNull()
 	}
 
 	if result.Rule.DenominatorFilter != nil {
@@ -439,6 +797,14 @@ func mapAlertRuleResponseToState(data *AlertRuleResourceModel, result *alertRule
 		data.DenominatorFilter = types.StringNull()
 	}
 
+	// Typed blocks are refreshed when they're in use, or on import, when
+	// nothing is known yet. A rule still on the deprecated actions JSON, or
+	// imported with "/actions", keeps its blocks empty.
+	if len(data.Action) > 0 || data.Actions.IsNull() {
+		if blocks, err := decomposeAlertRuleActions(result.Actions); err == nil {
+			data.Action = blocks
+		}
+	}
 	data.Actions = types.StringValue(string(result.Actions))
 	data.CreatedAt = types.StringValue(result.Rule.CreatedAt)
 	data.UpdatedAt = types.StringValue(result.Rule.UpdatedAt)
@@ -4,11 +4,23 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // TestAccAlertRuleResource_basic sets up a lookout on the project and waits
@@ -36,7 +48,6 @@ resource "langsmith_alert_rule" "test" {
   operator       = "gte"
   window_minutes = 5
   threshold      = 5000
-  actions        = "[]"
 }`, rName, rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("langsmith_alert_rule.test", "id"),
@@ -47,3 +58,383 @@ resource "langsmith_alert_rule" "test" {
 		},
 	})
 }
+
+// TestAlertRuleResource_feedbackScoreFilter checks that a feedback_score rule
+// without a feedback key filter draws a warning, and one with it rides through.
+func TestAlertRuleResource_feedbackScoreFilter(t *testing.T) {
+	base := map[string]tftypes.Value{
+		"session_id":     tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+		"name":           tftypes.NewValue(tftypes.String, "low-correctness"),
+		"description":    tftypes.NewValue(tftypes.String, "Correctness dipped"),
+		"type":           tftypes.NewValue(tftypes.String, "threshold"),
+		"aggregation":    tftypes.NewValue(tftypes.String, "avg"),
+		"attribute":      tftypes.NewValue(tftypes.String, "feedback_score"),
+		"operator":       tftypes.NewValue(tftypes.String, "lte"),
+		"window_minutes": tftypes.NewValue(tftypes.Number, 15),
+		"threshold":      tftypes.NewValue(tftypes.Number, 0.5),
+	}
+
+	diags := testValidateResourceConfig(t, "langsmith_alert_rule", base)
+	if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityWarning, "Feedback Key") {
+		t.Errorf("expected a feedback key warning, got %v", diags)
+	}
+
+	withKey := map[string]tftypes.Value{}
+	for k, v := range base {
+		withKey[k] = v
+	}
+	withKey["filter"] = tftypes.NewValue(tftypes.String, `eq(feedback_key, "correctness")`)
+	diags = testValidateResourceConfig(t, "langsmith_alert_rule", withKey)
+	if len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+
+	latency := map[string]tftypes.Value{}
+	for k, v := range base {
+		latency[k] = v
+	}
+	latency["attribute"] = tftypes.NewValue(tftypes.String, "latency")
+	diags = testValidateResourceConfig(t, "langsmith_alert_rule", latency)
+	if len(diags) != 0 {
+		t.Errorf("expected no diagnostics for latency rule, got %v", diags)
+	}
+}
+
+// TestAlertRuleResource_enumsAndTypeFields checks that misspelled enum values
+// are turned away at plan time, and that each rule type carries the fields
+// it needs.
+func TestAlertRuleResource_enumsAndTypeFields(t *testing.T) {
+	base := func() map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"session_id":     tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+			"name":           tftypes.NewValue(tftypes.String, "slow-runs"),
+			"description":    tftypes.NewValue(tftypes.String, "Latency is climbing"),
+			"type":           tftypes.NewValue(tftypes.String, "threshold"),
+			"aggregation":    tftypes.NewValue(tftypes.String, "avg"),
+			"attribute":      tftypes.NewValue(tftypes.String, "latency"),
+			"operator":       tftypes.NewValue(tftypes.String, "gte"),
+			"window_minutes": tftypes.NewValue(tftypes.Number, 5),
+			"threshold":      tftypes.NewValue(tftypes.Number, 5000),
+		}
+	}
+
+	cases := map[string]struct {
+		modify  func(map[string]tftypes.Value)
+		wantErr string
+	}{
+		"valid threshold rule": {
+			modify: func(map[string]tftypes.Value) {},
+		},
+		"valid change rule": {
+			modify: func(m map[string]tftypes.Value) {
+				m["type"] = tftypes.NewValue(tftypes.String, "change")
+				delete(m, "threshold")
+				m["threshold_multiplier"] = tftypes.NewValue(tftypes.Number, 2)
+				m["threshold_window_minutes"] = tftypes.NewValue(tftypes.Number, 60)
+			},
+		},
+		"bad type": {
+			modify:  func(m map[string]tftypes.Value) { m["type"] = tftypes.NewValue(tftypes.String, "thresold") },
+			wantErr: "type",
+		},
+		"bad aggregation": {
+			modify:  func(m map[string]tftypes.Value) { m["aggregation"] = tftypes.NewValue(tftypes.String, "avgg") },
+			wantErr: "aggregation",
+		},
+		"bad attribute": {
+			modify:  func(m map[string]tftypes.Value) { m["attribute"] = tftypes.NewValue(tftypes.String, "latencyy") },
+			wantErr: "attribute",
+		},
+		"bad operator": {
+			modify:  func(m map[string]tftypes.Value) { m["operator"] = tftypes.NewValue(tftypes.String, "gt") },
+			wantErr: "operator",
+		},
+		"threshold rule without threshold": {
+			modify:  func(m map[string]tftypes.Value) { delete(m, "threshold") },
+			wantErr: `"threshold" is required`,
+		},
+		"change rule without window": {
+			modify: func(m map[string]tftypes.Value) {
+				m["type"] = tftypes.NewValue(tftypes.String, "change")
+				m["threshold_multiplier"] = tftypes.NewValue(tftypes.Number, 2)
+			},
+			wantErr: `"threshold_window_minutes" is required`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attrs := base()
+			tc.modify(attrs)
+			diags := testValidateResourceConfig(t, "langsmith_alert_rule", attrs)
+			if tc.wantErr == "" {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestAlertRuleResource_actionBlocks checks typed action blocks are sent as
+// the API's actions JSON, and that the API's answer breaks back down into the
+// same blocks.
+func TestAlertRuleResource_actionBlocks(t *testing.T) {
+	blocks := []alertRuleActionModel{
+		{
+			Target: types.StringValue("webhook"),
+			Config: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"url":     types.StringValue("https://hooks.example.com/alerts"),
+				"headers": types.StringValue(`{"X-Token":"abc"}`),
+			}),
+		},
+		{
+			Target: types.StringValue("email"),
+			Config: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"emails": types.StringValue(`["kitty@longbranch.example"]`),
+			}),
+		},
+		{
+			Target: types.StringValue("pagerduty"),
+			Config: types.MapNull(types.StringType),
+		},
+	}
+
+	raw, err := buildAlertRuleActions(blocks)
+	if err != nil {
+		t.Fatalf("building actions: %s", err)
+	}
+	if !jsonSemanticallyEqual(string(raw), `[
+		{"target": "webhook", "config": {"url": "https://hooks.example.com/alerts", "headers": {"X-Token": "abc"}}},
+		{"target": "email", "config": {"emails": ["kitty@longbranch.example"]}},
+		{"target": "pagerduty", "config": {}}
+	]`) {
+		t.Errorf("unexpected actions JSON: %s", raw)
+	}
+
+	// The API answers with its own spacing; the blocks shouldn't notice.
+	back, err := decomposeAlertRuleActions(json.RawMessage(`[
+		{"target": "webhook", "config": {"url": "https://hooks.example.com/alerts", "headers": {"X-Token": "abc"}}},
+		{"target": "email", "config": {"emails": [ "kitty@longbranch.example" ]}},
+		{"target": "pagerduty", "config": {}}
+	]`))
+	if err != nil {
+		t.Fatalf("decomposing actions: %s", err)
+	}
+	if len(back) != len(blocks) {
+		t.Fatalf("expected %d blocks, got %+v", len(blocks), back)
+	}
+	for i := range blocks {
+		if !back[i].Target.Equal(blocks[i].Target) || !back[i].Config.Equal(blocks[i].Config) {
+			t.Errorf("block %d: expected %+v, got %+v", i, blocks[i], back[i])
+		}
+	}
+}
+
+// TestAlertRuleResource_actionsMapping checks which rules get their action
+// blocks refreshed: those using blocks and freshly imported ones, but not a
+// rule still on the deprecated actions JSON.
+func TestAlertRuleResource_actionsMapping(t *testing.T) {
+	result := &alertRuleResponse{
+		Rule:    alertRuleResponseBody{ID: "r1", SessionID: "s1"},
+		Actions: json.RawMessage(`[{"target": "email", "config": {"emails": ["festus@example.com"]}}]`),
+	}
+
+	imported := AlertRuleResourceModel{Actions: types.StringNull()}
+	mapAlertRuleResponseToState(&imported, result)
+	if len(imported.Action) != 1 || imported.Action[0].Target.ValueString() != "email" {
+		t.Errorf("expected an imported rule to get its action blocks, got %+v", imported.Action)
+	}
+
+	deprecated := AlertRuleResourceModel{Actions: types.StringValue(`[]`)}
+	mapAlertRuleResponseToState(&deprecated, result)
+	if len(deprecated.Action) != 0 {
+		t.Errorf("expected a rule on the actions JSON to keep its blocks empty, got %+v", deprecated.Action)
+	}
+	if deprecated.Actions.ValueString() != string(result.Actions) {
+		t.Errorf("expected actions to follow the API, got %s", deprecated.Actions)
+	}
+}
+
+// TestAlertRuleResource_actionScalars checks numbers and booleans in an
+// action's config survive the trip through the blocks, and a string that
+// reads like one stays a string.
+func TestAlertRuleResource_actionScalars(t *testing.T) {
+	raw := json.RawMessage(`[{"target": "webhook", "config": {"timeout": 5, "verify": true, "retries": "3", "url": "https://hooks.example.com"}}]`)
+	blocks, err := decomposeAlertRuleActions(raw)
+	if err != nil {
+		t.Fatalf("decomposing actions: %s", err)
+	}
+	back, err := buildAlertRuleActions(blocks)
+	if err != nil {
+		t.Fatalf("building actions: %s", err)
+	}
+	if !jsonSemanticallyEqual(string(back), string(raw)) {
+		t.Errorf("expected the actions to round-trip, got %s", back)
+	}
+}
+
+// TestAlertRuleResource_actionsLifecycle checks removing actions from the
+// config clears them, and that a rule imported for the actions JSON plans no
+// change against a config using it.
+func TestAlertRuleResource_actionsLifecycle(t *testing.T) {
+	rule := alertRuleResponse{Rule: alertRuleResponseBody{ID: "a1"}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/platform/alerts/s1",
+			r.Method == http.MethodPatch && r.URL.Path == "/v1/platform/alerts/s1/a1":
+			var body alertRuleRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			rule.Rule.Name, rule.Rule.Description, rule.Rule.Type = body.Rule.Name, body.Rule.Description, body.Rule.Type
+			rule.Rule.Aggregation, rule.Rule.Attribute, rule.Rule.Operator = body.Rule.Aggregation, body.Rule.Attribute, body.Rule.Operator
+			rule.Rule.WindowMinutes, rule.Rule.Threshold = body.Rule.WindowMinutes, body.Rule.Threshold
+			rule.Actions = body.Actions
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/platform/alerts/s1/a1":
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rule.Rule.SessionID = "s1"
+		_ = json.NewEncoder(w).Encode(rule)
+	}))
+	defer srv.Close()
+
+	config := map[string]tftypes.Value{
+		"session_id":     tftypes.NewValue(tftypes.String, "s1"),
+		"name":           tftypes.NewValue(tftypes.String, "slow"),
+		"description":    tftypes.NewValue(tftypes.String, "latency"),
+		"type":           tftypes.NewValue(tftypes.String, "threshold"),
+		"aggregation":    tftypes.NewValue(tftypes.String, "avg"),
+		"attribute":      tftypes.NewValue(tftypes.String, "latency"),
+		"operator":       tftypes.NewValue(tftypes.String, "gte"),
+		"window_minutes": tftypes.NewValue(tftypes.Number, 5),
+		"threshold":      tftypes.NewValue(tftypes.Number, 2.5),
+		"actions":        tftypes.NewValue(tftypes.String, `[{"target":"email","config":{"emails":["kitty@longbranch.example"]}}]`),
+	}
+	s := newTestResourceServer(t, srv.URL, "langsmith_alert_rule")
+	s.apply(config)
+	s.refresh()
+	if !s.planIsEmpty(config) {
+		t.Fatal("expected a rule on the actions JSON to plan no change")
+	}
+
+	// Importing for the JSON leaves the blocks empty, so nothing moves.
+	imported := testImportState(t, &AlertRuleResource{}, "s1/a1/actions")
+	if imported.Diagnostics.HasError() {
+		t.Fatalf("importing: %v", imported.Diagnostics)
+	}
+	var importedActions types.String
+	imported.State.GetAttribute(context.Background(), path.Root("actions"), &importedActions)
+	if importedActions.IsNull() {
+		t.Fatal("expected an import for the actions JSON to mark actions as known")
+	}
+	s.setState(testDynamicValue(t, s.schema.ValueType(), imported.State.Raw), nil)
+	s.refresh()
+	if blocks := s.attribute("action"); !blocks.IsNull() && blocks.String() != tftypes.NewValue(blocks.Type(), []tftypes.Value{}).String() {
+		t.Errorf("expected no action blocks after import, got %s", blocks)
+	}
+	if !s.planIsEmpty(config) {
+		t.Fatal("expected an imported rule on the actions JSON to plan no change")
+	}
+
+	delete(config, "actions")
+	if s.planIsEmpty(config) {
+		t.Fatal("expected removing actions to plan clearing them")
+	}
+	s.apply(config)
+	if string(rule.Actions) != "[]" {
+		t.Errorf("expected the API's actions to be cleared, got %s", rule.Actions)
+	}
+	s.refresh()
+	if !s.planIsEmpty(config) {
+		t.Error("expected cleared actions to plan no change")
+	}
+}
+
+// TestAlertRuleResource_actionsConflict checks action blocks and the
+// deprecated actions JSON can't be used together.
+func TestAlertRuleResource_actionsConflict(t *testing.T) {
+	actionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"target": tftypes.String,
+		"config": tftypes.Map{ElementType: tftypes.String},
+	}}
+	emailAction := tftypes.NewValue(actionType, map[string]tftypes.Value{
+		"target": tftypes.NewValue(tftypes.String, "email"),
+		"config": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"emails": tftypes.NewValue(tftypes.String, `["kitty@longbranch.example"]`),
+		}),
+	})
+
+	attrs := func() map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"session_id":     tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+			"name":           tftypes.NewValue(tftypes.String, "slow-runs"),
+			"description":    tftypes.NewValue(tftypes.String, "Latency is climbing"),
+			"type":           tftypes.NewValue(tftypes.String, "threshold"),
+			"aggregation":    tftypes.NewValue(tftypes.String, "avg"),
+			"attribute":      tftypes.NewValue(tftypes.String, "latency"),
+			"operator":       tftypes.NewValue(tftypes.String, "gte"),
+			"window_minutes": tftypes.NewValue(tftypes.Number, 5),
+			"threshold":      tftypes.NewValue(tftypes.Number, 5000),
+			"action":         tftypes.NewValue(tftypes.List{ElementType: actionType}, []tftypes.Value{emailAction}),
+		}
+	}
+
+	if diags := testValidateResourceConfig(t, "langsmith_alert_rule", attrs()); len(diags) != 0 {
+		t.Errorf("expected action blocks alone to be fine, got %v", diags)
+	}
+
+	both := attrs()
+	both["actions"] = tftypes.NewValue(tftypes.String, "[]")
+	diags := testValidateResourceConfig(t, "langsmith_alert_rule", both)
+	if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "Conflicting Alert Actions") {
+		t.Errorf("expected a conflict error, got %v", diags)
+	}
+
+	deprecated := attrs()
+	delete(deprecated, "action")
+	deprecated["actions"] = tftypes.NewValue(tftypes.String, "[]")
+	diags = testValidateResourceConfig(t, "langsmith_alert_rule", deprecated)
+	if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") || !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityWarning, "action blocks") {
+		t.Errorf("expected only a deprecation warning for actions, got %v", diags)
+	}
+}
+
+// TestAlertRuleResource_deleteGone checks the old rule in a create_before_destroy
+// replacement is deleted from its own project, and that a rule already gone
+// doesn't stop the replacement from finishing.
+func TestAlertRuleResource_deleteGone(t *testing.T) {
+	for name, status := range map[string]int{"present": http.StatusOK, "gone": http.StatusNotFound} {
+		t.Run(name, func(t *testing.T) {
+			var deleted string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+				}
+				deleted = r.URL.Path
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer srv.Close()
+
+			r := &AlertRuleResource{client: client.NewClient(srv.URL, "key", "")}
+			state := testResourceState(t, r, &AlertRuleResourceModel{
+				ID:        types.StringValue("a1"),
+				SessionID: types.StringValue("old-project"),
+			})
+			resp := &fwresource.DeleteResponse{}
+			r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("deleting: %v", resp.Diagnostics)
+			}
+			if deleted != "/v1/platform/alerts/old-project/a1" {
+				t.Errorf("expected the old project's rule to be deleted, got %s", deleted)
+			}
+		})
+	}
+}
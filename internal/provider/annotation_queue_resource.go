@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -23,8 +27,29 @@ import (
 )
 
 var (
-	_ resource.Resource                = &AnnotationQueueResource{}
-	_ resource.ResourceWithImportState = &AnnotationQueueResource{}
+	_ resource.Resource                   = &AnnotationQueueResource{}
+	_ resource.ResourceWithImportState    = &AnnotationQueueResource{}
+	_ resource.ResourceWithValidateConfig = &AnnotationQueueResource{}
+)
+
+// Rubric item types. Categories go with categorical items and min/max with
+// continuous ones; freeform items take neither.
+const (
+	rubricItemCategorical = "categorical"
+	rubricItemContinuous  = "continuous"
+	rubricItemFreeform    = "freeform"
+)
+
+// annotationQueueMinReservationMinutes is the shortest reservation we consider
+// long enough for a reviewer to actually finish an item.
+const annotationQueueMinReservationMinutes = 5
+
+// Defaults for the queue settings the API doesn't always echo back. The
+// schema plans them and the mapping falls back on them, so the two agree.
+const (
+	annotationQueueDefaultEnableReservations  = true
+	annotationQueueDefaultNumReviewersPerItem = 1
+	annotationQueueDefaultReservationMinutes  = 1
 )
 
 // NewAnnotationQueueResource returns a new AnnotationQueueResource, ready to
@@ -59,6 +84,31 @@ type AnnotationQueueResourceModel struct {
 	TenantID            types.String `tfsdk:"tenant_id"`
 	CreatedAt           types.String `tfsdk:"created_at"`
 	UpdatedAt           types.String `tfsdk:"updated_at"`
+
+	RubricItem  []annotationQueueRubricItemModel `tfsdk:"rubric_item"`
+	WorkspaceID types.String                     `tfsdk:"workspace_id"`
+}
+
+// annotationQueueRubricItemModel is one rubric_item block: a piece of
+// feedback reviewers are asked for.
+type annotationQueueRubricItemModel struct {
+	FeedbackKey  types.String  `tfsdk:"feedback_key"`
+	Type         types.String  `tfsdk:"type"`
+	Instructions types.String  `tfsdk:"instructions"`
+	Categories   types.List    `tfsdk:"categories"`
+	Min          types.Float64 `tfsdk:"min"`
+	Max          types.Float64 `tfsdk:"max"`
+}
+
+// annotationQueueRubricItem is how a rubric item travels in the API's
+// rubric_items list.
+type annotationQueueRubricItem struct {
+	FeedbackKey  string   `json:"feedback_key"`
+	Type         string   `json:"type,omitempty"`
+	Instructions *string  `json:"instructions,omitempty"`
+	Categories   []string `json:"categories,omitempty"`
+	Min          *float64 `json:"min,omitempty"`
+	Max          *float64 `json:"max,omitempty"`
 }
 
 // annotationQueueAPIRequest is the request body for creating/updating an annotation queue.
@@ -102,6 +152,7 @@ func (r *AnnotationQueueResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith annotation queue.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the annotation queue.",
 				Computed:            true,
@@ -121,19 +172,19 @@ func (r *AnnotationQueueResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "Whether to enable reservations for the annotation queue.",
 				Optional:            true,
 				Computed:            true,
-				Default:             booldefault.StaticBool(true),
+				Default:             booldefault.StaticBool(annotationQueueDefaultEnableReservations),
 			},
 			"num_reviewers_per_item": schema.Int64Attribute{
 				MarkdownDescription: "The number of reviewers per item in the queue.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
+				Default:             int64default.StaticInt64(annotationQueueDefaultNumReviewersPerItem),
 			},
 			"reservation_minutes": schema.Int64Attribute{
-				MarkdownDescription: "The number of minutes a reservation is held.",
+				MarkdownDescription: "The number of minutes a reservation is held. Defaults to `1`; a warning is raised when it's set under `5` while reservations are enabled, since reviewers rarely finish an item that quickly.",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
+				Default:             int64default.StaticInt64(annotationQueueDefaultReservationMinutes),
 			},
 			"default_dataset": schema.StringAttribute{
 				MarkdownDescription: "The UUID of the default dataset for the annotation queue.",
@@ -144,12 +195,23 @@ func (r *AnnotationQueueResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 			},
 			"rubric_items": schema.StringAttribute{
-				MarkdownDescription: "JSON-encoded array of rubric items for the annotation queue.",
-				Optional:            true,
+				MarkdownDescription: "JSON-encoded array of rubric items for the annotation queue. " +
+					"Deprecated: use `rubric_item` blocks instead. Always reflects the rubric items the API holds, however they were configured; " +
+					"removing it along with every `rubric_item` block clears the queue's rubric items.",
+				DeprecationMessage: "Use rubric_item blocks instead. The rubric_items attribute will be removed in a future major version.",
+				Optional:           true,
+				Computed:           true,
+				PlanModifiers: []planmodifier.String{
+					clearedWithoutBlocks("rubric_item", types.StringNull()),
+					jsonNormalize(),
+				},
 			},
 			"metadata": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded metadata object.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"source_rule_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the source rule that created this queue.",
@@ -182,6 +244,46 @@ func (r *AnnotationQueueResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"rubric_item": schema.ListNestedBlock{
+				MarkdownDescription: "A piece of feedback reviewers are asked for on each item. Repeat the block for several; leave it out, along with `rubric_items`, for a queue with no rubric.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"feedback_key": schema.StringAttribute{
+							MarkdownDescription: "The feedback key reviewers' scores are recorded under, such as `correctness`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The kind of feedback: `categorical` (pick one of `categories`), `continuous` (a score between `min` and `max`), or `freeform` (a comment).",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(rubricItemCategorical, rubricItemContinuous, rubricItemFreeform),
+							},
+						},
+						"instructions": schema.StringAttribute{
+							MarkdownDescription: "What reviewers should look for when giving this feedback.",
+							Optional:            true,
+						},
+						"categories": schema.ListAttribute{
+							MarkdownDescription: "The choices for a `categorical` item.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"min": schema.Float64Attribute{
+							MarkdownDescription: "The lowest score for a `continuous` item.",
+							Optional:            true,
+						},
+						"max": schema.Float64Attribute{
+							MarkdownDescription: "The highest score for a `continuous` item.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -190,16 +292,16 @@ func (r *AnnotationQueueResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *AnnotationQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -209,6 +311,8 @@ func (r *AnnotationQueueResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := annotationQueueAPIRequest{
 		Name: data.Name.ValueString(),
 	}
@@ -238,21 +342,22 @@ func (r *AnnotationQueueResource) Create(ctx context.Context, req resource.Creat
 		body.RubricInstructions = &v
 	}
 	// Rubric items and metadata ride along as raw JSON -- no need to break 'em in.
-	if !data.RubricItems.IsNull() && !data.RubricItems.IsUnknown() {
-		body.RubricItems = json.RawMessage(data.RubricItems.ValueString())
-	}
+	body.RubricItems = annotationQueueRubricItemsJSON(&data)
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
 		body.Metadata = json.RawMessage(data.Metadata.ValueString())
 	}
 
 	var result annotationQueueAPIResponse
-	err := r.client.Post(ctx, "/api/v1/annotation-queues", body, &result)
+	err := r.client.Create(ctx, "/api/v1/annotation-queues", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating annotation queue", err.Error())
 		return
 	}
 
+	// Blocks can't be computed; keep them as planned so apply stays consistent.
+	rubricBlocks := data.RubricItem
 	mapAnnotationQueueResponseToState(&data, &result)
+	data.RubricItem = rubricBlocks
 	tflog.Trace(ctx, "created annotation queue resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -265,6 +370,8 @@ func (r *AnnotationQueueResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result annotationQueueAPIResponse
 	err := r.client.Get(ctx, "/api/v1/annotation-queues/"+data.ID.ValueString(), nil, &result)
 	if err != nil {
@@ -288,58 +395,34 @@ func (r *AnnotationQueueResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	body := annotationQueueAPIRequest{
-		Name: data.Name.ValueString(),
-	}
+	ctx = withWorkspace(ctx, data.WorkspaceID)
 
-	if !data.Description.IsNull() && !data.Description.IsUnknown() {
-		v := data.Description.ValueString()
-		body.Description = &v
-	}
-	if !data.EnableReservations.IsNull() && !data.EnableReservations.IsUnknown() {
-		v := data.EnableReservations.ValueBool()
-		body.EnableReservations = &v
-	}
-	if !data.NumReviewersPerItem.IsNull() && !data.NumReviewersPerItem.IsUnknown() {
-		v := data.NumReviewersPerItem.ValueInt64()
-		body.NumReviewersPerItem = &v
-	}
-	if !data.ReservationMinutes.IsNull() && !data.ReservationMinutes.IsUnknown() {
-		v := data.ReservationMinutes.ValueInt64()
-		body.ReservationMinutes = &v
-	}
-	if !data.DefaultDataset.IsNull() && !data.DefaultDataset.IsUnknown() {
-		v := data.DefaultDataset.ValueString()
-		body.DefaultDataset = &v
-	}
-	if !data.RubricInstructions.IsNull() && !data.RubricInstructions.IsUnknown() {
-		v := data.RubricInstructions.ValueString()
-		body.RubricInstructions = &v
-	}
-	// Same as Create -- hitch up the raw JSON fields for the ride to the API.
-	if !data.RubricItems.IsNull() && !data.RubricItems.IsUnknown() {
-		body.RubricItems = json.RawMessage(data.RubricItems.ValueString())
-	}
-	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
-		body.Metadata = json.RawMessage(data.Metadata.ValueString())
+	var state AnnotationQueueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.Patch(ctx, "/api/v1/annotation-queues/"+data.ID.ValueString(), body, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating annotation queue", err.Error())
-		return
+	if body := annotationQueueUpdateBody(&data, &state); len(body) > 0 {
+		err := r.client.Patch(ctx, "/api/v1/annotation-queues/"+data.ID.ValueString(), body, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating annotation queue", err.Error())
+			return
+		}
 	}
 
 	// The PATCH response only returns {"message": "..."}, not the full resource.
 	// Like Festus reporting back with half the story, we need to go get the rest ourselves.
 	var result annotationQueueAPIResponse
-	err = r.client.Get(ctx, "/api/v1/annotation-queues/"+data.ID.ValueString(), nil, &result)
+	err := r.client.Get(ctx, "/api/v1/annotation-queues/"+data.ID.ValueString(), nil, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading annotation queue after update", err.Error())
 		return
 	}
 
+	rubricBlocks := data.RubricItem
 	mapAnnotationQueueResponseToState(&data, &result)
+	data.RubricItem = rubricBlocks
 	tflog.Trace(ctx, "updated annotation queue resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -352,6 +435,8 @@ func (r *AnnotationQueueResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	q := url.Values{}
 	q.Set("queue_ids", data.ID.ValueString())
 	err := r.client.DeleteWithQuery(ctx, "/api/v1/annotation-queues", q)
@@ -363,12 +448,246 @@ func (r *AnnotationQueueResource) Delete(ctx context.Context, req resource.Delet
 	tflog.Trace(ctx, "deleted annotation queue resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+// ValidateConfig catches rubric items that can't be right, and flags
+// reservation settings that probably don't mean what the user thinks: a
+// reservation too short to be useful, or reservation settings on a queue with
+// reservations turned off. Those are legal, so they draw warnings rather than
+// errors, and only for values the user set; a queue left on the defaults
+// gets none.
+func (r *AnnotationQueueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateAnnotationQueueRubric(ctx, req, &resp.Diagnostics)
+
+	// Attributes are read one by one; the whole model can't take rubric_item
+	// blocks that aren't known yet.
+	var data AnnotationQueueResourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enable_reservations"), &data.EnableReservations)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("reservation_minutes"), &data.ReservationMinutes)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("num_reviewers_per_item"), &data.NumReviewersPerItem)...)
+	if resp.Diagnostics.HasError() || data.EnableReservations.IsUnknown() || data.ReservationMinutes.IsUnknown() {
+		return
+	}
+
+	enabled := annotationQueueDefaultEnableReservations
+	if !data.EnableReservations.IsNull() {
+		enabled = data.EnableReservations.ValueBool()
+	}
+	if !enabled {
+		if !data.ReservationMinutes.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("reservation_minutes"),
+				"Reservation Setting Has No Effect",
+				"reservation_minutes is set but enable_reservations is false, so items are never reserved. "+
+					"Remove reservation_minutes or enable reservations.",
+			)
+		}
+		if !data.NumReviewersPerItem.IsNull() && !data.NumReviewersPerItem.IsUnknown() && data.NumReviewersPerItem.ValueInt64() != annotationQueueDefaultNumReviewersPerItem {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("num_reviewers_per_item"),
+				"Reservation Setting Has No Effect",
+				"num_reviewers_per_item is set but enable_reservations is false, so reviewers aren't held to a per-item count. "+
+					"Remove num_reviewers_per_item or enable reservations.",
+			)
+		}
+		return
+	}
+
+	if data.ReservationMinutes.IsNull() {
+		return
+	}
+	if minutes := data.ReservationMinutes.ValueInt64(); minutes < annotationQueueMinReservationMinutes {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("reservation_minutes"),
+			"Short Reservation",
+			fmt.Sprintf("Reservations are enabled with reservation_minutes = %d, which is rarely long enough for a reviewer to finish an item "+
+				"before it's released to someone else. Consider setting reservation_minutes to at least %d.",
+				minutes, annotationQueueMinReservationMinutes),
+		)
+	}
+}
+
+// validateAnnotationQueueRubric turns away rubric items that are given both
+// ways, or whose settings don't fit their type.
+func validateAnnotationQueueRubric(ctx context.Context, req resource.ValidateConfigRequest, diags *diag.Diagnostics) {
+	var legacy types.String
+	var blocks types.List
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("rubric_items"), &legacy)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("rubric_item"), &blocks)...)
+	if diags.HasError() || blocks.IsNull() || blocks.IsUnknown() {
+		return
+	}
+
+	if !legacy.IsNull() && len(blocks.Elements()) > 0 {
+		diags.AddAttributeError(
+			path.Root("rubric_items"),
+			"Conflicting Rubric Items",
+			"Set either rubric_item blocks or the deprecated rubric_items attribute, not both.",
+		)
+		return
+	}
+
+	var items []annotationQueueRubricItemModel
+	diags.Append(blocks.ElementsAs(ctx, &items, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	for i, item := range items {
+		at := path.Root("rubric_item").AtListIndex(i)
+		if item.Type.IsUnknown() {
+			continue
+		}
+		itemType := item.Type.ValueString()
+
+		if itemType == rubricItemCategorical {
+			if item.Categories.IsNull() || (!item.Categories.IsUnknown() && len(item.Categories.Elements()) == 0) {
+				diags.AddAttributeError(at.AtName("categories"), "Invalid Rubric Item",
+					fmt.Sprintf("Rubric item %q is categorical, so it needs at least one category.", item.FeedbackKey.ValueString()))
+			}
+		} else if !item.Categories.IsNull() {
+			diags.AddAttributeError(at.AtName("categories"), "Invalid Rubric Item",
+				fmt.Sprintf("Rubric item %q is %s; categories only apply to categorical items.", item.FeedbackKey.ValueString(), itemType))
+		}
+
+		if itemType != rubricItemContinuous {
+			if !item.Min.IsNull() || !item.Max.IsNull() {
+				diags.AddAttributeError(at, "Invalid Rubric Item",
+					fmt.Sprintf("Rubric item %q is %s; min and max only apply to continuous items.", item.FeedbackKey.ValueString(), itemType))
+			}
+			continue
+		}
+		if !item.Min.IsNull() && !item.Min.IsUnknown() && !item.Max.IsNull() && !item.Max.IsUnknown() &&
+			item.Min.ValueFloat64() >= item.Max.ValueFloat64() {
+			diags.AddAttributeError(at.AtName("max"), "Invalid Rubric Item",
+				fmt.Sprintf("Rubric item %q has min %g and max %g; max must be greater than min.",
+					item.FeedbackKey.ValueString(), item.Min.ValueFloat64(), item.Max.ValueFloat64()))
+		}
+	}
+}
+
+// annotationQueueUpdateBody builds a PATCH body holding only what changed
+// between state and plan, so a one-field edit can't reset the rest of the
+// queue's settings. An optional field dropped from the configuration is sent
+// as null to clear it, and rubric items that are all gone as an empty list.
+func annotationQueueUpdateBody(plan, state *AnnotationQueueResourceModel) map[string]interface{} {
+	body := map[string]interface{}{}
+
+	if !plan.Name.Equal(state.Name) {
+		body["name"] = plan.Name.ValueString()
+	}
+	if !plan.Description.Equal(state.Description) && !plan.Description.IsUnknown() {
+		body["description"] = plan.Description.ValueStringPointer()
+	}
+	if !plan.EnableReservations.Equal(state.EnableReservations) && !plan.EnableReservations.IsUnknown() {
+		body["enable_reservations"] = plan.EnableReservations.ValueBoolPointer()
+	}
+	if !plan.NumReviewersPerItem.Equal(state.NumReviewersPerItem) && !plan.NumReviewersPerItem.IsUnknown() {
+		body["num_reviewers_per_item"] = plan.NumReviewersPerItem.ValueInt64Pointer()
+	}
+	if !plan.ReservationMinutes.Equal(state.ReservationMinutes) && !plan.ReservationMinutes.IsUnknown() {
+		body["reservation_minutes"] = plan.ReservationMinutes.ValueInt64Pointer()
+	}
+	if !plan.DefaultDataset.Equal(state.DefaultDataset) && !plan.DefaultDataset.IsUnknown() {
+		body["default_dataset"] = plan.DefaultDataset.ValueStringPointer()
+	}
+	if !plan.RubricInstructions.Equal(state.RubricInstructions) && !plan.RubricInstructions.IsUnknown() {
+		body["rubric_instructions"] = plan.RubricInstructions.ValueStringPointer()
+	}
+	// Same as Create -- hitch up the raw JSON fields for the ride to the API.
+	items, previous := annotationQueueRubricItemsJSON(plan), annotationQueueRubricItemsJSON(state)
+	switch {
+	case items != nil:
+		if previous == nil || !jsonSemanticallyEqual(string(items), string(previous)) {
+			body["rubric_items"] = items
+		}
+	case previous != nil && len(plan.RubricItem) == 0 && plan.RubricItems.IsNull():
+		body["rubric_items"] = json.RawMessage("[]")
+	}
+	if !plan.Metadata.Equal(state.Metadata) && !plan.Metadata.IsUnknown() {
+		if plan.Metadata.IsNull() {
+			body["metadata"] = nil
+		} else {
+			body["metadata"] = json.RawMessage(plan.Metadata.ValueString())
+		}
+	}
+
+	return body
+}
+
+// annotationQueueRubricItemsJSON picks the rubric items to send: built from
+// rubric_item blocks when there are any, otherwise the deprecated JSON as
+// given.
+func annotationQueueRubricItemsJSON(data *AnnotationQueueResourceModel) json.RawMessage {
+	if len(data.RubricItem) > 0 {
+		items := make([]annotationQueueRubricItem, 0, len(data.RubricItem))
+		for _, block := range data.RubricItem {
+			item := annotationQueueRubricItem{
+				FeedbackKey:  block.FeedbackKey.ValueString(),
+				Type:         block.Type.ValueString(),
+				Instructions: block.Instructions.ValueStringPointer(),
+				Min:          block.Min.ValueFloat64Pointer(),
+				Max:          block.Max.ValueFloat64Pointer(),
+			}
+			for _, category := range block.Categories.Elements() {
+				if str, ok := category.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
+					item.Categories = append(item.Categories, str.ValueString())
+				}
+			}
+			items = append(items, item)
+		}
+		// Plain strings and numbers always marshal.
+		raw, _ := json.Marshal(items)
+		return raw
+	}
+	if !data.RubricItems.IsNull() && !data.RubricItems.IsUnknown() {
+		return json.RawMessage(data.RubricItems.ValueString())
+	}
+	return nil
+}
+
+// decomposeAnnotationQueueRubricItems breaks the API's rubric items back down
+// into typed blocks, the reverse of annotationQueueRubricItemsJSON.
+func decomposeAnnotationQueueRubricItems(raw json.RawMessage) ([]annotationQueueRubricItemModel, error) {
+	var items []annotationQueueRubricItem
+	if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+	}
+
+	blocks := make([]annotationQueueRubricItemModel, 0, len(items))
+	for _, item := range items {
+		block := annotationQueueRubricItemModel{
+			FeedbackKey:  types.StringValue(item.FeedbackKey),
+			Type:         types.StringValue(item.Type),
+			Instructions: types.StringPointerValue(item.Instructions),
+			Categories:   types.ListNull(types.StringType),
+			Min:          types.Float64PointerValue(item.Min),
+			Max:          types.Float64PointerValue(item.Max),
+		}
+		if item.Type == "" {
+			block.Type = types.StringNull()
+		}
+		if item.Categories != nil {
+			categories := make([]attr.Value, 0, len(item.Categories))
+			for _, category := range item.Categories {
+				categories = append(categories, types.StringValue(category))
+			}
+			block.Categories = types.ListValueMust(types.StringType, categories)
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
 func (r *AnnotationQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
 // mapAnnotationQueueResponseToState maps the API response onto the Terraform state,
-// setting null for any optional fields the API left unspoken.
+// setting null for any optional fields the API left unspoken -- save the queue
+// settings, which have defaults to fall back on.
 func mapAnnotationQueueResponseToState(data *AnnotationQueueResourceModel, result *annotationQueueAPIResponse) {
 	data.ID = types.StringValue(result.ID)
 	data.Name = types.StringValue(result.Name)
@@ -379,22 +698,24 @@ func mapAnnotationQueueResponseToState(data *AnnotationQueueResourceModel, resul
 		data.Description = types.StringNull()
 	}
 
+	// Some responses leave the queue settings out. What was planned still
+	// stands then, and an import with nothing planned gets the defaults.
 	if result.EnableReservations != nil {
 		data.EnableReservations = types.BoolValue(*result.EnableReservations)
-	} else {
-		data.EnableReservations = types.BoolNull()
+	} else if data.EnableReservations.IsNull() || data.EnableReservations.IsUnknown() {
+		data.EnableReservations = types.BoolValue(annotationQueueDefaultEnableReservations)
 	}
 
 	if result.NumReviewersPerItem != nil {
 		data.NumReviewersPerItem = types.Int64Value(*result.NumReviewersPerItem)
-	} else {
-		data.NumReviewersPerItem = types.Int64Null()
+	} else if data.NumReviewersPerItem.IsNull() || data.NumReviewersPerItem.IsUnknown() {
+		data.NumReviewersPerItem = types.Int64Value(annotationQueueDefaultNumReviewersPerItem)
 	}
 
 	if result.ReservationMinutes != nil {
 		data.ReservationMinutes = types.Int64Value(*result.ReservationMinutes)
-	} else {
-		data.ReservationMinutes = types.Int64Null()
+	} else if data.ReservationMinutes.IsNull() || data.ReservationMinutes.IsUnknown() {
+		data.ReservationMinutes = types.Int64Value(annotationQueueDefaultReservationMinutes)
 	}
 
 	if result.DefaultDataset != nil {
@@ -409,6 +730,15 @@ func mapAnnotationQueueResponseToState(data *AnnotationQueueResourceModel, resul
 		data.RubricInstructions = types.StringNull()
 	}
 
+	// Typed blocks are refreshed when they're in use, or on import, when
+	// nothing is known yet. A queue still on the deprecated JSON keeps its
+	// blocks empty.
+	if len(data.RubricItem) > 0 || data.RubricItems.IsNull() {
+		if blocks, err := decomposeAnnotationQueueRubricItems(result.RubricItems); err == nil {
+			data.RubricItem = blocks
+		}
+	}
+
 	// Rubric items and metadata come back as raw JSON -- round 'em up carefully
 	// so Terraform don't report phantom drift on empty corrals.
 	if len(result.RubricItems) > 0 && string(result.RubricItems) != "null" && string(result.RubricItems) != "[]" {
@@ -4,9 +4,14 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -72,3 +77,320 @@ resource "langsmith_annotation_queue" "test" {
 }
 `, name)
 }
+
+// TestAnnotationQueueResource_reservations checks that too-short reservations
+// and reservation settings on a queue without reservations draw warnings, but
+// only when they're set; the defaults draw none.
+func TestAnnotationQueueResource_reservations(t *testing.T) {
+	cases := map[string]struct {
+		attrs       map[string]tftypes.Value
+		wantWarning string
+	}{
+		"defaults": {
+			attrs: map[string]tftypes.Value{},
+		},
+		"explicit short reservation": {
+			attrs: map[string]tftypes.Value{
+				"enable_reservations": tftypes.NewValue(tftypes.Bool, true),
+				"reservation_minutes": tftypes.NewValue(tftypes.Number, 2),
+			},
+			wantWarning: "Short Reservation",
+		},
+		"reasonable reservation": {
+			attrs: map[string]tftypes.Value{
+				"reservation_minutes": tftypes.NewValue(tftypes.Number, 15),
+			},
+		},
+		"reservations disabled": {
+			attrs: map[string]tftypes.Value{
+				"enable_reservations": tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+		"minutes without reservations": {
+			attrs: map[string]tftypes.Value{
+				"enable_reservations": tftypes.NewValue(tftypes.Bool, false),
+				"reservation_minutes": tftypes.NewValue(tftypes.Number, 15),
+			},
+			wantWarning: "reservation_minutes",
+		},
+		"reviewers without reservations": {
+			attrs: map[string]tftypes.Value{
+				"enable_reservations":    tftypes.NewValue(tftypes.Bool, false),
+				"num_reviewers_per_item": tftypes.NewValue(tftypes.Number, 2),
+			},
+			wantWarning: "num_reviewers_per_item",
+		},
+		"default reviewers without reservations": {
+			attrs: map[string]tftypes.Value{
+				"enable_reservations":    tftypes.NewValue(tftypes.Bool, false),
+				"num_reviewers_per_item": tftypes.NewValue(tftypes.Number, annotationQueueDefaultNumReviewersPerItem),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["name"] = tftypes.NewValue(tftypes.String, "review-posse")
+			diags := testValidateResourceConfig(t, "langsmith_annotation_queue", tc.attrs)
+			if tc.wantWarning == "" {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityWarning, tc.wantWarning) {
+				t.Errorf("expected a warning mentioning %q, got %v", tc.wantWarning, diags)
+			}
+			if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") {
+				t.Errorf("expected only warnings, got %v", diags)
+			}
+		})
+	}
+}
+
+// testRubricItemType is the Terraform type of a rubric_item block.
+var testRubricItemType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"feedback_key": tftypes.String,
+	"type":         tftypes.String,
+	"instructions": tftypes.String,
+	"categories":   tftypes.List{ElementType: tftypes.String},
+	"min":          tftypes.Number,
+	"max":          tftypes.Number,
+}}
+
+// testRubricItem builds a rubric_item block value; nil arguments are unset.
+func testRubricItem(key, itemType string, categories []string, minScore, maxScore *float64) tftypes.Value {
+	catsValue := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+	if categories != nil {
+		cats := []tftypes.Value{}
+		for _, c := range categories {
+			cats = append(cats, tftypes.NewValue(tftypes.String, c))
+		}
+		catsValue = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, cats)
+	}
+	bound := func(v *float64) tftypes.Value {
+		if v == nil {
+			return tftypes.NewValue(tftypes.Number, nil)
+		}
+		return tftypes.NewValue(tftypes.Number, *v)
+	}
+	return tftypes.NewValue(testRubricItemType, map[string]tftypes.Value{
+		"feedback_key": tftypes.NewValue(tftypes.String, key),
+		"type":         tftypes.NewValue(tftypes.String, itemType),
+		"instructions": tftypes.NewValue(tftypes.String, nil),
+		"categories":   catsValue,
+		"min":          bound(minScore),
+		"max":          bound(maxScore),
+	})
+}
+
+// TestAnnotationQueueResource_rubricItemValidation checks malformed rubric
+// items are caught at plan time, and that blocks and the deprecated JSON
+// can't both be set.
+func TestAnnotationQueueResource_rubricItemValidation(t *testing.T) {
+	zero, one, ten := 0.0, 1.0, 10.0
+
+	cases := map[string]struct {
+		items   []tftypes.Value
+		legacy  bool
+		wantErr string
+	}{
+		"well formed": {
+			items: []tftypes.Value{
+				testRubricItem("verdict", "categorical", []string{"guilty", "innocent"}, nil, nil),
+				testRubricItem("correctness", "continuous", nil, &zero, &one),
+				testRubricItem("notes", "freeform", nil, nil, nil),
+			},
+		},
+		"categorical without categories": {
+			items:   []tftypes.Value{testRubricItem("verdict", "categorical", nil, nil, nil)},
+			wantErr: "at least one category",
+		},
+		"categories on a continuous item": {
+			items:   []tftypes.Value{testRubricItem("correctness", "continuous", []string{"yes"}, nil, nil)},
+			wantErr: "categories only apply",
+		},
+		"bounds on a freeform item": {
+			items:   []tftypes.Value{testRubricItem("notes", "freeform", nil, nil, &ten)},
+			wantErr: "min and max only apply",
+		},
+		"min above max": {
+			items:   []tftypes.Value{testRubricItem("correctness", "continuous", nil, &ten, &one)},
+			wantErr: "max must be greater than min",
+		},
+		"unknown type": {
+			items:   []tftypes.Value{testRubricItem("correctness", "stars", nil, nil, nil)},
+			wantErr: "type",
+		},
+		"blocks and json": {
+			items:   []tftypes.Value{testRubricItem("notes", "freeform", nil, nil, nil)},
+			legacy:  true,
+			wantErr: "Conflicting Rubric Items",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attrs := map[string]tftypes.Value{
+				"name":                tftypes.NewValue(tftypes.String, "review-posse"),
+				"reservation_minutes": tftypes.NewValue(tftypes.Number, 15),
+				"rubric_item":         tftypes.NewValue(tftypes.List{ElementType: testRubricItemType}, tc.items),
+			}
+			if tc.legacy {
+				attrs["rubric_items"] = tftypes.NewValue(tftypes.String, `[{"feedback_key": "notes"}]`)
+			}
+			diags := testValidateResourceConfig(t, "langsmith_annotation_queue", attrs)
+			if tc.wantErr == "" {
+				if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") {
+					t.Errorf("expected no errors, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestAnnotationQueueResource_rubricItemBlocks checks rubric_item blocks are
+// sent as the API's rubric_items JSON and read back into the same blocks.
+func TestAnnotationQueueResource_rubricItemBlocks(t *testing.T) {
+	minScore, maxScore := 0.0, 5.0
+	blocks := []annotationQueueRubricItemModel{
+		{
+			FeedbackKey:  types.StringValue("verdict"),
+			Type:         types.StringValue("categorical"),
+			Instructions: types.StringValue("Would the answer hold up in front of Judge Brooker?"),
+			Categories:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("guilty"), types.StringValue("innocent")}),
+			Min:          types.Float64Null(),
+			Max:          types.Float64Null(),
+		},
+		{
+			FeedbackKey:  types.StringValue("correctness"),
+			Type:         types.StringValue("continuous"),
+			Instructions: types.StringNull(),
+			Categories:   types.ListNull(types.StringType),
+			Min:          types.Float64Value(minScore),
+			Max:          types.Float64Value(maxScore),
+		},
+	}
+
+	raw := annotationQueueRubricItemsJSON(&AnnotationQueueResourceModel{RubricItem: blocks, RubricItems: types.StringNull()})
+	if !jsonSemanticallyEqual(string(raw), `[
+		{"feedback_key": "verdict", "type": "categorical", "instructions": "Would the answer hold up in front of Judge Brooker?", "categories": ["guilty", "innocent"]},
+		{"feedback_key": "correctness", "type": "continuous", "min": 0, "max": 5}
+	]`) {
+		t.Errorf("unexpected rubric items JSON: %s", raw)
+	}
+
+	back, err := decomposeAnnotationQueueRubricItems(raw)
+	if err != nil {
+		t.Fatalf("decomposing rubric items: %s", err)
+	}
+	if len(back) != len(blocks) {
+		t.Fatalf("expected %d rubric items, got %+v", len(blocks), back)
+	}
+	for i := range blocks {
+		if !back[i].FeedbackKey.Equal(blocks[i].FeedbackKey) || !back[i].Type.Equal(blocks[i].Type) ||
+			!back[i].Instructions.Equal(blocks[i].Instructions) || !back[i].Categories.Equal(blocks[i].Categories) ||
+			!back[i].Min.Equal(blocks[i].Min) || !back[i].Max.Equal(blocks[i].Max) {
+			t.Errorf("rubric item %d: expected %+v, got %+v", i, blocks[i], back[i])
+		}
+	}
+
+	legacy := annotationQueueRubricItemsJSON(&AnnotationQueueResourceModel{RubricItems: types.StringValue(`[{"feedback_key": "notes"}]`)})
+	if string(legacy) != `[{"feedback_key": "notes"}]` {
+		t.Errorf("expected the deprecated JSON to be sent as given, got %s", legacy)
+	}
+}
+
+// TestAnnotationQueueResource_partialResponse checks queue settings the API
+// leaves out of its answer keep their planned values, and fall back to the
+// schema defaults on import, rather than turning null mid-apply.
+func TestAnnotationQueueResource_partialResponse(t *testing.T) {
+	result := &annotationQueueAPIResponse{ID: "q1", Name: "review"}
+
+	planned := AnnotationQueueResourceModel{
+		EnableReservations:  types.BoolValue(false),
+		NumReviewersPerItem: types.Int64Value(3),
+		ReservationMinutes:  types.Int64Value(15),
+	}
+	mapAnnotationQueueResponseToState(&planned, result)
+	if planned.EnableReservations.ValueBool() || planned.NumReviewersPerItem.ValueInt64() != 3 || planned.ReservationMinutes.ValueInt64() != 15 {
+		t.Errorf("expected the planned settings to stand, got %+v", planned)
+	}
+
+	imported := AnnotationQueueResourceModel{
+		EnableReservations:  types.BoolNull(),
+		NumReviewersPerItem: types.Int64Null(),
+		ReservationMinutes:  types.Int64Null(),
+	}
+	mapAnnotationQueueResponseToState(&imported, result)
+	if !imported.EnableReservations.ValueBool() || imported.NumReviewersPerItem.ValueInt64() != 1 || imported.ReservationMinutes.ValueInt64() != 1 {
+		t.Errorf("expected the schema defaults on import, got %+v", imported)
+	}
+
+	three := int64(3)
+	mapAnnotationQueueResponseToState(&imported, &annotationQueueAPIResponse{ID: "q1", Name: "review", NumReviewersPerItem: &three})
+	if imported.NumReviewersPerItem.ValueInt64() != 3 {
+		t.Errorf("expected the API's answer to win when given, got %s", imported.NumReviewersPerItem)
+	}
+}
+
+// TestAnnotationQueueResource_updateBody checks an update sends only what
+// changed, so a description edit leaves the reviewer settings alone, that a
+// dropped field is cleared with a null, and that dropped rubric items are
+// cleared with an empty list.
+func TestAnnotationQueueResource_updateBody(t *testing.T) {
+	state := AnnotationQueueResourceModel{
+		ID:                  types.StringValue("q1"),
+		Name:                types.StringValue("review"),
+		Description:         types.StringValue("first pass"),
+		EnableReservations:  types.BoolValue(true),
+		NumReviewersPerItem: types.Int64Value(3),
+		ReservationMinutes:  types.Int64Value(15),
+		DefaultDataset:      types.StringValue("d1"),
+		RubricItems:         types.StringValue(`[{"feedback_key": "correctness"}]`),
+		Metadata:            types.StringNull(),
+	}
+
+	plan := state
+	plan.Description = types.StringValue("second pass")
+	plan.RubricItems = types.StringValue(`[{"feedback_key":"correctness"}]`)
+	raw, err := json.Marshal(annotationQueueUpdateBody(&plan, &state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `{"description":"second pass"}` {
+		t.Errorf("expected only the description, got %s", raw)
+	}
+
+	plan = state
+	plan.DefaultDataset = types.StringNull()
+	plan.NumReviewersPerItem = types.Int64Value(2)
+	raw, err = json.Marshal(annotationQueueUpdateBody(&plan, &state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `{"default_dataset":null,"num_reviewers_per_item":2}` {
+		t.Errorf("expected the reviewer count and a cleared dataset, got %s", raw)
+	}
+
+	plan = state
+	plan.RubricItems = types.StringNull()
+	plan.EnableReservations = types.BoolNull()
+	plan.NumReviewersPerItem = types.Int64Null()
+	plan.ReservationMinutes = types.Int64Null()
+	raw, err = json.Marshal(annotationQueueUpdateBody(&plan, &state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !jsonSemanticallyEqual(string(raw), `{"rubric_items":[],"enable_reservations":null,"num_reviewers_per_item":null,"reservation_minutes":null}`) {
+		t.Errorf("expected the rubric items and reviewer settings to be cleared, got %s", raw)
+	}
+
+	if body := annotationQueueUpdateBody(&state, &state); len(body) != 0 {
+		t.Errorf("expected nothing to send, got %v", body)
+	}
+}
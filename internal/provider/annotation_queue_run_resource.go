@@ -0,0 +1,291 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &AnnotationQueueRunResource{}
+	_ resource.ResourceWithImportState = &AnnotationQueueRunResource{}
+)
+
+// NewAnnotationQueueRunResource returns a resource for putting a single run
+// in front of an annotation queue's reviewers -- a case on the docket, with
+// the judge's name pencilled in if you like.
+func NewAnnotationQueueRunResource() resource.Resource {
+	return &AnnotationQueueRunResource{}
+}
+
+// AnnotationQueueRunResource manages one run's place in an annotation queue.
+type AnnotationQueueRunResource struct {
+	client *client.Client
+}
+
+// AnnotationQueueRunResourceModel maps the Terraform schema for a run in an
+// annotation queue.
+type AnnotationQueueRunResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	QueueID        types.String `tfsdk:"queue_id"`
+	RunID          types.String `tfsdk:"run_id"`
+	AssigneeUserID types.String `tfsdk:"assignee_user_id"`
+	ItemID         types.String `tfsdk:"item_id"`
+	AddedAt        types.String `tfsdk:"added_at"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
+}
+
+// annotationQueueRunRequest is one entry sent to POST
+// /api/v1/annotation-queues/{id}/runs.
+type annotationQueueRunRequest struct {
+	RunID          string  `json:"run_id"`
+	AssigneeUserID *string `json:"assignee_user_id,omitempty"`
+}
+
+// annotationQueueRunUpdateRequest is sent to PATCH
+// /api/v1/annotation-queues/{id}/runs/{item_id}. A null assignee takes the
+// run off whoever had it.
+type annotationQueueRunUpdateRequest struct {
+	AssigneeUserID *string `json:"assignee_user_id"`
+}
+
+// annotationQueueRunAPIResponse is the shape of a queue item from the API.
+type annotationQueueRunAPIResponse struct {
+	ID             string  `json:"id"`
+	RunID          string  `json:"run_id"`
+	AssigneeUserID *string `json:"assignee_user_id"`
+	AddedAt        *string `json:"added_at"`
+}
+
+func (r *AnnotationQueueRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotation_queue_run"
+}
+
+func (r *AnnotationQueueRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a run to a LangSmith annotation queue, optionally assigned to a reviewer. Destroying the resource takes the run back out of the queue; the run itself is left alone.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the queued run, in the form `queue_id/run_id`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"queue_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the annotation queue to add the run to.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the run to queue for review.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignee_user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user assigned to review the run. Leave unset to let any of the queue's reviewers pick it up.",
+				Optional:            true,
+				Validators:          []validator.String{validUUID()},
+			},
+			"item_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the queue item the API created for the run.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"added_at": schema.StringAttribute{
+				MarkdownDescription: "When the run was added to the queue.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *AnnotationQueueRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+// findItem rides through the queue's items looking for our run. It returns
+// nil when the run isn't in the queue.
+func (r *AnnotationQueueRunResource) findItem(ctx context.Context, queueID, runID string) (*annotationQueueRunAPIResponse, error) {
+	var found *annotationQueueRunAPIResponse
+	err := r.client.GetAllPages(ctx, fmt.Sprintf("/api/v1/annotation-queues/%s/runs", queueID), nil, func(page json.RawMessage) (int, error) {
+		var batch []annotationQueueRunAPIResponse
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return 0, err
+		}
+		for i := range batch {
+			if strings.EqualFold(batch[i].RunID, runID) {
+				found = &batch[i]
+				return len(batch), client.ErrStopPaging
+			}
+		}
+		return len(batch), nil
+	})
+	return found, err
+}
+
+// mapItem copies a queue item into the model.
+func (r *AnnotationQueueRunResource) mapItem(item *annotationQueueRunAPIResponse, data *AnnotationQueueRunResourceModel) {
+	data.ID = types.StringValue(data.QueueID.ValueString() + "/" + data.RunID.ValueString())
+	data.ItemID = types.StringValue(item.ID)
+	data.AssigneeUserID = types.StringPointerValue(item.AssigneeUserID)
+	data.AddedAt = types.StringPointerValue(item.AddedAt)
+}
+
+func (r *AnnotationQueueRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AnnotationQueueRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	body := []annotationQueueRunRequest{{
+		RunID:          data.RunID.ValueString(),
+		AssigneeUserID: data.AssigneeUserID.ValueStringPointer(),
+	}}
+
+	var result []annotationQueueRunAPIResponse
+	err := r.client.Create(ctx, fmt.Sprintf("/api/v1/annotation-queues/%s/runs", data.QueueID.ValueString()), body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error adding run to annotation queue", err.Error())
+		return
+	}
+
+	var item *annotationQueueRunAPIResponse
+	for i := range result {
+		if strings.EqualFold(result[i].RunID, data.RunID.ValueString()) {
+			item = &result[i]
+			break
+		}
+	}
+	// Not every version of the API answers with the items it made; go look.
+	if item == nil {
+		item, err = r.findItem(ctx, data.QueueID.ValueString(), data.RunID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading annotation queue runs", err.Error())
+			return
+		}
+		if item == nil {
+			resp.Diagnostics.AddError("Annotation Queue Run Not Found",
+				fmt.Sprintf("Run %s was added to annotation queue %s, but the queue doesn't list it.", data.RunID.ValueString(), data.QueueID.ValueString()))
+			return
+		}
+	}
+
+	// The assignee stays as planned; the next read shows it if the API
+	// didn't take it.
+	assignee := data.AssigneeUserID
+	r.mapItem(item, &data)
+	data.AssigneeUserID = assignee
+
+	tflog.Trace(ctx, "created annotation queue run", map[string]interface{}{"id": data.ID.ValueString(), "item_id": item.ID})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationQueueRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AnnotationQueueRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	item, err := r.findItem(ctx, data.QueueID.ValueString(), data.RunID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading annotation queue runs", err.Error())
+		return
+	}
+	// Reviewed and cleared out, or taken off the docket by hand.
+	if item == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapItem(item, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationQueueRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AnnotationQueueRunResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// Only the assignee can change; everything else replaces the resource.
+	body := annotationQueueRunUpdateRequest{AssigneeUserID: data.AssigneeUserID.ValueStringPointer()}
+	err := r.client.Patch(ctx, fmt.Sprintf("/api/v1/annotation-queues/%s/runs/%s", data.QueueID.ValueString(), data.ItemID.ValueString()), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating annotation queue run", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnnotationQueueRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AnnotationQueueRunResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, fmt.Sprintf("/api/v1/annotation-queues/%s/runs/%s", data.QueueID.ValueString(), data.ItemID.ValueString()))
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error removing run from annotation queue", err.Error())
+	}
+}
+
+func (r *AnnotationQueueRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	// Import format: queue_id/run_id
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: queue_id/run_id")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("queue_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("run_id"), parts[1])...)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const (
+	testQueueID = "7b3e1a52-9c4d-4f0e-8a61-2d5c9e8f1b03"
+	testRunID   = "c1f0d7a4-3b2e-4e8f-9d6a-5a4b3c2d1e0f"
+	testUserID  = "0e9d8c7b-6a5f-4e3d-2c1b-a09f8e7d6c5b"
+)
+
+// fakeAnnotationQueue is a queue's item list that can be added to, patched,
+// and cleared out like the real one.
+type fakeAnnotationQueue struct {
+	mu    sync.Mutex
+	items []annotationQueueRunAPIResponse
+	added []annotationQueueRunRequest
+}
+
+func (q *fakeAnnotationQueue) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	base := "/api/v1/annotation-queues/" + testQueueID + "/runs"
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == base:
+		_ = json.NewDecoder(r.Body).Decode(&q.added)
+		for _, a := range q.added {
+			added := "2025-06-01T00:00:00Z"
+			q.items = append(q.items, annotationQueueRunAPIResponse{ID: "item1", RunID: a.RunID, AssigneeUserID: a.AssigneeUserID, AddedAt: &added})
+		}
+		// Answer the way older servers do, without the new items.
+		_, _ = w.Write([]byte(`[]`))
+	case r.Method == http.MethodGet && r.URL.Path == base:
+		if r.URL.Query().Get("offset") != "0" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(q.items)
+	case r.Method == http.MethodPatch && r.URL.Path == base+"/item1":
+		var body annotationQueueRunUpdateRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		q.items[0].AssigneeUserID = body.AssigneeUserID
+		_, _ = w.Write([]byte(`{}`))
+	case r.Method == http.MethodDelete && r.URL.Path == base+"/item1":
+		q.items = nil
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// TestAnnotationQueueRunResource_lifecycle checks a run is added with its
+// assignee, can be handed to someone else, and leaves state once it's gone
+// from the queue.
+func TestAnnotationQueueRunResource_lifecycle(t *testing.T) {
+	queue := &fakeAnnotationQueue{}
+	ts := httptest.NewServer(queue)
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &AnnotationQueueRunResource{client: client.NewClient(ts.URL, "key", "")}
+
+	plan := testResourceState(t, r, &AnnotationQueueRunResourceModel{
+		ID:             types.StringUnknown(),
+		QueueID:        types.StringValue(testQueueID),
+		RunID:          types.StringValue(testRunID),
+		AssigneeUserID: types.StringValue(testUserID),
+		ItemID:         types.StringUnknown(),
+		AddedAt:        types.StringUnknown(),
+	})
+	createResp := &resource.CreateResponse{State: plan}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+	if len(queue.added) != 1 || queue.added[0].RunID != testRunID || queue.added[0].AssigneeUserID == nil || *queue.added[0].AssigneeUserID != testUserID {
+		t.Errorf("unexpected items added %+v", queue.added)
+	}
+
+	var created AnnotationQueueRunResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueString() != testQueueID+"/"+testRunID || created.ItemID.ValueString() != "item1" || created.AddedAt.ValueString() != "2025-06-01T00:00:00Z" {
+		t.Errorf("unexpected state after create %+v", created)
+	}
+
+	// Hand the run back to the whole queue.
+	created.AssigneeUserID = types.StringNull()
+	updated := testResourceState(t, r, &created)
+	updateResp := &resource.UpdateResponse{State: updated}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(updated), State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("update: %v", updateResp.Diagnostics)
+	}
+	if queue.items[0].AssigneeUserID != nil {
+		t.Errorf("expected the assignee to be cleared, got %s", *queue.items[0].AssigneeUserID)
+	}
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", readResp.Diagnostics)
+	}
+	var read AnnotationQueueRunResourceModel
+	readResp.State.Get(ctx, &read)
+	if read.ItemID.ValueString() != "item1" || !read.AssigneeUserID.IsNull() {
+		t.Errorf("unexpected state after read %+v", read)
+	}
+
+	deleteResp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("delete: %v", deleteResp.Diagnostics)
+	}
+	if len(queue.items) != 0 {
+		t.Errorf("expected the run to be taken out of the queue, got %+v", queue.items)
+	}
+
+	goneResp := &resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", goneResp.Diagnostics)
+	}
+	if !goneResp.State.Raw.IsNull() {
+		t.Error("expected a run no longer in the queue to leave state")
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestBulkExportDestinationResource_destinationType checks each destination
+// type demands its own fields and turns away the other types' fields.
+func TestBulkExportDestinationResource_destinationType(t *testing.T) {
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr string
+	}{
+		"s3 without a type": {
+			attrs: map[string]tftypes.Value{
+				"bucket_name":       str("exports"),
+				"region":            str("us-east-1"),
+				"access_key_id":     str("AKIA"),
+				"secret_access_key": str("secret"),
+			},
+		},
+		"s3 missing bucket": {
+			attrs:   map[string]tftypes.Value{"destination_type": str("s3")},
+			wantErr: `"bucket_name" is required`,
+		},
+		"gcs": {
+			attrs: map[string]tftypes.Value{
+				"destination_type":     str("gcs"),
+				"bucket_name":          str("exports"),
+				"prefix":               str("traces/"),
+				"service_account_json": str(`{"type":"service_account"}`),
+			},
+		},
+		"gcs with aws keys": {
+			attrs: map[string]tftypes.Value{
+				"destination_type": str("gcs"),
+				"bucket_name":      str("exports"),
+				"access_key_id":    str("AKIA"),
+			},
+			wantErr: `"access_key_id" cannot be used`,
+		},
+		"azure": {
+			attrs: map[string]tftypes.Value{
+				"destination_type": str("azure"),
+				"account_name":     str("dodgecity"),
+				"account_key":      str("key"),
+				"container":        str("exports"),
+			},
+		},
+		"azure missing container": {
+			attrs: map[string]tftypes.Value{
+				"destination_type": str("azure"),
+				"account_name":     str("dodgecity"),
+			},
+			wantErr: `"container" is required`,
+		},
+		"azure with bucket": {
+			attrs: map[string]tftypes.Value{
+				"destination_type": str("azure"),
+				"account_name":     str("dodgecity"),
+				"container":        str("exports"),
+				"bucket_name":      str("exports"),
+			},
+			wantErr: `"bucket_name" cannot be used`,
+		},
+		"unknown type": {
+			attrs: map[string]tftypes.Value{
+				"destination_type": str("ftp"),
+				"bucket_name":      str("exports"),
+			},
+			wantErr: "destination_type",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["display_name"] = str("exports")
+			diags := testValidateResourceConfig(t, "langsmith_bulk_export_destination", tc.attrs)
+			if tc.wantErr == "" {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestBulkExportDestinationResource_destroyMode checks destroy_mode decides
+// whether the API is asked to delete the destination, and that an API that
+// can't delete leaves a warning instead of a failed destroy.
+func TestBulkExportDestinationResource_destroyMode(t *testing.T) {
+	cases := map[string]struct {
+		mode        string
+		status      int
+		wantDelete  bool
+		wantWarning bool
+		wantError   bool
+	}{
+		"state only":         {mode: "state_only"},
+		"api":                {mode: "api", status: http.StatusNoContent, wantDelete: true},
+		"api not supported":  {mode: "api", status: http.StatusMethodNotAllowed, wantDelete: true, wantWarning: true},
+		"api already gone":   {mode: "api", status: http.StatusNotFound, wantDelete: true, wantWarning: true},
+		"api server trouble": {mode: "api", status: http.StatusForbidden, wantDelete: true, wantError: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/bulk-exports/destinations/d1" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				deleted = true
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			r := &BulkExportDestinationResource{client: client.NewClient(srv.URL, "key", "")}
+			r.client.MaxRetries = 0
+			state := testResourceState(t, r, &BulkExportDestinationResourceModel{
+				ID:              types.StringValue("d1"),
+				DisplayName:     types.StringValue("exports"),
+				DestinationType: types.StringValue("s3"),
+				BucketName:      types.StringValue("exports"),
+				Prefix:          types.StringNull(),
+				Region:          types.StringNull(),
+				EndpointURL:     types.StringNull(),
+				AccessKeyID:     types.StringNull(),
+				SecretAccessKey: types.StringNull(),
+				ServiceAccount:  types.StringNull(),
+				AccountName:     types.StringNull(),
+				AccountKey:      types.StringNull(),
+				Container:       types.StringNull(),
+				TenantID:        types.StringValue("t1"),
+				CreatedAt:       types.StringValue("2024-01-01T00:00:00Z"),
+				UpdatedAt:       types.StringValue("2024-01-01T00:00:00Z"),
+				CredentialsKeys: types.ListNull(types.StringType),
+				DestroyMode:     types.StringValue(tc.mode),
+			})
+
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+
+			if deleted != tc.wantDelete {
+				t.Errorf("expected delete request=%t, got %t", tc.wantDelete, deleted)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.wantWarning {
+				t.Errorf("expected warning=%t, got %v", tc.wantWarning, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("expected error=%t, got %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestBulkExportDestinationResource_credentials checks credentials are sent on
+// create, held back on an update that leaves them be, and sent together once
+// any one of them changes.
+func TestBulkExportDestinationResource_credentials(t *testing.T) {
+	prior := BulkExportDestinationResourceModel{
+		AccessKeyID:     types.StringValue("AKIA1"),
+		SecretAccessKey: types.StringValue("secret1"),
+		ServiceAccount:  types.StringNull(),
+		AccountKey:      types.StringNull(),
+	}
+
+	creds := buildBulkExportDestinationCredentials(&prior, nil)
+	if creds == nil || creds.AccessKeyID != "AKIA1" || creds.SecretAccessKey != "secret1" {
+		t.Errorf("expected every credential on create, got %+v", creds)
+	}
+
+	unchanged := prior
+	if creds := buildBulkExportDestinationCredentials(&unchanged, &prior); creds != nil {
+		t.Errorf("expected no credentials when none changed, got %+v", creds)
+	}
+
+	rotated := prior
+	rotated.SecretAccessKey = types.StringValue("secret2")
+	creds = buildBulkExportDestinationCredentials(&rotated, &prior)
+	if creds == nil || creds.AccessKeyID != "AKIA1" || creds.SecretAccessKey != "secret2" {
+		t.Errorf("expected the rotated secret to ride with its key ID, got %+v", creds)
+	}
+}
@@ -5,16 +5,24 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -22,10 +30,36 @@ import (
 )
 
 var (
-	_ resource.Resource                = &BulkExportResource{}
-	_ resource.ResourceWithImportState = &BulkExportResource{}
+	_ resource.Resource                   = &BulkExportResource{}
+	_ resource.ResourceWithImportState    = &BulkExportResource{}
+	_ resource.ResourceWithValidateConfig = &BulkExportResource{}
 )
 
+// defaultBulkExportTimeout is how long Create waits for an export to finish
+// when wait_for_completion is set without a timeout.
+const defaultBulkExportTimeout = 60 * time.Minute
+
+// bulkExportPollInterval is how often the export's status is checked while
+// waiting. A variable so tests don't have to wait around.
+var bulkExportPollInterval = 10 * time.Second
+
+// bulkExportFormats are the file formats an export can be written in.
+var bulkExportFormats = []string{"Parquet", "JSONL"}
+
+// bulkExportCompressions are the ways an export's files can be compressed.
+var bulkExportCompressions = []string{"gzip", "none"}
+
+// bulkExportFields are the run fields an export can be limited to. The API
+// quietly leaves out any field it doesn't know, so a misspelled one would
+// only be missed once the files land.
+var bulkExportFields = []string{
+	"id", "tenant_id", "session_id", "trace_id", "parent_run_id", "parent_run_ids", "dotted_order", "is_root",
+	"name", "run_type", "status", "error", "tags", "extra", "events", "serialized",
+	"inputs", "outputs", "start_time", "end_time", "first_token_time",
+	"reference_example_id", "reference_dataset_id", "feedback_stats", "thread_id",
+	"prompt_tokens", "completion_tokens", "total_tokens", "prompt_cost", "completion_cost", "total_cost",
+}
+
 // NewBulkExportResource returns a new BulkExportResource, ready to drive a herd of data
 // from LangSmith out to your chosen destination.
 func NewBulkExportResource() resource.Resource {
@@ -58,6 +92,9 @@ type BulkExportResourceModel struct {
 	FormatVersion           types.String `tfsdk:"format_version"`
 	ExportFields            types.List   `tfsdk:"export_fields"`
 	FinishedAt              types.String `tfsdk:"finished_at"`
+	WaitForCompletion       types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout                 types.String `tfsdk:"timeout"`
+	WorkspaceID             types.String `tfsdk:"workspace_id"`
 }
 
 // bulkExportAPICreateRequest is the request body for creating a bulk export.
@@ -107,6 +144,7 @@ func (r *BulkExportResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith bulk export. Deleting this resource cancels the bulk export.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the bulk export.",
 				Computed:            true,
@@ -131,31 +169,35 @@ func (r *BulkExportResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"start_time": schema.StringAttribute{
 				MarkdownDescription: "The start time for the export in RFC3339 format.",
 				Required:            true,
+				Validators:          []validator.String{validTimestamp()},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"end_time": schema.StringAttribute{
-				MarkdownDescription: "The end time for the export in RFC3339 format.",
+				MarkdownDescription: "The end time for the export in RFC3339 format. Must be after `start_time`.",
 				Optional:            true,
+				Validators:          []validator.String{validTimestamp()},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"format": schema.StringAttribute{
-				MarkdownDescription: "The export format. Defaults to `Parquet`.",
+				MarkdownDescription: "The export format, `Parquet` or `JSONL`. Defaults to `Parquet`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("Parquet"),
+				Validators:          []validator.String{stringvalidator.OneOf(bulkExportFormats...)},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"compression": schema.StringAttribute{
-				MarkdownDescription: "The compression type. Defaults to `gzip`.",
+				MarkdownDescription: "The compression type, `gzip` or `none`. Defaults to `gzip`.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("gzip"),
+				Validators:          []validator.String{stringvalidator.OneOf(bulkExportCompressions...)},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -196,14 +238,28 @@ func (r *BulkExportResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 			},
 			"export_fields": schema.ListAttribute{
-				MarkdownDescription: "List of fields to export.",
-				Optional:            true,
-				ElementType:         types.StringType,
+				MarkdownDescription: "The run fields to export, such as `id`, `name`, `inputs`, `outputs`, and `start_time`. Every field is exported when unset. " +
+					"Each must be one of `" + strings.Join(bulkExportFields, "`, `") + "`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(bulkExportFields...)),
+				},
 			},
 			"finished_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the export finished.",
 				Computed:            true,
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, creation waits until the export reaches `Completed`, `Failed`, or `Cancelled` instead of returning as soon as it is scheduled. A `Failed` export is reported as an error. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the export to finish when `wait_for_completion` is `true`, as a duration such as `30m` or `2h`. Defaults to `60m`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -213,16 +269,16 @@ func (r *BulkExportResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *BulkExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -232,6 +288,8 @@ func (r *BulkExportResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := bulkExportAPICreateRequest{
 		BulkExportDestinationID: data.BulkExportDestinationID.ValueString(),
 		SessionID:               data.SessionID.ValueString(),
@@ -271,7 +329,7 @@ func (r *BulkExportResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	var result bulkExportAPIResponse
-	err := r.client.Post(ctx, "/api/v1/bulk-exports", body, &result)
+	err := r.client.Create(ctx, "/api/v1/bulk-exports", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating bulk export", err.Error())
 		return
@@ -280,6 +338,10 @@ func (r *BulkExportResource) Create(ctx context.Context, req resource.CreateRequ
 	mapBulkExportResponseToState(&data, &result)
 	tflog.Trace(ctx, "created bulk export resource", map[string]interface{}{"id": result.ID})
 
+	if data.WaitForCompletion.ValueBool() {
+		r.waitForCompletion(ctx, &data, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -290,6 +352,8 @@ func (r *BulkExportResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result bulkExportAPIResponse
 	err := r.client.Get(ctx, "/api/v1/bulk-exports/"+data.ID.ValueString(), nil, &result)
 	if err != nil {
@@ -302,6 +366,9 @@ func (r *BulkExportResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	mapBulkExportResponseToState(&data, &result)
+	if data.WaitForCompletion.IsNull() {
+		data.WaitForCompletion = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -313,6 +380,23 @@ func (r *BulkExportResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var state BulkExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing only how long Terraform waits is our business, not the API's.
+	// Carry the new settings into state and leave the export be.
+	if data.ExportFields.Equal(state.ExportFields) && (data.FormatVersion.IsUnknown() || data.FormatVersion.Equal(state.FormatVersion)) {
+		state.WaitForCompletion = data.WaitForCompletion
+		state.Timeout = data.Timeout
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
 	body := bulkExportAPIUpdateRequest{
 		Status: "Cancelled",
 	}
@@ -337,6 +421,8 @@ func (r *BulkExportResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	// No delete endpoint exists, so we cancel the export instead -- the marshal's
 	// way of telling a rowdy export to settle down and go home.
 	body := bulkExportAPIUpdateRequest{
@@ -355,7 +441,103 @@ func (r *BulkExportResource) Delete(ctx context.Context, req resource.DeleteRequ
 	tflog.Trace(ctx, "cancelled (deleted) bulk export resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+// ValidateConfig makes sure the timeout is a duration we can read, and that
+// the export's time range runs forward. Both fields force a replacement, so
+// a bad range is better caught before the old export is cancelled.
+func (r *BulkExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout, startTime, endTime types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_time"), &startTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end_time"), &endTime)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("timeout must be a positive duration such as \"30m\" or \"2h\", got %q.", timeout.ValueString()),
+			)
+		}
+	}
+
+	// A malformed timestamp is already turned away by its own validator.
+	if startTime.IsNull() || startTime.IsUnknown() || endTime.IsNull() || endTime.IsUnknown() {
+		return
+	}
+	start, err := time.Parse(time.RFC3339Nano, startTime.ValueString())
+	if err != nil {
+		return
+	}
+	end, err := time.Parse(time.RFC3339Nano, endTime.ValueString())
+	if err != nil {
+		return
+	}
+	if !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid Export Time Range",
+			fmt.Sprintf("end_time %q must be after start_time %q.", endTime.ValueString(), startTime.ValueString()),
+		)
+	}
+}
+
+// waitForCompletion keeps watch on a freshly created export until it reaches
+// a terminal status or the timeout runs out, refreshing data as it goes. A
+// failed export or a timeout is reported as an error; either way data holds
+// the last status seen, so state never claims the export is still running
+// when we know better.
+func (r *BulkExportResource) waitForCompletion(ctx context.Context, data *BulkExportResourceModel, diags *diag.Diagnostics) {
+	timeout := defaultBulkExportTimeout
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+		if d, err := time.ParseDuration(data.Timeout.ValueString()); err == nil && d > 0 {
+			timeout = d
+		}
+	}
+	id := data.ID.ValueString()
+
+	// data is fresh from Create, so the first look needs no trip to the API.
+	refresh := false
+	err := poll(ctx, bulkExportPollInterval, timeout, func(ctx context.Context) (bool, error) {
+		if refresh {
+			var result bulkExportAPIResponse
+			if err := r.client.Get(ctx, "/api/v1/bulk-exports/"+id, nil, &result); err != nil {
+				return false, fmt.Errorf("reading bulk export: %w", err)
+			}
+			mapBulkExportResponseToState(data, &result)
+		}
+		refresh = true
+
+		switch strings.ToLower(data.Status.ValueString()) {
+		case "completed", "cancelled", "failed":
+			return true, nil
+		}
+		tflog.Debug(ctx, "waiting for bulk export to finish", map[string]interface{}{"id": id, "status": data.Status.ValueString()})
+		return false, nil
+	})
+
+	switch {
+	case errors.Is(err, errPollTimeout):
+		diags.AddError(
+			"Timed Out Waiting For Bulk Export",
+			fmt.Sprintf("Bulk export %s was still %q after %s. Raise timeout, or set wait_for_completion = false to stop waiting.", id, data.Status.ValueString(), timeout),
+		)
+	case err != nil:
+		diags.AddError("Error waiting for bulk export", err.Error())
+	case strings.EqualFold(data.Status.ValueString(), "failed"):
+		diags.AddError(
+			"Bulk Export Failed",
+			fmt.Sprintf("Bulk export %s finished with status %q. Check the export's runs in LangSmith for the cause.", id, data.Status.ValueString()),
+		)
+	default:
+		tflog.Info(ctx, "bulk export finished", map[string]interface{}{"id": id, "status": data.Status.ValueString()})
+	}
+}
+
 func (r *BulkExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
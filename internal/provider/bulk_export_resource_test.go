@@ -0,0 +1,220 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// testBulkExportServer schedules an export and then reports each of the given
+// statuses in turn on successive polls.
+func testBulkExportServer(t *testing.T, statuses ...string) *httptest.Server {
+	t.Helper()
+	polls := 0
+	export := func(status string) bulkExportAPIResponse {
+		e := bulkExportAPIResponse{
+			ID:                      "be1",
+			BulkExportDestinationID: "d1",
+			SessionID:               "s1",
+			StartTime:               "2024-01-01T00:00:00Z",
+			Format:                  "Parquet",
+			Compression:             "gzip",
+			Status:                  status,
+			FormatVersion:           "v1",
+		}
+		if status == "Completed" || status == "Failed" {
+			finished := "2024-01-02T00:00:00Z"
+			e.FinishedAt = &finished
+		}
+		return e
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/bulk-exports":
+			_ = json.NewEncoder(w).Encode(export("Created"))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/bulk-exports/be1":
+			status := statuses[len(statuses)-1]
+			if polls < len(statuses) {
+				status = statuses[polls]
+			}
+			polls++
+			_ = json.NewEncoder(w).Encode(export(status))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func testBulkExportCreate(t *testing.T, srv *httptest.Server, timeout types.String) (*resource.CreateResponse, BulkExportResourceModel) {
+	t.Helper()
+	defer func(d time.Duration) { bulkExportPollInterval = d }(bulkExportPollInterval)
+	bulkExportPollInterval = time.Millisecond
+
+	r := &BulkExportResource{client: client.NewClient(srv.URL, "key", "")}
+	plan := testResourceState(t, r, &BulkExportResourceModel{
+		ID:                      types.StringUnknown(),
+		BulkExportDestinationID: types.StringValue("d1"),
+		SessionID:               types.StringValue("s1"),
+		StartTime:               types.StringValue("2024-01-01T00:00:00Z"),
+		EndTime:                 types.StringNull(),
+		Format:                  types.StringValue("Parquet"),
+		Compression:             types.StringValue("gzip"),
+		IntervalHours:           types.Int64Null(),
+		Filter:                  types.StringNull(),
+		Status:                  types.StringUnknown(),
+		TenantID:                types.StringUnknown(),
+		CreatedAt:               types.StringUnknown(),
+		UpdatedAt:               types.StringUnknown(),
+		FormatVersion:           types.StringUnknown(),
+		ExportFields:            types.ListNull(types.StringType),
+		FinishedAt:              types.StringUnknown(),
+		WaitForCompletion:       types.BoolValue(true),
+		Timeout:                 timeout,
+	})
+
+	resp := &resource.CreateResponse{State: plan}
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+
+	var got BulkExportResourceModel
+	resp.State.Get(context.Background(), &got)
+	return resp, got
+}
+
+// TestBulkExportResource_waitForCompletion checks Create rides along until the
+// export is done and records when it finished.
+func TestBulkExportResource_waitForCompletion(t *testing.T) {
+	srv := testBulkExportServer(t, "Running", "Running", "Completed")
+	defer srv.Close()
+
+	resp, got := testBulkExportCreate(t, srv, types.StringNull())
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if got.Status.ValueString() != "Completed" || got.FinishedAt.ValueString() != "2024-01-02T00:00:00Z" {
+		t.Errorf("expected a completed export with finished_at, got status %s finished_at %s", got.Status, got.FinishedAt)
+	}
+}
+
+// TestBulkExportResource_waitForFailure checks a failed export is reported as
+// an error, with the failure recorded in state rather than a running status.
+func TestBulkExportResource_waitForFailure(t *testing.T) {
+	srv := testBulkExportServer(t, "Running", "Failed")
+	defer srv.Close()
+
+	resp, got := testBulkExportCreate(t, srv, types.StringNull())
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Bulk Export Failed" {
+		t.Fatalf("expected a failure diagnostic, got %v", resp.Diagnostics)
+	}
+	if got.Status.ValueString() != "Failed" {
+		t.Errorf("expected status Failed in state, got %s", got.Status)
+	}
+}
+
+// TestBulkExportResource_waitTimeout checks an export that never finishes
+// gives up once the timeout runs out.
+func TestBulkExportResource_waitTimeout(t *testing.T) {
+	srv := testBulkExportServer(t, "Running")
+	defer srv.Close()
+
+	resp, got := testBulkExportCreate(t, srv, types.StringValue("20ms"))
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Timed Out Waiting For Bulk Export" {
+		t.Fatalf("expected a timeout diagnostic, got %v", resp.Diagnostics)
+	}
+	if got.Status.ValueString() != "Running" {
+		t.Errorf("expected the last seen status in state, got %s", got.Status)
+	}
+}
+
+// TestBulkExportResource_validation checks a misspelled export field, format,
+// or compression is caught at plan time rather than in the exported files.
+func TestBulkExportResource_validation(t *testing.T) {
+	fields := func(names ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(names))
+		for _, name := range names {
+			values = append(values, tftypes.NewValue(tftypes.String, name))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		attribute string
+		value     tftypes.Value
+		wantError bool
+	}{
+		{name: "known fields", attribute: "export_fields", value: fields("id", "name", "inputs", "outputs", "start_time")},
+		{name: "unknown field", attribute: "export_fields", value: fields("id", "input"), wantError: true},
+		{name: "jsonl", attribute: "format", value: tftypes.NewValue(tftypes.String, "JSONL")},
+		{name: "unknown format", attribute: "format", value: tftypes.NewValue(tftypes.String, "CSV"), wantError: true},
+		{name: "uncompressed", attribute: "compression", value: tftypes.NewValue(tftypes.String, "none")},
+		{name: "unknown compression", attribute: "compression", value: tftypes.NewValue(tftypes.String, "zip"), wantError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_bulk_export", map[string]tftypes.Value{
+				"bulk_export_destination_id": tftypes.NewValue(tftypes.String, "3f6c1e2a-1a3c-4e5b-8d7f-0a1b2c3d4e5f"),
+				"session_id":                 tftypes.NewValue(tftypes.String, "9b2d4e6f-1a3c-4e5b-8d7f-0a1b2c3d4e5f"),
+				"start_time":                 tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+				tc.attribute:                 tc.value,
+			})
+			if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.attribute); got != tc.wantError {
+				t.Errorf("expected error=%t on %s, got %v", tc.wantError, tc.attribute, diags)
+			}
+		})
+	}
+}
+
+// TestBulkExportResource_timeRange checks both ends of the export window must
+// be RFC3339 timestamps, and the window must end after it starts, down to the
+// nanosecond and across time zones.
+func TestBulkExportResource_timeRange(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		start, end string
+		wantError  string
+	}{
+		{name: "open ended", start: "2025-01-01T00:00:00Z"},
+		{name: "forward", start: "2025-01-01T00:00:00Z", end: "2025-02-01T00:00:00Z"},
+		{name: "a nanosecond later", start: "2025-01-01T00:00:00Z", end: "2025-01-01T00:00:00.000000001Z"},
+		{name: "same instant", start: "2025-01-01T00:00:00Z", end: "2025-01-01T00:00:00Z", wantError: "end_time"},
+		{name: "same instant elsewhere", start: "2025-01-01T00:00:00Z", end: "2025-01-01T01:00:00+01:00", wantError: "end_time"},
+		{name: "backward", start: "2025-02-01T00:00:00Z", end: "2025-01-01T00:00:00Z", wantError: "end_time"},
+		{name: "bad start", start: "2025-01-01", wantError: "start_time"},
+		{name: "bad end", start: "2025-01-01T00:00:00Z", end: "2025-13-01T00:00:00Z", wantError: "end_time"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]tftypes.Value{
+				"bulk_export_destination_id": tftypes.NewValue(tftypes.String, "3f6c1e2a-1a3c-4e5b-8d7f-0a1b2c3d4e5f"),
+				"session_id":                 tftypes.NewValue(tftypes.String, "9b2d4e6f-1a3c-4e5b-8d7f-0a1b2c3d4e5f"),
+				"start_time":                 tftypes.NewValue(tftypes.String, tc.start),
+			}
+			if tc.end != "" {
+				config["end_time"] = tftypes.NewValue(tftypes.String, tc.end)
+			}
+			diags := testValidateResourceConfig(t, "langsmith_bulk_export", config)
+			if tc.wantError == "" {
+				if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") {
+					t.Errorf("expected no errors, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantError) {
+				t.Errorf("expected an error on %s, got %v", tc.wantError, diags)
+			}
+		})
+	}
+}
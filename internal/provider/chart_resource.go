@@ -0,0 +1,260 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &ChartResource{}
+	_ resource.ResourceWithImportState = &ChartResource{}
+)
+
+// NewChartResource returns a new ChartResource -- one line on the trail map,
+// tracing a single metric across a project.
+func NewChartResource() resource.Resource {
+	return &ChartResource{}
+}
+
+// ChartResource manages a single metric chart for a LangSmith project.
+type ChartResource struct {
+	client *client.Client
+}
+
+// ChartResourceModel describes the Terraform state for a chart.
+type ChartResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	SessionID   types.String `tfsdk:"session_id"`
+	Metric      types.String `tfsdk:"metric"`
+	Aggregation types.String `tfsdk:"aggregation"`
+	Filter      types.String `tfsdk:"filter"`
+	GroupBy     types.String `tfsdk:"group_by"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+// chartRequest is sent to create or update a chart.
+type chartRequest struct {
+	SessionID   string  `json:"session_id"`
+	Metric      string  `json:"metric"`
+	Aggregation string  `json:"aggregation"`
+	Filter      *string `json:"filter"`
+	GroupBy     *string `json:"group_by"`
+}
+
+// chartAPIResponse is the API's account of a chart.
+type chartAPIResponse struct {
+	ID          string  `json:"id"`
+	SessionID   string  `json:"session_id"`
+	Metric      string  `json:"metric"`
+	Aggregation string  `json:"aggregation"`
+	Filter      *string `json:"filter"`
+	GroupBy     *string `json:"group_by"`
+}
+
+func (r *ChartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chart"
+}
+
+func (r *ChartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a LangSmith chart plotting a single metric for a project.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the chart.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project (session) the chart plots.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+			},
+			"metric": schema.StringAttribute{
+				MarkdownDescription: "The run metric to plot, e.g. `latency` or `error_count`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"aggregation": schema.StringAttribute{
+				MarkdownDescription: "The aggregation method (`avg`, `sum`, or `pct`).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricAggregations...),
+				},
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "A run filter expression narrowing the runs plotted.",
+				Optional:            true,
+			},
+			"group_by": schema.StringAttribute{
+				MarkdownDescription: "The run attribute to split the chart into one series per value of, e.g. `tag` or `name`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ChartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+// buildChartRequest gathers the planned chart into a request. Unset optional
+// fields go out as null so an update clears them.
+func buildChartRequest(data *ChartResourceModel) chartRequest {
+	body := chartRequest{
+		SessionID:   data.SessionID.ValueString(),
+		Metric:      data.Metric.ValueString(),
+		Aggregation: data.Aggregation.ValueString(),
+	}
+	if !data.Filter.IsNull() && !data.Filter.IsUnknown() {
+		v := data.Filter.ValueString()
+		body.Filter = &v
+	}
+	if !data.GroupBy.IsNull() && !data.GroupBy.IsUnknown() {
+		v := data.GroupBy.ValueString()
+		body.GroupBy = &v
+	}
+	return body
+}
+
+func (r *ChartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result chartAPIResponse
+	err := r.client.Create(ctx, "/api/v1/charts", buildChartRequest(&data), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating chart", err.Error())
+		return
+	}
+
+	mapChartResponseToState(&data, &result)
+	tflog.Trace(ctx, "created chart resource", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChartResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result chartAPIResponse
+	err := r.client.Get(ctx, "/api/v1/charts/"+data.ID.ValueString(), nil, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading chart", err.Error())
+		return
+	}
+
+	mapChartResponseToState(&data, &result)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ChartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result chartAPIResponse
+	err := r.client.Patch(ctx, "/api/v1/charts/"+data.ID.ValueString(), buildChartRequest(&data), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating chart", err.Error())
+		return
+	}
+
+	mapChartResponseToState(&data, &result)
+	tflog.Trace(ctx, "updated chart resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ChartResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, "/api/v1/charts/"+data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting chart", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted chart resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+func (r *ChartResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapChartResponseToState maps the API response onto Terraform state.
+func mapChartResponseToState(data *ChartResourceModel, result *chartAPIResponse) {
+	data.ID = types.StringValue(result.ID)
+	data.SessionID = types.StringValue(result.SessionID)
+	data.Metric = types.StringValue(result.Metric)
+	data.Aggregation = types.StringValue(result.Aggregation)
+
+	if result.Filter != nil && *result.Filter != "" {
+		data.Filter = types.StringValue(*result.Filter)
+	} else {
+		data.Filter = types.StringNull()
+	}
+
+	if result.GroupBy != nil && *result.GroupBy != "" {
+		data.GroupBy = types.StringValue(*result.GroupBy)
+	} else {
+		data.GroupBy = types.StringNull()
+	}
+}
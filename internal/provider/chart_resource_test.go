@@ -0,0 +1,68 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestChartResource_aggregationValidation checks a chart takes the same
+// aggregations an alert rule does, and nothing else.
+func TestChartResource_aggregationValidation(t *testing.T) {
+	cases := map[string]bool{"max": true, "": true}
+	for _, a := range metricAggregations {
+		cases[a] = false
+	}
+
+	for aggregation, wantErr := range cases {
+		t.Run(aggregation, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_chart", map[string]tftypes.Value{
+				"session_id":  tftypes.NewValue(tftypes.String, "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"),
+				"metric":      tftypes.NewValue(tftypes.String, "latency"),
+				"aggregation": tftypes.NewValue(tftypes.String, aggregation),
+			})
+			if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "aggregation"); got != wantErr {
+				t.Errorf("expected error %v, got %v", wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestChartResource_request checks unset optional fields are sent as null,
+// so an update clears them, and read back as null.
+func TestChartResource_request(t *testing.T) {
+	data := ChartResourceModel{
+		SessionID:   types.StringValue("6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"),
+		Metric:      types.StringValue("error_count"),
+		Aggregation: types.StringValue("sum"),
+		Filter:      types.StringValue(`has(tags, "prod")`),
+		GroupBy:     types.StringNull(),
+	}
+
+	body, err := json.Marshal(buildChartRequest(&data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"session_id":"6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c","metric":"error_count","aggregation":"sum","filter":"has(tags, \"prod\")","group_by":null}`
+	if string(body) != want {
+		t.Errorf("unexpected request body\n got: %s\nwant: %s", body, want)
+	}
+
+	empty := ""
+	mapChartResponseToState(&data, &chartAPIResponse{
+		ID:          "c1",
+		SessionID:   data.SessionID.ValueString(),
+		Metric:      "error_count",
+		Aggregation: "sum",
+		GroupBy:     &empty,
+	})
+	if data.ID.ValueString() != "c1" || !data.Filter.IsNull() || !data.GroupBy.IsNull() {
+		t.Errorf("expected unset fields to read back as null, got %+v", data)
+	}
+}
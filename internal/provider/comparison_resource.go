@@ -0,0 +1,300 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &ComparisonResource{}
+	_ resource.ResourceWithImportState = &ComparisonResource{}
+)
+
+// NewComparisonResource returns a new ComparisonResource -- ready to line the
+// riders up side by side and see who made the better time.
+func NewComparisonResource() resource.Resource {
+	return &ComparisonResource{}
+}
+
+// ComparisonResource manages named experiment comparisons in LangSmith,
+// tying a set of experiments to the dataset they were judged against.
+type ComparisonResource struct {
+	client *client.Client
+}
+
+// ComparisonResourceModel describes the Terraform state for a comparison.
+type ComparisonResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	ReferenceDatasetID types.String `tfsdk:"reference_dataset_id"`
+	ExperimentIDs      types.List   `tfsdk:"experiment_ids"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
+}
+
+// comparisonCreateRequest lines up a new comparison.
+type comparisonCreateRequest struct {
+	Name               string   `json:"name"`
+	ReferenceDatasetID string   `json:"reference_dataset_id"`
+	ExperimentIDs      []string `json:"experiment_ids"`
+}
+
+// comparisonUpdateRequest renames a comparison or changes who's in the race.
+type comparisonUpdateRequest struct {
+	Name          string   `json:"name"`
+	ExperimentIDs []string `json:"experiment_ids"`
+}
+
+// comparisonAPIResponse is the API's account of a comparison.
+type comparisonAPIResponse struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	ReferenceDatasetID string   `json:"reference_dataset_id"`
+	ExperimentIDs      []string `json:"experiment_ids"`
+	CreatedAt          string   `json:"created_at"`
+}
+
+func (r *ComparisonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_comparison"
+}
+
+func (r *ComparisonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a named LangSmith experiment comparison, tying experiments to the dataset they were evaluated against.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the comparison.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the comparison.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"reference_dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset the experiments were evaluated against.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"experiment_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the experiments (tracing projects) to compare.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(validUUID()),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the comparison was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ComparisonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *ComparisonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ComparisonResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var experimentIDs []string
+	resp.Diagnostics.Append(data.ExperimentIDs.ElementsAs(ctx, &experimentIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := comparisonCreateRequest{
+		Name:               data.Name.ValueString(),
+		ReferenceDatasetID: data.ReferenceDatasetID.ValueString(),
+		ExperimentIDs:      experimentIDs,
+	}
+
+	var result comparisonAPIResponse
+	err := r.client.Create(ctx, "/api/v1/comparisons", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating comparison", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapComparisonResponseToState(ctx, &data, &result)...)
+	tflog.Trace(ctx, "created comparison resource", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ComparisonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ComparisonResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result comparisonAPIResponse
+	err := r.client.Get(ctx, "/api/v1/comparisons/"+data.ID.ValueString(), nil, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading comparison", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapComparisonResponseToState(ctx, &data, &result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ComparisonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ComparisonResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var experimentIDs []string
+	resp.Diagnostics.Append(data.ExperimentIDs.ElementsAs(ctx, &experimentIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := comparisonUpdateRequest{
+		Name:          data.Name.ValueString(),
+		ExperimentIDs: experimentIDs,
+	}
+
+	var result comparisonAPIResponse
+	err := r.client.Patch(ctx, "/api/v1/comparisons/"+data.ID.ValueString(), body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating comparison", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapComparisonResponseToState(ctx, &data, &result)...)
+	tflog.Trace(ctx, "updated comparison resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ComparisonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ComparisonResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, "/api/v1/comparisons/"+data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting comparison", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted comparison resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+func (r *ComparisonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapComparisonResponseToState maps the API response onto Terraform state.
+// The experiments keep the order they're configured in when the API hands
+// back the same set in another.
+func mapComparisonResponseToState(ctx context.Context, data *ComparisonResourceModel, result *comparisonAPIResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(result.ID)
+	data.Name = types.StringValue(result.Name)
+	data.ReferenceDatasetID = types.StringValue(result.ReferenceDatasetID)
+
+	if result.CreatedAt != "" {
+		data.CreatedAt = types.StringValue(result.CreatedAt)
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	var prior []string
+	if !data.ExperimentIDs.IsNull() && !data.ExperimentIDs.IsUnknown() {
+		diags.Append(data.ExperimentIDs.ElementsAs(ctx, &prior, false)...)
+	}
+	if sameStringSet(prior, result.ExperimentIDs) {
+		return diags
+	}
+
+	experimentIDs := result.ExperimentIDs
+	if experimentIDs == nil {
+		experimentIDs = []string{}
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, experimentIDs)
+	diags.Append(d...)
+	data.ExperimentIDs = list
+	return diags
+}
+
+// sameStringSet reports whether a and b hold the same strings, in any order.
+func sameStringSet(a, b []string) bool {
+	if a == nil || len(a) != len(b) {
+		return false
+	}
+	sa, sb := slices.Clone(a), slices.Clone(b)
+	slices.Sort(sa)
+	slices.Sort(sb)
+	return slices.Equal(sa, sb)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const (
+	testComparisonDatasetID = "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"
+	testComparisonExpA      = "11111111-2222-4333-8444-555555555555"
+	testComparisonExpB      = "aaaaaaaa-bbbb-4ccc-8ddd-eeeeeeeeeeee"
+)
+
+// TestComparisonResource_experimentIDsValidation checks the experiments are
+// a non-empty list of distinct IDs.
+func TestComparisonResource_experimentIDsValidation(t *testing.T) {
+	ids := func(v ...string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(v))
+		for _, s := range v {
+			elems = append(elems, tftypes.NewValue(tftypes.String, s))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elems)
+	}
+
+	cases := map[string]struct {
+		experimentIDs tftypes.Value
+		wantErr       string
+	}{
+		"two experiments": {experimentIDs: ids(testComparisonExpA, testComparisonExpB)},
+		"none":            {experimentIDs: ids(), wantErr: "at least 1"},
+		"duplicates":      {experimentIDs: ids(testComparisonExpA, testComparisonExpA), wantErr: "duplicate"},
+		"not a UUID":      {experimentIDs: ids("my-experiment"), wantErr: "valid UUID"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_comparison", map[string]tftypes.Value{
+				"name":                 tftypes.NewValue(tftypes.String, "gpt vs. claude"),
+				"reference_dataset_id": tftypes.NewValue(tftypes.String, testComparisonDatasetID),
+				"experiment_ids":       tc.experimentIDs,
+			})
+			if tc.wantErr == "" {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestComparisonResource_crud walks a comparison through create, read,
+// update and delete against a stand-in API.
+func TestComparisonResource_crud(t *testing.T) {
+	stored := comparisonAPIResponse{}
+	deleted := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/comparisons":
+			var body comparisonCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			stored = comparisonAPIResponse{
+				ID:                 "c1",
+				Name:               body.Name,
+				ReferenceDatasetID: body.ReferenceDatasetID,
+				ExperimentIDs:      body.ExperimentIDs,
+				CreatedAt:          "2025-06-01T00:00:00Z",
+			}
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/comparisons/c1":
+			var body comparisonUpdateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			stored.Name = body.Name
+			stored.ExperimentIDs = body.ExperimentIDs
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/comparisons/c1":
+			if deleted {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			// The API doesn't promise to keep the experiments in order.
+			reversed := append([]string(nil), stored.ExperimentIDs...)
+			for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+				reversed[i], reversed[j] = reversed[j], reversed[i]
+			}
+			resp := stored
+			resp.ExperimentIDs = reversed
+			_ = json.NewEncoder(w).Encode(resp)
+			return
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/comparisons/c1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &ComparisonResource{client: client.NewClient(ts.URL, "key", "")}
+	model := func(name string, experimentIDs ...string) ComparisonResourceModel {
+		list, _ := types.ListValueFrom(ctx, types.StringType, experimentIDs)
+		return ComparisonResourceModel{
+			ID:                 types.StringUnknown(),
+			Name:               types.StringValue(name),
+			ReferenceDatasetID: types.StringValue(testComparisonDatasetID),
+			ExperimentIDs:      list,
+			CreatedAt:          types.StringUnknown(),
+		}
+	}
+	emptyState := func(s tfsdk.State) tfsdk.State {
+		return tfsdk.State{Schema: s.Schema, Raw: tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)}
+	}
+
+	created := model("first ride", testComparisonExpA, testComparisonExpB)
+	plan := testResourceState(t, r, &created)
+	createResp := &resource.CreateResponse{State: emptyState(plan)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	var got ComparisonResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "c1" || got.CreatedAt.ValueString() == "" {
+		t.Errorf("expected the comparison's ID and creation time, got %+v", got)
+	}
+	if want := model("", testComparisonExpA, testComparisonExpB).ExperimentIDs; !got.ExperimentIDs.Equal(want) {
+		t.Errorf("expected the configured experiment order to be kept, got %s", got.ExperimentIDs)
+	}
+
+	updated := model("second ride", testComparisonExpB)
+	updated.ID = types.StringValue("c1")
+	updated.CreatedAt = got.CreatedAt
+	updatePlan := testResourceState(t, r, &updated)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(updatePlan), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("updating: %v", updateResp.Diagnostics)
+	}
+	if stored.Name != "second ride" || len(stored.ExperimentIDs) != 1 {
+		t.Errorf("expected the update to reach the API, got %+v", stored)
+	}
+
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &resource.DeleteResponse{})
+	goneResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, goneResp)
+	if !deleted || !goneResp.State.Raw.IsNull() {
+		t.Error("expected the deleted comparison to be removed from state")
+	}
+}
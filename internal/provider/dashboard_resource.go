@@ -0,0 +1,301 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &DashboardResource{}
+	_ resource.ResourceWithImportState    = &DashboardResource{}
+	_ resource.ResourceWithValidateConfig = &DashboardResource{}
+)
+
+// NewDashboardResource returns a new DashboardResource -- a lookout tower
+// for keeping an eye on the whole range.
+func NewDashboardResource() resource.Resource {
+	return &DashboardResource{}
+}
+
+// DashboardResource manages monitoring dashboards in LangSmith.
+type DashboardResource struct {
+	client *client.Client
+}
+
+// DashboardResourceModel describes the Terraform state for a dashboard.
+type DashboardResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Charts      types.String `tfsdk:"charts"`
+	TenantID    types.String `tfsdk:"tenant_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	ModifiedAt  types.String `tfsdk:"modified_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+// dashboardRequest is sent to create or update a dashboard.
+type dashboardRequest struct {
+	Title       string          `json:"title"`
+	Description *string         `json:"description"`
+	Charts      json.RawMessage `json:"charts"`
+}
+
+// dashboardAPIResponse is the API's account of a dashboard.
+type dashboardAPIResponse struct {
+	ID          string          `json:"id"`
+	Title       string          `json:"title"`
+	Description *string         `json:"description"`
+	Charts      json.RawMessage `json:"charts"`
+	TenantID    string          `json:"tenant_id"`
+	CreatedAt   string          `json:"created_at"`
+	ModifiedAt  string          `json:"modified_at"`
+}
+
+func (r *DashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *DashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a LangSmith monitoring dashboard and its charts.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the dashboard.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the dashboard.",
+				Optional:            true,
+			},
+			"charts": schema.StringAttribute{
+				MarkdownDescription: "JSON-encoded array of the dashboard's chart definitions.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the dashboard belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the dashboard was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the dashboard was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+// ValidateConfig makes sure charts, when set, is a JSON array.
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var charts types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("charts"), &charts)...)
+	if resp.Diagnostics.HasError() || charts.IsNull() || charts.IsUnknown() {
+		return
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal([]byte(charts.ValueString()), &list); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("charts"), "Invalid Charts",
+			fmt.Sprintf("charts must be a JSON array of chart definitions: %s", err))
+	}
+}
+
+// buildDashboardRequest gathers the planned dashboard into a request. A
+// dashboard without charts is sent an empty array, so removing charts from
+// the config clears them.
+func buildDashboardRequest(data *DashboardResourceModel) dashboardRequest {
+	body := dashboardRequest{
+		Title:  data.Title.ValueString(),
+		Charts: json.RawMessage("[]"),
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		v := data.Description.ValueString()
+		body.Description = &v
+	}
+	if !data.Charts.IsNull() && !data.Charts.IsUnknown() {
+		body.Charts = json.RawMessage(data.Charts.ValueString())
+	}
+	return body
+}
+
+func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result dashboardAPIResponse
+	err := r.client.Create(ctx, "/api/v1/dashboards", buildDashboardRequest(&data), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating dashboard", err.Error())
+		return
+	}
+
+	mapDashboardResponseToState(&data, &result)
+	tflog.Trace(ctx, "created dashboard resource", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result dashboardAPIResponse
+	err := r.client.Get(ctx, "/api/v1/dashboards/"+data.ID.ValueString(), nil, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dashboard", err.Error())
+		return
+	}
+
+	mapDashboardResponseToState(&data, &result)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result dashboardAPIResponse
+	err := r.client.Patch(ctx, "/api/v1/dashboards/"+data.ID.ValueString(), buildDashboardRequest(&data), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating dashboard", err.Error())
+		return
+	}
+
+	mapDashboardResponseToState(&data, &result)
+	tflog.Trace(ctx, "updated dashboard resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, "/api/v1/dashboards/"+data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting dashboard", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted dashboard resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapDashboardResponseToState maps the API response onto Terraform state.
+// The charts keep their configured spelling when the API re-serializes the
+// same document, and an empty array stays null for dashboards that never
+// set any.
+func mapDashboardResponseToState(data *DashboardResourceModel, result *dashboardAPIResponse) {
+	data.ID = types.StringValue(result.ID)
+	data.Title = types.StringValue(result.Title)
+
+	if result.Description != nil && *result.Description != "" {
+		data.Description = types.StringValue(*result.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	data.Charts = dashboardCharts(data.Charts, result.Charts)
+
+	data.TenantID = types.StringValue(result.TenantID)
+	data.CreatedAt = types.StringValue(result.CreatedAt)
+	data.ModifiedAt = types.StringValue(result.ModifiedAt)
+}
+
+// dashboardCharts picks the state value for charts given the prior value and
+// what the API returned.
+func dashboardCharts(prior types.String, charts json.RawMessage) types.String {
+	if len(charts) == 0 || string(charts) == "null" {
+		return types.StringNull()
+	}
+	if prior.IsNull() && jsonSemanticallyEqual(string(charts), "[]") {
+		return prior
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && jsonSemanticallyEqual(string(charts), prior.ValueString()) {
+		return prior
+	}
+	return types.StringValue(string(charts))
+}
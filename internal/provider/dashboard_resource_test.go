@@ -0,0 +1,111 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestDashboardResource_chartsValidation checks charts must be a JSON array.
+func TestDashboardResource_chartsValidation(t *testing.T) {
+	cases := map[string]bool{
+		`[]`:                                  false,
+		`[{"title":"latency","type":"line"}]`: false,
+		`{"title":"latency"}`:                 true,
+		`not json`:                            true,
+	}
+
+	for charts, wantErr := range cases {
+		t.Run(charts, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_dashboard", map[string]tftypes.Value{
+				"title":  tftypes.NewValue(tftypes.String, "Front Street"),
+				"charts": tftypes.NewValue(tftypes.String, charts),
+			})
+			if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "Invalid Charts"); got != wantErr {
+				t.Errorf("expected error %v, got %v", wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestDashboardResource_createAndRead checks the dashboard is sent as
+// planned, and that the API's re-serialized charts don't show up as a diff.
+func TestDashboardResource_createAndRead(t *testing.T) {
+	var sent dashboardRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/dashboards":
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/dashboards/d1":
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"id": "d1",
+			"title": "Front Street",
+			"description": null,
+			"charts": [{"type": "line", "title": "latency"}],
+			"tenant_id": "t1",
+			"created_at": "2025-06-01T00:00:00Z",
+			"modified_at": "2025-06-01T00:00:00Z"
+		}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &DashboardResource{client: client.NewClient(ts.URL, "key", "")}
+	planned := DashboardResourceModel{
+		ID:          types.StringUnknown(),
+		Title:       types.StringValue("Front Street"),
+		Description: types.StringNull(),
+		Charts:      types.StringValue(`[{"title":"latency","type":"line"}]`),
+		TenantID:    types.StringUnknown(),
+		CreatedAt:   types.StringUnknown(),
+		ModifiedAt:  types.StringUnknown(),
+	}
+	plan := testResourceState(t, r, &planned)
+	resp := &resource.CreateResponse{State: tfsdk.State{
+		Schema: plan.Schema,
+		Raw:    tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", resp.Diagnostics)
+	}
+	if sent.Title != "Front Street" || sent.Description != nil || !jsonSemanticallyEqual(string(sent.Charts), planned.Charts.ValueString()) {
+		t.Errorf("unexpected request body %+v", sent)
+	}
+
+	var got DashboardResourceModel
+	resp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "d1" || got.TenantID.ValueString() != "t1" {
+		t.Errorf("expected the dashboard's computed fields, got %+v", got)
+	}
+	if got.Charts != planned.Charts {
+		t.Errorf("expected the configured charts to be kept, got %s", got.Charts)
+	}
+
+	// An import has no charts of its own, and takes the API's.
+	imported := dashboardCharts(types.StringNull(), json.RawMessage(`[{"type":"line"}]`))
+	if imported.ValueString() != `[{"type":"line"}]` {
+		t.Errorf("expected an import to take the API's charts, got %s", imported)
+	}
+	if got := dashboardCharts(types.StringNull(), json.RawMessage(`[]`)); !got.IsNull() {
+		t.Errorf("expected no charts to stay null, got %s", got)
+	}
+}
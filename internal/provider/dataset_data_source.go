@@ -9,8 +9,11 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -49,6 +52,7 @@ type DatasetDataSourceModel struct {
 	ExampleCount            types.Int64  `tfsdk:"example_count"`
 	SessionCount            types.Int64  `tfsdk:"session_count"`
 	LastSessionStartTime    types.String `tfsdk:"last_session_start_time"`
+	WorkspaceID             types.String `tfsdk:"workspace_id"`
 }
 
 // datasetDataSourceAPIResponse is the API response for a dataset lookup.
@@ -78,6 +82,7 @@ func (d *DatasetDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Use this data source to look up a LangSmith dataset by ID or name.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the dataset. Either `id` or `name` must be specified.",
 				Optional:            true,
@@ -93,8 +98,10 @@ func (d *DatasetDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 			},
 			"data_type": schema.StringAttribute{
-				MarkdownDescription: "The data type of the dataset (e.g., `kv`, `llm`, or `chat`).",
+				MarkdownDescription: "The data type of the dataset, one of `kv`, `llm`, or `chat`. When set, only a dataset of that type is found.",
+				Optional:            true,
 				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(datasetDataTypes...)},
 			},
 			"inputs_schema_definition": schema.StringAttribute{
 				MarkdownDescription: "JSON string of the inputs JSON schema definition.",
@@ -149,16 +156,16 @@ func (d *DatasetDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = c
+	d.client = data.client
 }
 
 func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -168,6 +175,8 @@ func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	idSet := !data.ID.IsNull() && !data.ID.IsUnknown()
 	nameSet := !data.Name.IsNull() && !data.Name.IsUnknown()
 
@@ -190,6 +199,9 @@ func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	} else {
 		query := url.Values{}
 		query.Set("name", data.Name.ValueString())
+		if !data.DataType.IsNull() && !data.DataType.IsUnknown() {
+			query.Set("data_type", data.DataType.ValueString())
+		}
 
 		var results []datasetDataSourceAPIResponse
 		err := d.client.Get(ctx, "/api/v1/datasets", query, &results)
@@ -209,6 +221,15 @@ func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		result = results[0]
 	}
 
+	if !data.DataType.IsNull() && !data.DataType.IsUnknown() && result.DataType != data.DataType.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data_type"),
+			"Dataset Data Type Mismatch",
+			fmt.Sprintf("Dataset %q is of type %q, not %q.", result.Name, result.DataType, data.DataType.ValueString()),
+		)
+		return
+	}
+
 	data.ID = types.StringValue(result.ID)
 	data.Name = types.StringValue(result.Name)
 
@@ -0,0 +1,641 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &DatasetExamplesResource{}
+	_ resource.ResourceWithImportState    = &DatasetExamplesResource{}
+	_ resource.ResourceWithValidateConfig = &DatasetExamplesResource{}
+	_ resource.ResourceWithModifyPlan     = &DatasetExamplesResource{}
+)
+
+const (
+	// datasetExamplesBatchSize caps how many examples go out in one bulk
+	// create or update request.
+	datasetExamplesBatchSize = 500
+
+	// datasetExamplesDeleteBatchSize caps how many example IDs ride along in
+	// one delete request's query string.
+	datasetExamplesDeleteBatchSize = 100
+)
+
+// NewDatasetExamplesResource returns a new DatasetExamplesResource, for
+// driving a whole herd of examples into a dataset at once.
+func NewDatasetExamplesResource() resource.Resource {
+	return &DatasetExamplesResource{}
+}
+
+// DatasetExamplesResource manages a set of examples in a LangSmith dataset as
+// one resource, using the bulk examples endpoints, so seeding a dataset with
+// hundreds of fixtures doesn't take hundreds of resources.
+type DatasetExamplesResource struct {
+	client *client.Client
+}
+
+// DatasetExamplesResourceModel holds the dataset and the examples managed in it.
+type DatasetExamplesResourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	DatasetID   types.String          `tfsdk:"dataset_id"`
+	Examples    []datasetExampleModel `tfsdk:"examples"`
+	WorkspaceID types.String          `tfsdk:"workspace_id"`
+}
+
+// datasetExampleModel is one example in the set.
+type datasetExampleModel struct {
+	ID       types.String `tfsdk:"id"`
+	Key      types.String `tfsdk:"key"`
+	Inputs   types.String `tfsdk:"inputs"`
+	Outputs  types.String `tfsdk:"outputs"`
+	Metadata types.String `tfsdk:"metadata"`
+	Split    types.String `tfsdk:"split"`
+}
+
+// exampleAPIBulkUpdateRequest is one entry in a bulk update: an example's ID
+// and its new fields.
+type exampleAPIBulkUpdateRequest struct {
+	ID       string          `json:"id"`
+	Inputs   json.RawMessage `json:"inputs"`
+	Outputs  json.RawMessage `json:"outputs,omitempty"`
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+	Split    *string         `json:"split,omitempty"`
+}
+
+func (r *DatasetExamplesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_examples"
+}
+
+func (r *DatasetExamplesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of examples in a LangSmith dataset as one resource, using the bulk examples endpoints. " +
+			"Examples are matched across changes by `key` when it's set, or by a hash of `inputs` otherwise: matches are updated in place, new examples are created, and examples dropped from the list are deleted. " +
+			"Examples in the dataset that this resource didn't create are left alone, except on import, which adopts every example in the dataset. " +
+			"Keys live in Terraform alone, so after an import each keyed example in config claims the adopted example with the same `inputs`.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the set, which is its `dataset_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the dataset the examples belong to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validUUID(),
+				},
+			},
+			"examples": schema.ListNestedAttribute{
+				MarkdownDescription: "The examples to manage.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the example.",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "A stable name for the example, unique within the set, used to match it across changes. " +
+								"Without one, the example is matched by a hash of its `inputs`, so changing the inputs replaces it.",
+							Optional: true,
+						},
+						"inputs": schema.StringAttribute{
+							MarkdownDescription: "JSON object containing the input data for the example.",
+							Required:            true,
+							PlanModifiers: []planmodifier.String{
+								jsonNormalize(),
+							},
+						},
+						"outputs": schema.StringAttribute{
+							MarkdownDescription: "JSON object containing the output data for the example.",
+							Optional:            true,
+							PlanModifiers: []planmodifier.String{
+								jsonNormalize(),
+							},
+						},
+						"metadata": schema.StringAttribute{
+							MarkdownDescription: "JSON object containing metadata for the example.",
+							Optional:            true,
+							PlanModifiers: []planmodifier.String{
+								jsonNormalize(),
+							},
+						},
+						"split": schema.StringAttribute{
+							MarkdownDescription: "The split for the example (e.g., `train`, `test`). The API puts examples without one in `base`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DatasetExamplesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+// ValidateConfig makes sure every example's JSON is an object and that no
+// two examples would be matched to the same one.
+func (r *DatasetExamplesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var list types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("examples"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	var examples []datasetExampleModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &examples, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]int{}
+	for i, ex := range examples {
+		fields := []struct {
+			name  string
+			value types.String
+		}{{"inputs", ex.Inputs}, {"outputs", ex.Outputs}, {"metadata", ex.Metadata}}
+		for _, f := range fields {
+			if f.value.IsNull() || f.value.IsUnknown() {
+				continue
+			}
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(f.value.ValueString()), &obj); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("examples").AtListIndex(i).AtName(f.name),
+					"Invalid Example JSON",
+					fmt.Sprintf("%s must be a JSON object: %s", f.name, err),
+				)
+			}
+		}
+
+		identity, ok := datasetExampleIdentity(ex)
+		if !ok {
+			continue
+		}
+		if first, dup := seen[identity]; dup {
+			detail := fmt.Sprintf("Examples %d and %d have the same key.", first, i)
+			if ex.Key.IsNull() {
+				detail = fmt.Sprintf("Examples %d and %d have the same inputs. Give them each a key to tell them apart.", first, i)
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("examples").AtListIndex(i),
+				"Duplicate Example",
+				detail,
+			)
+			continue
+		}
+		seen[identity] = i
+	}
+}
+
+// ModifyPlan carries each example's ID over from state when it matches an
+// example already there, so only genuinely new examples show up as unknown.
+func (r *DatasetExamplesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var list types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("examples"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsUnknown() {
+		return
+	}
+
+	var planned []datasetExampleModel
+	var state DatasetExamplesResourceModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := datasetExampleIDsByIdentity(state.Examples)
+	matched := make([]bool, len(planned))
+	for i, ex := range planned {
+		id := types.StringUnknown()
+		if identity, ok := datasetExampleIdentity(ex); ok {
+			if known, found := ids[identity]; found {
+				id = known
+				matched[i] = true
+				delete(ids, identity)
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("examples").AtListIndex(i).AtName("id"), id)...)
+	}
+
+	// Examples adopted on import come without keys, which live in
+	// Terraform alone. A keyed example that matched nothing by key claims an
+	// unkeyed one with the same inputs, rather than replacing it.
+	for i, ex := range planned {
+		if matched[i] || ex.Key.IsNull() || ex.Key.IsUnknown() || ex.Inputs.IsUnknown() {
+			continue
+		}
+		unkeyed := ex
+		unkeyed.Key = types.StringNull()
+		identity, _ := datasetExampleIdentity(unkeyed)
+		if known, found := ids[identity]; found {
+			delete(ids, identity)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("examples").AtListIndex(i).AtName("id"), known)...)
+		}
+	}
+}
+
+func (r *DatasetExamplesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatasetExamplesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	if partial := r.reconcile(ctx, &data, nil, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		if partial {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+	tflog.Trace(ctx, "created dataset examples resource", map[string]interface{}{"id": data.ID.ValueString(), "examples": len(data.Examples)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetExamplesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatasetExamplesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	datasetID := data.DatasetID.ValueString()
+	if datasetID == "" {
+		datasetID = data.ID.ValueString()
+	}
+
+	examples, err := r.listExamples(ctx, datasetID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dataset examples", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(datasetID)
+	data.DatasetID = types.StringValue(datasetID)
+	mapDatasetExamplesToState(&data, examples)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetExamplesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatasetExamplesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	if partial := r.reconcile(ctx, &data, state.Examples, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		if partial {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+	tflog.Trace(ctx, "updated dataset examples resource", map[string]interface{}{"id": data.ID.ValueString(), "examples": len(data.Examples)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetExamplesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatasetExamplesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	ids := make([]string, 0, len(data.Examples))
+	for _, ex := range data.Examples {
+		if ex.ID.ValueString() != "" {
+			ids = append(ids, ex.ID.ValueString())
+		}
+	}
+
+	if err := r.deleteExamples(ctx, ids); err != nil {
+		resp.Diagnostics.AddError("Error deleting dataset examples", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted dataset examples resource", map[string]interface{}{"id": data.ID.ValueString(), "examples": len(ids)})
+}
+
+// ImportState brings in every example in the dataset, by dataset ID.
+func (r *DatasetExamplesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), req.ID)...)
+}
+
+// reconcile squares the dataset with the planned examples. Planned examples
+// carrying an ID from state are updated in one bulk request if anything
+// changed, those without one are created in another, and prior examples no
+// longer planned are deleted. On success data holds every example's ID.
+//
+// A create that fails after earlier batches went through reports partial:
+// data then holds just the examples that exist, so they can be saved to state
+// rather than left behind.
+func (r *DatasetExamplesResource) reconcile(ctx context.Context, data *DatasetExamplesResourceModel, prior []datasetExampleModel, diags *diag.Diagnostics) (partial bool) {
+	datasetID := data.DatasetID.ValueString()
+
+	priorByID := make(map[string]datasetExampleModel, len(prior))
+	for _, ex := range prior {
+		priorByID[ex.ID.ValueString()] = ex
+	}
+
+	var creates []exampleAPICreateRequest
+	var createIndexes []int
+	var updates []exampleAPIBulkUpdateRequest
+	for i, ex := range data.Examples {
+		id := ex.ID.ValueString()
+		old, ok := priorByID[id]
+		if ex.ID.IsUnknown() || ex.ID.IsNull() || !ok {
+			creates = append(creates, exampleAPICreateRequest{
+				DatasetID: datasetID,
+				Inputs:    json.RawMessage(ex.Inputs.ValueString()),
+				Outputs:   exampleOptionalJSON(ex.Outputs),
+				Metadata:  exampleOptionalJSON(ex.Metadata),
+				Split:     ex.Split.ValueStringPointer(),
+			})
+			createIndexes = append(createIndexes, i)
+			continue
+		}
+
+		delete(priorByID, id)
+		if datasetExampleUnchanged(old, ex) {
+			continue
+		}
+		updates = append(updates, exampleAPIBulkUpdateRequest{
+			ID:       id,
+			Inputs:   json.RawMessage(ex.Inputs.ValueString()),
+			Outputs:  exampleOptionalJSON(ex.Outputs),
+			Metadata: exampleOptionalJSON(ex.Metadata),
+			Split:    ex.Split.ValueStringPointer(),
+		})
+	}
+
+	// Clear out the dropped examples first, so a re-keyed example doesn't
+	// briefly sit alongside the one it replaces.
+	dropped := make([]string, 0, len(priorByID))
+	for id := range priorByID {
+		dropped = append(dropped, id)
+	}
+	sort.Strings(dropped)
+	if err := r.deleteExamples(ctx, dropped); err != nil {
+		diags.AddError("Error deleting dataset examples", err.Error())
+		return
+	}
+
+	for start := 0; start < len(updates); start += datasetExamplesBatchSize {
+		end := min(start+datasetExamplesBatchSize, len(updates))
+		if err := r.client.Patch(ctx, "/api/v1/examples/bulk", updates[start:end], nil); err != nil {
+			diags.AddError("Error updating dataset examples", err.Error())
+			return
+		}
+	}
+
+	for start := 0; start < len(creates); start += datasetExamplesBatchSize {
+		end := min(start+datasetExamplesBatchSize, len(creates))
+
+		var results []exampleAPIResponse
+		if err := r.client.Create(ctx, "/api/v1/examples/bulk", creates[start:end], &results); err != nil {
+			diags.AddError("Error creating dataset examples", err.Error())
+			return datasetExamplesCreated(data, start)
+		}
+		if len(results) != end-start {
+			diags.AddError(
+				"Unexpected Bulk Create Response",
+				fmt.Sprintf("Sent %d examples to create, but the API answered for %d.", end-start, len(results)),
+			)
+			return datasetExamplesCreated(data, start)
+		}
+		for j, result := range results {
+			data.Examples[createIndexes[start+j]].ID = types.StringValue(result.ID)
+		}
+	}
+
+	data.ID = types.StringValue(datasetID)
+	return false
+}
+
+// datasetExamplesCreated trims data down to the examples that exist after a
+// create failed, once created of them had gone through, and reports whether
+// there's anything worth saving.
+func datasetExamplesCreated(data *DatasetExamplesResourceModel, created int) bool {
+	if created == 0 {
+		return false
+	}
+
+	kept := make([]datasetExampleModel, 0, len(data.Examples))
+	for _, ex := range data.Examples {
+		if !ex.ID.IsUnknown() && !ex.ID.IsNull() {
+			kept = append(kept, ex)
+		}
+	}
+	data.Examples = kept
+	data.ID = data.DatasetID
+	return true
+}
+
+// deleteExamples deletes examples by ID, a batch at a time. Examples already
+// gone are no trouble.
+func (r *DatasetExamplesResource) deleteExamples(ctx context.Context, ids []string) error {
+	for start := 0; start < len(ids); start += datasetExamplesDeleteBatchSize {
+		end := min(start+datasetExamplesDeleteBatchSize, len(ids))
+
+		q := url.Values{}
+		for _, id := range ids[start:end] {
+			q.Add("example_ids", id)
+		}
+		if err := r.client.DeleteWithQuery(ctx, "/api/v1/examples", q); err != nil && !client.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// listExamples collects every example in the dataset.
+func (r *DatasetExamplesResource) listExamples(ctx context.Context, datasetID string) ([]exampleAPIResponse, error) {
+	query := url.Values{}
+	query.Set("dataset", datasetID)
+
+	return client.GetAll[exampleAPIResponse](ctx, r.client, "/api/v1/examples", query)
+}
+
+// mapDatasetExamplesToState reconciles state with the examples the dataset
+// holds. Examples deleted out from under us are dropped, so the next plan
+// brings them back. A freshly imported set, with no examples in state yet,
+// adopts every example in the dataset, oldest first.
+func mapDatasetExamplesToState(data *DatasetExamplesResourceModel, examples []exampleAPIResponse) {
+	byID := make(map[string]*exampleAPIResponse, len(examples))
+	for i := range examples {
+		byID[examples[i].ID] = &examples[i]
+	}
+
+	if data.Examples == nil {
+		sort.SliceStable(examples, func(i, j int) bool { return examples[i].CreatedAt < examples[j].CreatedAt })
+		data.Examples = make([]datasetExampleModel, 0, len(examples))
+		for i := range examples {
+			data.Examples = append(data.Examples, datasetExampleFromAPI(datasetExampleModel{
+				Key:      types.StringNull(),
+				Outputs:  types.StringNull(),
+				Metadata: types.StringNull(),
+				Split:    types.StringNull(),
+			}, &examples[i]))
+		}
+		return
+	}
+
+	kept := make([]datasetExampleModel, 0, len(data.Examples))
+	for _, ex := range data.Examples {
+		if result, ok := byID[ex.ID.ValueString()]; ok {
+			kept = append(kept, datasetExampleFromAPI(ex, result))
+		}
+	}
+	data.Examples = kept
+}
+
+// datasetExampleFromAPI lays an API example over its prior state. JSON that
+// means the same as before keeps the prior spelling, and the API's empty
+// outputs and default split don't count as drift for an example that never
+// set them.
+func datasetExampleFromAPI(prior datasetExampleModel, result *exampleAPIResponse) datasetExampleModel {
+	ex := prior
+	ex.ID = types.StringValue(result.ID)
+	ex.Inputs = datasetExampleJSONValue(prior.Inputs, result.Inputs)
+	ex.Outputs = datasetExampleJSONValue(prior.Outputs, result.Outputs)
+	ex.Metadata = datasetExampleJSONValue(prior.Metadata, result.Metadata)
+
+	switch {
+	case result.Split == nil:
+		ex.Split = types.StringNull()
+	case prior.Split.IsNull() && *result.Split == "base":
+		ex.Split = types.StringNull()
+	default:
+		ex.Split = types.StringValue(*result.Split)
+	}
+	return ex
+}
+
+// datasetExampleJSONValue picks the state value for one of an example's JSON
+// fields.
+func datasetExampleJSONValue(prior types.String, raw json.RawMessage) types.String {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+	if prior.IsNull() && jsonSemanticallyEqual(string(raw), "{}") {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && jsonSemanticallyEqual(prior.ValueString(), string(raw)) {
+		return prior
+	}
+	return types.StringValue(string(raw))
+}
+
+// datasetExampleUnchanged reports whether a planned example says the same
+// thing as its prior state, so it can sit out the bulk update.
+func datasetExampleUnchanged(prior, planned datasetExampleModel) bool {
+	sameJSON := func(a, b types.String) bool {
+		if a.IsNull() || b.IsNull() {
+			return a.IsNull() == b.IsNull()
+		}
+		return jsonSemanticallyEqual(a.ValueString(), b.ValueString())
+	}
+	return sameJSON(prior.Inputs, planned.Inputs) &&
+		sameJSON(prior.Outputs, planned.Outputs) &&
+		sameJSON(prior.Metadata, planned.Metadata) &&
+		prior.Split.Equal(planned.Split)
+}
+
+// datasetExampleIdentity is how an example is recognized across changes: its
+// key when it has one, otherwise a hash of its inputs. The second result is
+// false when the identity can't be known yet.
+func datasetExampleIdentity(ex datasetExampleModel) (string, bool) {
+	if ex.Key.IsUnknown() || (ex.Key.IsNull() && ex.Inputs.IsUnknown()) {
+		return "", false
+	}
+	if !ex.Key.IsNull() {
+		return "key:" + ex.Key.ValueString(), true
+	}
+
+	// Hash the inputs as Go re-encodes them, which sorts object keys, so
+	// spacing and key order don't change who an example is.
+	canonical := []byte(ex.Inputs.ValueString())
+	var v interface{}
+	if err := json.Unmarshal(canonical, &v); err == nil {
+		if b, err := json.Marshal(v); err == nil {
+			canonical = b
+		}
+	}
+	sum := sha256.Sum256(canonical)
+	return "inputs:" + hex.EncodeToString(sum[:]), true
+}
+
+// datasetExampleIDsByIdentity maps each example in state to its ID.
+func datasetExampleIDsByIdentity(examples []datasetExampleModel) map[string]types.String {
+	ids := make(map[string]types.String, len(examples))
+	for _, ex := range examples {
+		if identity, ok := datasetExampleIdentity(ex); ok {
+			ids[identity] = ex.ID
+		}
+	}
+	return ids
+}
@@ -0,0 +1,394 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const testDatasetExamplesDatasetID = "00000000-0000-0000-0000-0000000000d5"
+
+// testDatasetExample builds an example model with everything but inputs unset.
+func testDatasetExample(id, key, inputs string) datasetExampleModel {
+	ex := datasetExampleModel{
+		ID:       types.StringValue(id),
+		Key:      types.StringNull(),
+		Inputs:   types.StringValue(inputs),
+		Outputs:  types.StringNull(),
+		Metadata: types.StringNull(),
+		Split:    types.StringNull(),
+	}
+	if id == "" {
+		ex.ID = types.StringUnknown()
+	}
+	if key != "" {
+		ex.Key = types.StringValue(key)
+	}
+	return ex
+}
+
+// TestDatasetExamplesResource_identity checks examples are known by key when
+// they have one, and otherwise by their inputs regardless of spacing or key
+// order.
+func TestDatasetExamplesResource_identity(t *testing.T) {
+	a, _ := datasetExampleIdentity(testDatasetExample("", "", `{"q": "who", "lang": "en"}`))
+	b, _ := datasetExampleIdentity(testDatasetExample("", "", `{"lang":"en","q":"who"}`))
+	if a != b {
+		t.Errorf("expected the same inputs to match, got %q and %q", a, b)
+	}
+
+	c, _ := datasetExampleIdentity(testDatasetExample("", "", `{"q": "what"}`))
+	if a == c {
+		t.Error("expected different inputs to differ")
+	}
+
+	keyed, _ := datasetExampleIdentity(testDatasetExample("", "marshal", `{"q": "who"}`))
+	rekeyed, _ := datasetExampleIdentity(testDatasetExample("", "marshal", `{"q": "what"}`))
+	if keyed != rekeyed {
+		t.Errorf("expected a key to outweigh the inputs, got %q and %q", keyed, rekeyed)
+	}
+
+	unknown := testDatasetExample("", "", "")
+	unknown.Inputs = types.StringUnknown()
+	if _, ok := datasetExampleIdentity(unknown); ok {
+		t.Error("expected no identity for unknown inputs without a key")
+	}
+}
+
+// TestDatasetExamplesResource_validate checks example JSON must be objects
+// and no two examples may be matched to the same one.
+func TestDatasetExamplesResource_validate(t *testing.T) {
+	exampleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":       tftypes.String,
+		"key":      tftypes.String,
+		"inputs":   tftypes.String,
+		"outputs":  tftypes.String,
+		"metadata": tftypes.String,
+		"split":    tftypes.String,
+	}}
+	example := func(key, inputs string) tftypes.Value {
+		k := tftypes.NewValue(tftypes.String, nil)
+		if key != "" {
+			k = tftypes.NewValue(tftypes.String, key)
+		}
+		return tftypes.NewValue(exampleType, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, nil),
+			"key":      k,
+			"inputs":   tftypes.NewValue(tftypes.String, inputs),
+			"outputs":  tftypes.NewValue(tftypes.String, nil),
+			"metadata": tftypes.NewValue(tftypes.String, nil),
+			"split":    tftypes.NewValue(tftypes.String, nil),
+		})
+	}
+
+	cases := map[string]struct {
+		examples []tftypes.Value
+		wantErr  string
+	}{
+		"distinct inputs": {
+			examples: []tftypes.Value{example("", `{"q": "who"}`), example("", `{"q": "what"}`)},
+		},
+		"same inputs, different keys": {
+			examples: []tftypes.Value{example("a", `{"q": "who"}`), example("b", `{"q": "who"}`)},
+		},
+		"same inputs, no keys": {
+			examples: []tftypes.Value{example("", `{"q": "who"}`), example("", `{ "q":"who" }`)},
+			wantErr:  "Give them each a key",
+		},
+		"same key": {
+			examples: []tftypes.Value{example("a", `{"q": "who"}`), example("a", `{"q": "what"}`)},
+			wantErr:  "same key",
+		},
+		"inputs not an object": {
+			examples: []tftypes.Value{example("", `["who"]`)},
+			wantErr:  "Invalid Example JSON",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_dataset_examples", map[string]tftypes.Value{
+				"dataset_id": tftypes.NewValue(tftypes.String, testDatasetExamplesDatasetID),
+				"examples":   tftypes.NewValue(tftypes.List{ElementType: exampleType}, tc.examples),
+			})
+			if tc.wantErr == "" {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestDatasetExamplesResource_reconcile checks an update sends one bulk
+// request each for the creates and the changed examples, skips unchanged
+// ones, and deletes what was dropped.
+func TestDatasetExamplesResource_reconcile(t *testing.T) {
+	var created []exampleAPICreateRequest
+	var updated []exampleAPIBulkUpdateRequest
+	var deleted []string
+	posts, patches := 0, 0
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/examples/bulk":
+			posts++
+			var batch []exampleAPICreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			results := make([]exampleAPIResponse, 0, len(batch))
+			for _, ex := range batch {
+				created = append(created, ex)
+				results = append(results, exampleAPIResponse{ID: fmt.Sprintf("new-%d", len(created)), DatasetID: ex.DatasetID})
+			}
+			_ = json.NewEncoder(w).Encode(results)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/examples/bulk":
+			patches++
+			var batch []exampleAPIBulkUpdateRequest
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			updated = append(updated, batch...)
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/examples":
+			deleted = append(deleted, r.URL.Query()["example_ids"]...)
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	r := &DatasetExamplesResource{client: client.NewClient(ts.URL, "key", "")}
+
+	prior := []datasetExampleModel{
+		testDatasetExample("ex-1", "kitty", `{"q": "who runs the Long Branch?"}`),
+		testDatasetExample("ex-2", "", `{"q": "who's the marshal?"}`),
+		testDatasetExample("ex-3", "", `{"q": "who limps?"}`),
+	}
+
+	changed := testDatasetExample("ex-1", "kitty", `{"q": "who owns the Long Branch?"}`)
+	unchanged := testDatasetExample("ex-2", "", `{ "q":"who's the marshal?" }`)
+	fresh := testDatasetExample("", "", `{"q": "who's the doc?"}`)
+	data := &DatasetExamplesResourceModel{
+		DatasetID: types.StringValue(testDatasetExamplesDatasetID),
+		Examples:  []datasetExampleModel{fresh, changed, unchanged},
+	}
+
+	var diags diag.Diagnostics
+	r.reconcile(context.Background(), data, prior, &diags)
+	if diags.HasError() {
+		t.Fatalf("reconciling: %v", diags)
+	}
+
+	if posts != 1 || len(created) != 1 || !jsonSemanticallyEqual(string(created[0].Inputs), fresh.Inputs.ValueString()) || created[0].DatasetID != testDatasetExamplesDatasetID {
+		t.Errorf("expected one bulk create for the new example, got %d requests: %+v", posts, created)
+	}
+	if patches != 1 || len(updated) != 1 || updated[0].ID != "ex-1" {
+		t.Errorf("expected one bulk update for the changed example only, got %d requests: %+v", patches, updated)
+	}
+	if len(deleted) != 1 || deleted[0] != "ex-3" {
+		t.Errorf("expected the dropped example to be deleted, got %v", deleted)
+	}
+
+	ids := make([]string, 0, len(data.Examples))
+	for _, ex := range data.Examples {
+		ids = append(ids, ex.ID.ValueString())
+	}
+	if fmt.Sprint(ids) != "[new-1 ex-1 ex-2]" {
+		t.Errorf("unexpected example IDs %v", ids)
+	}
+	if data.ID.ValueString() != testDatasetExamplesDatasetID {
+		t.Errorf("expected id to be the dataset ID, got %s", data.ID)
+	}
+}
+
+// TestDatasetExamplesResource_reconcileBatches checks a large seed is split
+// into bulk requests no bigger than the batch size.
+func TestDatasetExamplesResource_reconcileBatches(t *testing.T) {
+	var sizes []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []exampleAPICreateRequest
+		_ = json.NewDecoder(r.Body).Decode(&batch)
+		sizes = append(sizes, len(batch))
+		results := make([]exampleAPIResponse, len(batch))
+		for i := range batch {
+			results[i].ID = fmt.Sprintf("id-%d-%d", len(sizes), i)
+		}
+		_ = json.NewEncoder(w).Encode(results)
+	}))
+	defer ts.Close()
+
+	r := &DatasetExamplesResource{client: client.NewClient(ts.URL, "key", "")}
+
+	total := datasetExamplesBatchSize + 20
+	data := &DatasetExamplesResourceModel{DatasetID: types.StringValue(testDatasetExamplesDatasetID)}
+	for i := 0; i < total; i++ {
+		data.Examples = append(data.Examples, testDatasetExample("", "", fmt.Sprintf(`{"n": %d}`, i)))
+	}
+
+	var diags diag.Diagnostics
+	r.reconcile(context.Background(), data, nil, &diags)
+	if diags.HasError() {
+		t.Fatalf("reconciling: %v", diags)
+	}
+
+	if fmt.Sprint(sizes) != fmt.Sprint([]int{datasetExamplesBatchSize, 20}) {
+		t.Errorf("unexpected batch sizes %v", sizes)
+	}
+	seen := map[string]bool{}
+	for _, ex := range data.Examples {
+		if ex.ID.IsUnknown() || seen[ex.ID.ValueString()] {
+			t.Fatalf("expected every example to get its own ID, got %s", ex.ID)
+		}
+		seen[ex.ID.ValueString()] = true
+	}
+}
+
+// TestDatasetExamplesResource_mapping checks Read drops examples deleted out
+// from under us, doesn't read API defaults as drift, and adopts every example
+// on import.
+func TestDatasetExamplesResource_mapping(t *testing.T) {
+	base := "base"
+	test := "test"
+	examples := []exampleAPIResponse{
+		{ID: "ex-2", Inputs: json.RawMessage(`{"q":"b"}`), Outputs: json.RawMessage(`{}`), Split: &test, CreatedAt: "2025-01-02T00:00:00Z"},
+		{ID: "ex-1", Inputs: json.RawMessage(`{"q":"a"}`), Outputs: json.RawMessage(`{"a":1}`), Split: &base, CreatedAt: "2025-01-01T00:00:00Z"},
+	}
+
+	withOutputs := testDatasetExample("ex-1", "first", `{ "q": "a" }`)
+	withOutputs.Outputs = types.StringValue(`{"a": 1}`)
+	data := DatasetExamplesResourceModel{Examples: []datasetExampleModel{
+		withOutputs,
+		testDatasetExample("ex-gone", "", `{"q":"gone"}`),
+		testDatasetExample("ex-2", "", `{"q":"b"}`),
+	}}
+	mapDatasetExamplesToState(&data, examples)
+
+	if len(data.Examples) != 2 {
+		t.Fatalf("expected the deleted example to be dropped, got %+v", data.Examples)
+	}
+	first := data.Examples[0]
+	if first.Inputs.ValueString() != `{ "q": "a" }` || first.Outputs.ValueString() != `{"a": 1}` || !first.Split.IsNull() || first.Key.ValueString() != "first" {
+		t.Errorf("expected the first example to read back as configured, got %+v", first)
+	}
+	second := data.Examples[1]
+	if !second.Outputs.IsNull() || second.Split.ValueString() != "test" {
+		t.Errorf("expected empty outputs to stay null and a real split to come through, got %+v", second)
+	}
+
+	var imported DatasetExamplesResourceModel
+	mapDatasetExamplesToState(&imported, examples)
+	ids := make([]string, 0, len(imported.Examples))
+	for _, ex := range imported.Examples {
+		ids = append(ids, ex.ID.ValueString())
+	}
+	if !sort.StringsAreSorted(ids) || len(ids) != 2 {
+		t.Errorf("expected an import to adopt every example, oldest first, got %v", ids)
+	}
+}
+
+// TestDatasetExamplesResource_keyedAfterImport checks keyed examples in config
+// claim the unkeyed ones adopted on import by their inputs, instead of
+// planning to replace every one.
+func TestDatasetExamplesResource_keyedAfterImport(t *testing.T) {
+	ctx := context.Background()
+	r := &DatasetExamplesResource{}
+	state := testResourceState(t, r, &DatasetExamplesResourceModel{
+		ID:          types.StringValue(testDatasetExamplesDatasetID),
+		DatasetID:   types.StringValue(testDatasetExamplesDatasetID),
+		WorkspaceID: types.StringNull(),
+		Examples: []datasetExampleModel{
+			testDatasetExample("ex-1", "", `{"q": "who runs the Long Branch?"}`),
+			testDatasetExample("ex-2", "", `{"q": "who's the marshal?"}`),
+		},
+	})
+	planned := testResourceState(t, r, &DatasetExamplesResourceModel{
+		ID:          types.StringValue(testDatasetExamplesDatasetID),
+		DatasetID:   types.StringValue(testDatasetExamplesDatasetID),
+		WorkspaceID: types.StringNull(),
+		Examples: []datasetExampleModel{
+			testDatasetExample("", "kitty", `{ "q": "who runs the Long Branch?" }`),
+			testDatasetExample("", "doc", `{"q": "who's the doc?"}`),
+		},
+	})
+
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(planned)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: tfsdk.Config(planned), Plan: tfsdk.Plan(planned), State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("planning: %v", resp.Diagnostics)
+	}
+
+	var got DatasetExamplesResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	if got.Examples[0].ID.ValueString() != "ex-1" || !got.Examples[1].ID.IsUnknown() {
+		t.Errorf("expected the keyed example to claim ex-1 and the new one to be created, got %s and %s", got.Examples[0].ID, got.Examples[1].ID)
+	}
+}
+
+// TestDatasetExamplesResource_partialCreate checks a create that fails partway
+// saves the examples already created, so they aren't left behind.
+func TestDatasetExamplesResource_partialCreate(t *testing.T) {
+	batches := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		batches++
+		if batches > 1 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"detail": "inputs too large"}`))
+			return
+		}
+		var batch []exampleAPICreateRequest
+		_ = json.NewDecoder(r.Body).Decode(&batch)
+		results := make([]exampleAPIResponse, len(batch))
+		for i := range batch {
+			results[i].ID = fmt.Sprintf("id-%d", i)
+		}
+		_ = json.NewEncoder(w).Encode(results)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &DatasetExamplesResource{client: client.NewClient(ts.URL, "key", "")}
+	model := &DatasetExamplesResourceModel{
+		ID:          types.StringUnknown(),
+		DatasetID:   types.StringValue(testDatasetExamplesDatasetID),
+		WorkspaceID: types.StringNull(),
+	}
+	for i := 0; i < datasetExamplesBatchSize+5; i++ {
+		model.Examples = append(model.Examples, testDatasetExample("", "", fmt.Sprintf(`{"n": %d}`, i)))
+	}
+	plan := testResourceState(t, r, model)
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the failed batch to be reported")
+	}
+
+	var got DatasetExamplesResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ID.ValueString() != testDatasetExamplesDatasetID || len(got.Examples) != datasetExamplesBatchSize {
+		t.Fatalf("expected the first batch to be saved, got %d examples", len(got.Examples))
+	}
+	if got.Examples[0].ID.ValueString() != "id-0" {
+		t.Errorf("expected the saved examples to carry their IDs, got %s", got.Examples[0].ID)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &DatasetReadyDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DatasetReadyDataSource{}
+)
+
+// defaultDatasetReadyTimeout is how long Read waits for examples to arrive
+// when no timeout is set.
+const defaultDatasetReadyTimeout = 10 * time.Minute
+
+// datasetReadyPollInterval is how often the dataset's example count is
+// checked. A variable so tests don't have to wait around.
+var datasetReadyPollInterval = 5 * time.Second
+
+// NewDatasetReadyDataSource returns a new DatasetReadyDataSource, for waiting
+// on a dataset to fill up before the rest of the posse rides out.
+func NewDatasetReadyDataSource() datasource.DataSource {
+	return &DatasetReadyDataSource{}
+}
+
+// DatasetReadyDataSource waits for a dataset to hold a minimum number of
+// examples, for pipelines where another tool loads them in the background.
+type DatasetReadyDataSource struct {
+	client *client.Client
+}
+
+// DatasetReadyDataSourceModel holds the dataset to watch, how many examples
+// to wait for and how long, and whether they showed up.
+type DatasetReadyDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	DatasetID    types.String `tfsdk:"dataset_id"`
+	MinExamples  types.Int64  `tfsdk:"min_examples"`
+	Timeout      types.String `tfsdk:"timeout"`
+	Ready        types.Bool   `tfsdk:"ready"`
+	ExampleCount types.Int64  `tfsdk:"example_count"`
+	WorkspaceID  types.String `tfsdk:"workspace_id"`
+}
+
+// datasetReadyAPIResponse is the slice of the dataset response we need.
+type datasetReadyAPIResponse struct {
+	ID           string `json:"id"`
+	ExampleCount int64  `json:"example_count"`
+}
+
+func (d *DatasetReadyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_ready"
+}
+
+func (d *DatasetReadyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to wait until a LangSmith dataset holds at least `min_examples` examples, for gating resources on examples loaded asynchronously by another tool. " +
+			"Running out of time isn't an error: `ready` comes back `false` with a warning, so gate dependents on it with a precondition.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset.",
+				Computed:            true,
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset to watch.",
+				Required:            true,
+				Validators: []validator.String{
+					validUUID(),
+				},
+			},
+			"min_examples": schema.Int64Attribute{
+				MarkdownDescription: "The number of examples the dataset must hold to be considered ready.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the examples, as a duration such as `30s` or `10m`. Defaults to `10m`.",
+				Optional:            true,
+			},
+			"ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether the dataset reached `min_examples` before the timeout.",
+				Computed:            true,
+			},
+			"example_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of examples in the dataset when the wait ended.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DatasetReadyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+// ValidateConfig makes sure the timeout is a duration we can read.
+func (d *DatasetReadyDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if resp.Diagnostics.HasError() || timeout.IsNull() || timeout.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			fmt.Sprintf("timeout must be a positive duration such as \"30s\" or \"10m\", got %q.", timeout.ValueString()),
+		)
+	}
+}
+
+func (d *DatasetReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatasetReadyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	timeout := defaultDatasetReadyTimeout
+	if !data.Timeout.IsNull() {
+		if t, err := time.ParseDuration(data.Timeout.ValueString()); err == nil && t > 0 {
+			timeout = t
+		}
+	}
+
+	datasetID := data.DatasetID.ValueString()
+	minExamples := data.MinExamples.ValueInt64()
+
+	var result datasetReadyAPIResponse
+	err := poll(ctx, datasetReadyPollInterval, timeout, func(ctx context.Context) (bool, error) {
+		if err := d.client.Get(ctx, "/api/v1/datasets/"+datasetID, nil, &result); err != nil {
+			return false, err
+		}
+		tflog.Debug(ctx, "checking dataset example count", map[string]interface{}{
+			"id": datasetID, "example_count": result.ExampleCount, "min_examples": minExamples,
+		})
+		return result.ExampleCount >= minExamples, nil
+	})
+	if err != nil && !errors.Is(err, errPollTimeout) {
+		resp.Diagnostics.AddError("Error reading dataset", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(datasetID)
+	data.ExampleCount = types.Int64Value(result.ExampleCount)
+	data.Ready = types.BoolValue(err == nil)
+
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Dataset Not Ready",
+			fmt.Sprintf("Dataset %s held %d of %d examples after %s.", datasetID, result.ExampleCount, minExamples, timeout),
+		)
+	}
+
+	tflog.Trace(ctx, "read dataset ready data source", map[string]interface{}{"id": datasetID, "ready": err == nil})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const testDatasetReadyID = "2b0c1f4e-6f5a-4c1d-9a57-0e4c2d5b7a10"
+
+// testDatasetReadyRead points the data source at a dataset whose example
+// count grows by ten on every look, the way a background loader fills it.
+func testDatasetReadyRead(t *testing.T, minExamples int64, timeout types.String) (DatasetReadyDataSourceModel, diag.Diagnostics, int) {
+	t.Helper()
+	defer func(d time.Duration) { datasetReadyPollInterval = d }(datasetReadyPollInterval)
+	datasetReadyPollInterval = time.Millisecond
+
+	looks := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/datasets/"+testDatasetReadyID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		looks++
+		_ = json.NewEncoder(w).Encode(datasetReadyAPIResponse{ID: testDatasetReadyID, ExampleCount: int64(looks-1) * 10})
+	}))
+	defer srv.Close()
+
+	d := &DatasetReadyDataSource{client: client.NewClient(srv.URL, "key", "")}
+	resp := testDataSourceRead(t, d, &DatasetReadyDataSourceModel{
+		ID:           types.StringNull(),
+		DatasetID:    types.StringValue(testDatasetReadyID),
+		MinExamples:  types.Int64Value(minExamples),
+		Timeout:      timeout,
+		Ready:        types.BoolNull(),
+		ExampleCount: types.Int64Null(),
+	})
+
+	var got DatasetReadyDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	return got, resp.Diagnostics, looks
+}
+
+// TestDatasetReadyDataSource_waitsForExamples checks the data source keeps
+// watching while the count grows, and stops as soon as it's enough.
+func TestDatasetReadyDataSource_waitsForExamples(t *testing.T) {
+	got, diags, looks := testDatasetReadyRead(t, 25, types.StringNull())
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got.Ready.ValueBool() || got.ExampleCount.ValueInt64() != 30 {
+		t.Errorf("expected ready with 30 examples, got ready %s with %s", got.Ready, got.ExampleCount)
+	}
+	if looks != 4 {
+		t.Errorf("expected four looks at the dataset, got %d", looks)
+	}
+	if got.ID.ValueString() != testDatasetReadyID {
+		t.Errorf("expected id %s, got %s", testDatasetReadyID, got.ID)
+	}
+}
+
+// TestDatasetReadyDataSource_timeout checks a dataset that never fills up
+// comes back not ready with a warning, rather than failing the plan.
+func TestDatasetReadyDataSource_timeout(t *testing.T) {
+	got, diags, _ := testDatasetReadyRead(t, 1_000_000, types.StringValue("20ms"))
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if got.Ready.ValueBool() || got.ExampleCount.IsNull() {
+		t.Errorf("expected not ready with the last count, got ready %s with %s", got.Ready, got.ExampleCount)
+	}
+}
@@ -7,14 +7,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -24,6 +30,7 @@ import (
 var (
 	_ resource.Resource                = &DatasetResource{}
 	_ resource.ResourceWithImportState = &DatasetResource{}
+	_ resource.ResourceWithModifyPlan  = &DatasetResource{}
 )
 
 // NewDatasetResource constructs a fresh DatasetResource for managing LangSmith
@@ -32,6 +39,10 @@ func NewDatasetResource() resource.Resource {
 	return &DatasetResource{}
 }
 
+// datasetDataTypes are the kinds of dataset LangSmith knows: plain key-value
+// examples, LLM prompt/completion pairs, and chat transcripts.
+var datasetDataTypes = []string{"kv", "llm", "chat"}
+
 // DatasetResource manages a LangSmith dataset — a well-organized stockyard for
 // your evaluation data.
 type DatasetResource struct {
@@ -50,12 +61,14 @@ type DatasetResourceModel struct {
 	ExternallyManaged       types.Bool   `tfsdk:"externally_managed"`
 	Transformations         types.String `tfsdk:"transformations"`
 	Metadata                types.String `tfsdk:"metadata"`
+	InferSchemaFromExamples types.Bool   `tfsdk:"infer_schema_from_examples"`
 	ExampleCount            types.Int64  `tfsdk:"example_count"`
 	SessionCount            types.Int64  `tfsdk:"session_count"`
 	ModifiedAt              types.String `tfsdk:"modified_at"`
 	LastSessionStartTime    types.String `tfsdk:"last_session_start_time"`
 	TenantID                types.String `tfsdk:"tenant_id"`
 	CreatedAt               types.String `tfsdk:"created_at"`
+	WorkspaceID             types.String `tfsdk:"workspace_id"`
 }
 
 // datasetAPIRequest is the wire format for creating or updating a dataset on
@@ -99,6 +112,7 @@ func (r *DatasetResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith dataset.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the dataset.",
 				Computed:            true,
@@ -119,14 +133,31 @@ func (r *DatasetResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("kv"),
+				Validators:          []validator.String{stringvalidator.OneOf(datasetDataTypes...)},
 			},
 			"inputs_schema_definition": schema.StringAttribute{
-				MarkdownDescription: "JSON string defining the inputs schema.",
+				MarkdownDescription: "JSON string defining the inputs schema. Inferred from the dataset's examples when `infer_schema_from_examples` is enabled and this is left unset.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"outputs_schema_definition": schema.StringAttribute{
-				MarkdownDescription: "JSON string defining the outputs schema.",
+				MarkdownDescription: "JSON string defining the outputs schema. Inferred from the dataset's examples when `infer_schema_from_examples` is enabled and this is left unset.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
+			},
+			"infer_schema_from_examples": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, sets any unconfigured `inputs_schema_definition`/`outputs_schema_definition` to a normalized JSON schema inferred from a sample of the dataset's examples. " +
+					"Inference runs on the first apply once the dataset has examples, and again whenever `example_count` changes. " +
+					"Defaults to `false`, in which case an unconfigured definition is cleared.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"externally_managed": schema.BoolAttribute{
 				MarkdownDescription: "Whether the dataset is externally managed.",
@@ -139,11 +170,18 @@ func (r *DatasetResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"transformations": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded array of dataset transformations.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"metadata": schema.StringAttribute{
-				MarkdownDescription: "JSON-encoded metadata object for the dataset.",
+				MarkdownDescription: "JSON-encoded metadata object for the dataset. Keys the server adds on its own aren't reported as drift; only a change to a key set here is.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"example_count": schema.Int64Attribute{
 				MarkdownDescription: "The number of examples in the dataset.",
@@ -178,16 +216,16 @@ func (r *DatasetResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -197,6 +235,8 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := datasetAPIRequest{
 		Name: data.Name.ValueString(),
 	}
@@ -228,13 +268,20 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var result datasetAPIResponse
-	err := r.client.Post(ctx, "/api/v1/datasets", body, &result)
+	err := r.client.Create(ctx, "/api/v1/datasets", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating dataset", err.Error())
 		return
 	}
 
 	mapDatasetResponseToState(&data, &result)
+
+	// A new dataset has no examples to infer from yet. Note how many it has,
+	// so the plan after the first ones land knows to take another look.
+	if data.InferSchemaFromExamples.ValueBool() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, datasetInferredCountKey, datasetInferredCount(data.ExampleCount))...)
+	}
+
 	tflog.Trace(ctx, "created dataset resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -247,6 +294,8 @@ func (r *DatasetResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result datasetAPIResponse
 	err := r.client.Get(ctx, "/api/v1/datasets/"+data.ID.ValueString(), nil, &result)
 	if err != nil {
@@ -260,16 +309,24 @@ func (r *DatasetResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	mapDatasetResponseToState(&data, &result)
 
+	// Imported datasets arrive without the flag; settle on the default.
+	if data.InferSchemaFromExamples.IsNull() {
+		data.InferSchemaFromExamples = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data DatasetResourceModel
+	var data, state DatasetResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := datasetAPIRequest{
 		Name: data.Name.ValueString(),
 	}
@@ -282,12 +339,8 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 		v := data.DataType.ValueString()
 		body.DataType = &v
 	}
-	if !data.InputsSchemaDefinition.IsNull() && !data.InputsSchemaDefinition.IsUnknown() {
-		body.InputsSchemaDefinition = json.RawMessage(data.InputsSchemaDefinition.ValueString())
-	}
-	if !data.OutputsSchemaDefinition.IsNull() && !data.OutputsSchemaDefinition.IsUnknown() {
-		body.OutputsSchemaDefinition = json.RawMessage(data.OutputsSchemaDefinition.ValueString())
-	}
+	body.InputsSchemaDefinition = datasetSchemaUpdate(data.InputsSchemaDefinition, state.InputsSchemaDefinition)
+	body.OutputsSchemaDefinition = datasetSchemaUpdate(data.OutputsSchemaDefinition, state.OutputsSchemaDefinition)
 	if !data.ExternallyManaged.IsNull() && !data.ExternallyManaged.IsUnknown() {
 		v := data.ExternallyManaged.ValueBool()
 		body.ExternallyManaged = &v
@@ -300,6 +353,10 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 		body.Metadata = json.RawMessage(data.Metadata.ValueString())
 	}
 
+	// Only a definition the plan left for inference to decide is inferred.
+	inferInputs := data.InputsSchemaDefinition.IsUnknown()
+	inferOutputs := data.OutputsSchemaDefinition.IsUnknown()
+
 	var result datasetAPIResponse
 	err := r.client.Patch(ctx, "/api/v1/datasets/"+data.ID.ValueString(), body, &result)
 	if err != nil {
@@ -308,6 +365,17 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	mapDatasetResponseToState(&data, &result)
+
+	if data.InferSchemaFromExamples.ValueBool() {
+		if err := r.inferSchemas(ctx, &data, inferInputs, inferOutputs); err != nil {
+			resp.Diagnostics.AddError("Error inferring dataset schema from examples", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, datasetInferredCountKey, datasetInferredCount(data.ExampleCount))...)
+	} else {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, datasetInferredCountKey, nil)...)
+	}
+
 	tflog.Trace(ctx, "updated dataset resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -320,6 +388,8 @@ func (r *DatasetResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/datasets/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting dataset", err.Error())
@@ -329,8 +399,188 @@ func (r *DatasetResource) Delete(ctx context.Context, req resource.DeleteRequest
 	tflog.Trace(ctx, "deleted dataset resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+// datasetInferredCountKey is the private state key holding the example count
+// the schema definitions were last inferred at. Inference only needs another
+// look once the count moves.
+const datasetInferredCountKey = "schema_inferred_example_count"
+
+// datasetInferredCount is the private state value recording an example count.
+func datasetInferredCount(count types.Int64) []byte {
+	return []byte(strconv.FormatInt(count.ValueInt64(), 10))
+}
+
+// ModifyPlan settles unconfigured schema definitions. Without inference they
+// plan null, so one dropped from config is cleared. With it, the one in state
+// stands until there's something new to infer from: examples where nothing
+// was inferred yet, or a different example count than the last inference saw.
+func (r *DatasetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan DatasetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DatasetResourceModel
+	stale := false
+	if !req.State.Raw.IsNull() && plan.InferSchemaFromExamples.ValueBool() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		inferredAt, diags := req.Private.GetKey(ctx, datasetInferredCountKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stale = inferredAt != nil && string(inferredAt) != string(datasetInferredCount(state.ExampleCount))
+	}
+
+	for _, attr := range []struct {
+		name            string
+		configured, was types.String
+	}{
+		{"inputs_schema_definition", config.InputsSchemaDefinition, state.InputsSchemaDefinition},
+		{"outputs_schema_definition", config.OutputsSchemaDefinition, state.OutputsSchemaDefinition},
+	} {
+		if !attr.configured.IsNull() {
+			continue
+		}
+		// Without inference, or with no dataset to infer from yet, an
+		// unconfigured definition is no definition at all.
+		v := types.StringNull()
+		if !state.ID.IsNull() {
+			v = attr.was
+			if stale || (attr.was.IsNull() && state.ExampleCount.ValueInt64() > 0) {
+				v = types.StringUnknown()
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr.name), v)...)
+	}
+}
+
+// datasetSchemaUpdate is the schema definition to send on update: the planned
+// one, an explicit null when one in state is being cleared, or nothing at all
+// when there's neither, or inference is yet to decide.
+func datasetSchemaUpdate(planned, previous types.String) json.RawMessage {
+	switch {
+	case planned.IsUnknown():
+		return nil
+	case !planned.IsNull():
+		return json.RawMessage(planned.ValueString())
+	case !previous.IsNull():
+		return json.RawMessage("null")
+	}
+	return nil
+}
+
+// inferSchemas samples the dataset's examples and patches the chosen schema
+// definitions with ones inferred from them. An empty dataset leaves things as
+// they are — can't brand cattle you haven't rounded up.
+func (r *DatasetResource) inferSchemas(ctx context.Context, data *DatasetResourceModel, inferInputs, inferOutputs bool) error {
+	if !inferInputs && !inferOutputs {
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("dataset", data.ID.ValueString())
+	query.Set("limit", strconv.Itoa(datasetSchemaSampleSize))
+
+	var examples []exampleAPIResponse
+	if err := r.client.Get(ctx, "/api/v1/examples", query, &examples); err != nil {
+		return fmt.Errorf("listing examples: %w", err)
+	}
+	if len(examples) == 0 {
+		tflog.Debug(ctx, "no examples to infer dataset schema from", map[string]interface{}{"id": data.ID.ValueString()})
+		return nil
+	}
+
+	inputs := make([]json.RawMessage, 0, len(examples))
+	outputs := make([]json.RawMessage, 0, len(examples))
+	for _, ex := range examples {
+		inputs = append(inputs, ex.Inputs)
+		outputs = append(outputs, ex.Outputs)
+	}
+
+	body := datasetAPIRequest{
+		Name: data.Name.ValueString(),
+	}
+	if inferInputs {
+		inferred, err := inferJSONSchema(inputs)
+		if err != nil {
+			return fmt.Errorf("inferring inputs schema: %w", err)
+		}
+		body.InputsSchemaDefinition = inferred
+	}
+	if inferOutputs {
+		inferred, err := inferJSONSchema(outputs)
+		if err != nil {
+			return fmt.Errorf("inferring outputs schema: %w", err)
+		}
+		body.OutputsSchemaDefinition = inferred
+	}
+	if body.InputsSchemaDefinition == nil && body.OutputsSchemaDefinition == nil {
+		return nil
+	}
+
+	var result datasetAPIResponse
+	if err := r.client.Patch(ctx, "/api/v1/datasets/"+data.ID.ValueString(), body, &result); err != nil {
+		return fmt.Errorf("updating dataset schema: %w", err)
+	}
+
+	mapDatasetResponseToState(data, &result)
+	tflog.Debug(ctx, "inferred dataset schema from examples", map[string]interface{}{
+		"id":       data.ID.ValueString(),
+		"examples": len(examples),
+	})
+
+	return nil
+}
+
+// ImportState takes a dataset ID, or `name:<dataset name>` for a dataset
+// known only by its name, which is looked up before the import rides on.
 func (r *DatasetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	name, byName := strings.CutPrefix(req.ID, "name:")
+	if !byName {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id, err := r.lookupDatasetID(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing dataset", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// lookupDatasetID finds the ID of the one dataset with exactly the name.
+func (r *DatasetResource) lookupDatasetID(ctx context.Context, name string) (string, error) {
+	query := url.Values{}
+	query.Set("name", name)
+
+	var results []datasetAPIResponse
+	if err := r.client.Get(ctx, "/api/v1/datasets", query, &results); err != nil {
+		return "", fmt.Errorf("looking up dataset %q: %w", name, err)
+	}
+
+	var ids []string
+	for _, d := range results {
+		if d.Name == name {
+			ids = append(ids, d.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no dataset found with name %q", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d datasets have the name %q; import by ID instead", len(ids), name)
+	}
 }
 
 // mapDatasetResponseToState translates the API response into Terraform state.
@@ -366,16 +616,8 @@ func mapDatasetResponseToState(data *DatasetResourceModel, result *datasetAPIRes
 	}
 
 	// Round up the extra fields — every head of cattle needs accounting for.
-	if len(result.Transformations) > 0 && string(result.Transformations) != "null" {
-		data.Transformations = types.StringValue(string(result.Transformations))
-	} else {
-		data.Transformations = types.StringNull()
-	}
-	if len(result.Metadata) > 0 && string(result.Metadata) != "null" {
-		data.Metadata = types.StringValue(string(result.Metadata))
-	} else {
-		data.Metadata = types.StringNull()
-	}
+	data.Transformations = datasetTransformations(data.Transformations, result.Transformations)
+	data.Metadata = datasetMetadata(data.Metadata, result.Metadata)
 	if result.ExampleCount != nil {
 		data.ExampleCount = types.Int64Value(*result.ExampleCount)
 	} else {
@@ -392,3 +634,33 @@ func mapDatasetResponseToState(data *DatasetResourceModel, result *datasetAPIRes
 	data.TenantID = types.StringValue(result.TenantID)
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 }
+
+// datasetTransformations keeps the configured transformations when the API
+// hands back the same document re-serialized, and keeps them null when it
+// answers an unset field with an empty array.
+func datasetTransformations(prior types.String, transformations json.RawMessage) types.String {
+	if len(transformations) == 0 || string(transformations) == "null" {
+		return types.StringNull()
+	}
+	if prior.IsNull() && jsonSemanticallyEqual(string(transformations), "[]") {
+		return prior
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && jsonSemanticallyEqual(string(transformations), prior.ValueString()) {
+		return prior
+	}
+	return types.StringValue(string(transformations))
+}
+
+// datasetMetadata keeps the metadata as the user wrote it so long as the
+// API still holds every key they set with the value they set it to. The
+// server adds keys of its own, and those alone aren't drift; only a change
+// to one of the user's keys takes the API's document.
+func datasetMetadata(prior types.String, metadata json.RawMessage) types.String {
+	if len(metadata) == 0 || string(metadata) == "null" {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && jsonObjectSubset(prior.ValueString(), string(metadata)) {
+		return prior
+	}
+	return types.StringValue(string(metadata))
+}
@@ -4,11 +4,23 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // TestAccDatasetResource_basic puts the dataset resource through its paces —
@@ -71,3 +83,205 @@ resource "langsmith_dataset" "test" {
 }
 `, name, dataType)
 }
+
+// TestDatasetResource_serverInjectedMetadata reproduces the server adding
+// its own keys to a dataset's metadata. Those alone mustn't show up as a
+// change, plan after plan; a change to a key the user set must.
+func TestDatasetResource_serverInjectedMetadata(t *testing.T) {
+	const configured = `{"team": "evals"}`
+	injected := json.RawMessage(`{"team":"evals","runtime":{"sdk":"langsmith-py","sdk_version":"0.3.1"}}`)
+
+	data := DatasetResourceModel{
+		Metadata:        types.StringValue(configured),
+		Transformations: types.StringNull(),
+	}
+
+	// Create and two reads in a row against a server that adds keys.
+	for i := 0; i < 3; i++ {
+		mapDatasetResponseToState(&data, &datasetAPIResponse{
+			ID:              "d1",
+			Name:            "golden",
+			Metadata:        injected,
+			Transformations: json.RawMessage(`[]`),
+		})
+		if data.Metadata.ValueString() != configured {
+			t.Fatalf("pass %d: expected the configured metadata to be kept, got %s", i, data.Metadata)
+		}
+		if !data.Transformations.IsNull() {
+			t.Fatalf("pass %d: expected unset transformations to stay null, got %s", i, data.Transformations)
+		}
+	}
+
+	// Someone changed the team out from under us; that's real drift.
+	mapDatasetResponseToState(&data, &datasetAPIResponse{
+		Metadata: json.RawMessage(`{"team":"platform","runtime":{"sdk":"langsmith-py"}}`),
+	})
+	if data.Metadata.ValueString() != `{"team":"platform","runtime":{"sdk":"langsmith-py"}}` {
+		t.Errorf("expected a changed key to take the API's metadata, got %s", data.Metadata)
+	}
+
+	// Nothing configured takes whatever the server holds.
+	data.Metadata = types.StringNull()
+	mapDatasetResponseToState(&data, &datasetAPIResponse{Metadata: injected})
+	if data.Metadata.ValueString() != string(injected) {
+		t.Errorf("expected unset metadata to take the API's, got %s", data.Metadata)
+	}
+}
+
+// TestDatasetResource_dataType checks an unknown data_type is turned away at
+// plan time, and the documented ones pass.
+func TestDatasetResource_dataType(t *testing.T) {
+	for dataType, wantErr := range map[string]bool{"kv": false, "llm": false, "chat": false, "KV": true, "text": true} {
+		diags := testValidateResourceConfig(t, "langsmith_dataset", map[string]tftypes.Value{
+			"name":      tftypes.NewValue(tftypes.String, "golden"),
+			"data_type": tftypes.NewValue(tftypes.String, dataType),
+		})
+		if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "data_type") != wantErr {
+			t.Errorf("%s: expected error=%t, got %v", dataType, wantErr, diags)
+		}
+	}
+}
+
+// TestDatasetResource_importByName checks a `name:` import finds the one
+// dataset with exactly that name, and anything else imports as an ID.
+func TestDatasetResource_importByName(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/datasets" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		// The name filter matches loosely, so more than the one asked for
+		// can come back.
+		_, _ = w.Write([]byte(`[
+			{"id": "d1", "name": "golden"},
+			{"id": "d2", "name": "golden-v2"},
+			{"id": "d3", "name": "twins"},
+			{"id": "d4", "name": "twins"}
+		]`))
+	}))
+	defer ts.Close()
+
+	cases := map[string]struct {
+		importID string
+		wantID   string
+		wantErr  string
+	}{
+		"by name":        {importID: "name:golden", wantID: "d1"},
+		"by ID":          {importID: "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c", wantID: "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"},
+		"unknown name":   {importID: "name:silver", wantErr: "no dataset found"},
+		"ambiguous name": {importID: "name:twins", wantErr: "2 datasets"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &DatasetResource{client: client.NewClient(ts.URL, "key", "")}
+			state := testResourceState(t, r, &DatasetResourceModel{})
+			resp := &fwresource.ImportStateResponse{State: state}
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: tc.importID}, resp)
+
+			if tc.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), tc.wantErr) {
+					t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("importing: %v", resp.Diagnostics)
+			}
+
+			var id types.String
+			resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+			if id.ValueString() != tc.wantID {
+				t.Errorf("expected ID %q, got %s", tc.wantID, id)
+			}
+		})
+	}
+}
+
+// TestDatasetResource_inferSchemaLifecycle checks schema inference settles:
+// nothing to infer on an empty dataset, one inference once examples arrive,
+// no further change until the example count moves, and the inferred schema
+// cleared once inference is turned off.
+func TestDatasetResource_inferSchemaLifecycle(t *testing.T) {
+	dataset := datasetAPIResponse{ID: "d1", DataType: "kv"}
+	var examples []exampleAPIResponse
+	patches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/datasets":
+			var body datasetAPIRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			dataset.Name = body.Name
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/datasets/d1":
+			patches++
+			var body map[string]json.RawMessage
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if v, ok := body["inputs_schema_definition"]; ok {
+				dataset.InputsSchemaDefinition = v
+			}
+			if v, ok := body["outputs_schema_definition"]; ok {
+				dataset.OutputsSchemaDefinition = v
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/datasets/d1":
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/examples":
+			_ = json.NewEncoder(w).Encode(examples)
+			return
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		count := int64(len(examples))
+		dataset.ExampleCount = &count
+		_ = json.NewEncoder(w).Encode(dataset)
+	}))
+	defer srv.Close()
+
+	config := map[string]tftypes.Value{
+		"name":                       tftypes.NewValue(tftypes.String, "golden"),
+		"infer_schema_from_examples": tftypes.NewValue(tftypes.Bool, true),
+	}
+	s := newTestResourceServer(t, srv.URL, "langsmith_dataset")
+	s.apply(config)
+	s.refresh()
+	if !s.planIsEmpty(config) || !s.attribute("inputs_schema_definition").IsNull() {
+		t.Fatalf("expected an empty dataset to plan no change, got inputs schema %s", s.attribute("inputs_schema_definition"))
+	}
+
+	examples = []exampleAPIResponse{
+		{ID: "e1", Inputs: json.RawMessage(`{"question": "Who runs Dodge?"}`), Outputs: json.RawMessage(`{"answer": "Matt"}`)},
+		{ID: "e2", Inputs: json.RawMessage(`{"question": "Where's Festus?"}`), Outputs: json.RawMessage(`{"answer": "Out back"}`)},
+	}
+	s.refresh()
+	if s.planIsEmpty(config) {
+		t.Fatal("expected new examples to plan an inference")
+	}
+	s.apply(config)
+	s.refresh()
+	if !strings.Contains(s.attribute("inputs_schema_definition").String(), "question") {
+		t.Errorf("expected an inferred inputs schema, got %s", s.attribute("inputs_schema_definition"))
+	}
+	for i := 0; i < 2; i++ {
+		s.refresh()
+		if !s.planIsEmpty(config) {
+			t.Fatalf("refresh %d: expected the inferred schema to hold steady", i)
+		}
+	}
+
+	examples = examples[:1]
+	s.refresh()
+	if s.planIsEmpty(config) {
+		t.Fatal("expected a changed example count to plan another inference")
+	}
+	s.apply(config)
+
+	before := patches
+	config["infer_schema_from_examples"] = tftypes.NewValue(tftypes.Bool, false)
+	s.apply(config)
+	s.refresh()
+	if patches != before+1 || !s.attribute("inputs_schema_definition").IsNull() || !s.attribute("outputs_schema_definition").IsNull() {
+		t.Errorf("expected turning inference off to clear the schemas, got %s and %s", s.attribute("inputs_schema_definition"), s.attribute("outputs_schema_definition"))
+	}
+	if !s.planIsEmpty(config) {
+		t.Error("expected no change once the schemas are cleared")
+	}
+}
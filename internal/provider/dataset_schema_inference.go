@@ -0,0 +1,129 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// datasetSchemaSampleSize is how many examples get looked over when inferring
+// a dataset schema. Enough to get the lay of the land without driving the
+// whole herd through the chute.
+const datasetSchemaSampleSize = 100
+
+// inferJSONSchema builds a JSON schema describing every sample it's handed.
+// Samples that are empty or JSON null are skipped; if nothing's left, it
+// returns nil. The output is normalized — keys sorted, type unions and
+// required lists in a fixed order — so the same examples always produce the
+// same bytes and plans stay quiet.
+func inferJSONSchema(samples []json.RawMessage) (json.RawMessage, error) {
+	var node *schemaNode
+	for _, raw := range samples {
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("decoding example: %w", err)
+		}
+		node = node.merge(v)
+	}
+	if node == nil {
+		return nil, nil
+	}
+
+	out := node.schema()
+	out["$schema"] = "http://json-schema.org/draft-07/schema#"
+	return json.Marshal(out)
+}
+
+// schemaNode accumulates what's been seen at one spot in the sampled
+// documents: which JSON types turned up, and what lives beneath them.
+type schemaNode struct {
+	types      map[string]bool
+	properties map[string]*schemaNode
+	seen       int
+	present    map[string]int
+	items      *schemaNode
+}
+
+func (n *schemaNode) merge(v interface{}) *schemaNode {
+	if n == nil {
+		n = &schemaNode{types: map[string]bool{}}
+	}
+
+	switch val := v.(type) {
+	case nil:
+		n.types["null"] = true
+	case bool:
+		n.types["boolean"] = true
+	case float64:
+		if val == float64(int64(val)) {
+			n.types["integer"] = true
+		} else {
+			n.types["number"] = true
+		}
+	case string:
+		n.types["string"] = true
+	case []interface{}:
+		n.types["array"] = true
+		for _, item := range val {
+			n.items = n.items.merge(item)
+		}
+	case map[string]interface{}:
+		n.types["object"] = true
+		if n.properties == nil {
+			n.properties = map[string]*schemaNode{}
+			n.present = map[string]int{}
+		}
+		n.seen++
+		for k, child := range val {
+			n.properties[k] = n.properties[k].merge(child)
+			n.present[k]++
+		}
+	}
+
+	return n
+}
+
+func (n *schemaNode) schema() map[string]interface{} {
+	out := map[string]interface{}{}
+
+	// An integer that sometimes shows up with a fraction is just a number.
+	if n.types["integer"] && n.types["number"] {
+		delete(n.types, "integer")
+	}
+	types := make([]string, 0, len(n.types))
+	for t := range n.types {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	if len(types) == 1 {
+		out["type"] = types[0]
+	} else {
+		out["type"] = types
+	}
+
+	if n.types["object"] {
+		props := map[string]interface{}{}
+		required := []string{}
+		for k, child := range n.properties {
+			props[k] = child.schema()
+			if n.present[k] == n.seen {
+				required = append(required, k)
+			}
+		}
+		sort.Strings(required)
+		out["properties"] = props
+		out["required"] = required
+	}
+
+	if n.types["array"] && n.items != nil {
+		out["items"] = n.items.schema()
+	}
+
+	return out
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestInferJSONSchema runs a handful of representative examples through the
+// inference and checks the brand that comes out the other side.
+func TestInferJSONSchema(t *testing.T) {
+	samples := []json.RawMessage{
+		json.RawMessage(`{"question": "Who runs Dodge?", "context": ["Matt", "Kitty"], "score": 1, "meta": {"source": "wiki"}}`),
+		json.RawMessage(`{"score": 0.5, "question": "Where's Festus?", "context": [], "meta": {"source": "radio", "lang": "en"}}`),
+		json.RawMessage(`{"question": "Is Doc in?", "score": null}`),
+		json.RawMessage(`null`),
+	}
+
+	got, err := inferJSONSchema(samples)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"$schema":"http://json-schema.org/draft-07/schema#",` +
+		`"properties":{` +
+		`"context":{"items":{"type":"string"},"type":"array"},` +
+		`"meta":{"properties":{"lang":{"type":"string"},"source":{"type":"string"}},"required":["source"],"type":"object"},` +
+		`"question":{"type":"string"},` +
+		`"score":{"type":["null","number"]}},` +
+		`"required":["question","score"],"type":"object"}`
+	if string(got) != want {
+		t.Errorf("unexpected schema\n got: %s\nwant: %s", got, want)
+	}
+
+	// Key order in the examples shouldn't matter — same herd, same brand.
+	again, err := inferJSONSchema([]json.RawMessage{samples[1], samples[0], samples[2]})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(again) != string(got) {
+		t.Errorf("schema not stable across sample order\n got: %s\nwant: %s", again, got)
+	}
+}
+
+// TestInferJSONSchema_empty confirms an empty dataset yields no schema at all.
+func TestInferJSONSchema_empty(t *testing.T) {
+	got, err := inferJSONSchema([]json.RawMessage{nil, json.RawMessage(`null`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Errorf("expected nil schema, got %s", got)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &DatasetShareResource{}
+	_ resource.ResourceWithImportState = &DatasetShareResource{}
+)
+
+// NewDatasetShareResource returns a new DatasetShareResource -- posting a
+// dataset on the board outside the marshal's office for anyone to read.
+func NewDatasetShareResource() resource.Resource {
+	return &DatasetShareResource{}
+}
+
+// DatasetShareResource manages the public share link of a dataset. While it
+// exists, anyone with the link can see the dataset.
+type DatasetShareResource struct {
+	client *client.Client
+}
+
+// DatasetShareResourceModel describes the Terraform state for a dataset share.
+type DatasetShareResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DatasetID   types.String `tfsdk:"dataset_id"`
+	ShareToken  types.String `tfsdk:"share_token"`
+	PublicURL   types.String `tfsdk:"public_url"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+// datasetShareAPIResponse is the API's record of a dataset's share.
+type datasetShareAPIResponse struct {
+	DatasetID  string `json:"dataset_id"`
+	ShareToken string `json:"share_token"`
+}
+
+func (r *DatasetShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_share"
+}
+
+func (r *DatasetShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Publishes a LangSmith dataset through a public share link. Anyone with the link can view the dataset; destroying the resource unshares it and the link stops working. " +
+			"A dataset that's already shared keeps its existing link, and can be imported by its dataset ID.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the share, the same as `dataset_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset to share.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_token": schema.StringAttribute{
+				MarkdownDescription: "The token in the dataset's public link.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_url": schema.StringAttribute{
+				MarkdownDescription: "The public link to the dataset in the LangSmith web app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatasetShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *DatasetShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatasetShareResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// Sharing a dataset that's already shared hands back the link it has,
+	// so there's no harm in asking twice.
+	var result datasetShareAPIResponse
+	err := r.client.Put(ctx, "/api/v1/datasets/"+data.DatasetID.ValueString()+"/share", nil, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error sharing dataset", err.Error())
+		return
+	}
+
+	r.mapResponseToState(&data, &result)
+	tflog.Trace(ctx, "created dataset share resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatasetShareResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// A dataset that isn't shared answers with null rather than a 404.
+	var result *datasetShareAPIResponse
+	err := r.client.Get(ctx, "/api/v1/datasets/"+data.DatasetID.ValueString()+"/share", nil, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dataset share", err.Error())
+		return
+	}
+	if result == nil || result.ShareToken == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.mapResponseToState(&data, result)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only ever moves state along; every configurable attribute forces a
+// new share.
+func (r *DatasetShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatasetShareResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatasetShareResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, "/api/v1/datasets/"+data.DatasetID.ValueString()+"/share")
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error unsharing dataset", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted dataset share resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+// ImportState takes the dataset ID. Read fills in the link the dataset is
+// already shared under, or drops the import if it isn't shared at all.
+func (r *DatasetShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), req.ID)...)
+}
+
+// mapResponseToState records the share and works out its public link.
+func (r *DatasetShareResource) mapResponseToState(data *DatasetShareResourceModel, result *datasetShareAPIResponse) {
+	data.ID = data.DatasetID
+	data.ShareToken = types.StringValue(result.ShareToken)
+	data.PublicURL = types.StringValue(datasetPublicURL(r.client.BaseURL, result.ShareToken))
+}
+
+// datasetPublicURL builds the web app link for a shared dataset. The web app
+// lives on the API's host, less the "api." the hosted regions put in front,
+// e.g. https://api.smith.langchain.com serves https://smith.langchain.com.
+func datasetPublicURL(baseURL, shareToken string) string {
+	web := baseURL
+	if u, err := url.Parse(baseURL); err == nil {
+		if rest, ok := strings.CutPrefix(u.Host, "api."); ok {
+			u.Host = rest
+		} else if before, after, ok := strings.Cut(u.Host, ".api."); ok {
+			u.Host = before + "." + after
+		}
+		web = strings.TrimRight(u.String(), "/")
+	}
+	return web + "/public/" + shareToken + "/d"
+}
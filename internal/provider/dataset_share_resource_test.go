@@ -0,0 +1,94 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestDatasetShareResource_lifecycle checks a dataset is shared with its
+// link recorded, read back while shared, dropped from state once someone
+// unshares it, and unshared on destroy.
+func TestDatasetShareResource_lifecycle(t *testing.T) {
+	const datasetID = "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"
+	shared := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/datasets/"+datasetID+"/share" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			shared = true
+		case http.MethodDelete:
+			shared = false
+			return
+		}
+		if !shared {
+			_, _ = w.Write([]byte(`null`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"dataset_id": "` + datasetID + `", "share_token": "tok1"}`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &DatasetShareResource{client: client.NewClient(ts.URL, "key", "")}
+
+	plan := testResourceState(t, r, &DatasetShareResourceModel{
+		ID:         types.StringUnknown(),
+		DatasetID:  types.StringValue(datasetID),
+		ShareToken: types.StringUnknown(),
+		PublicURL:  types.StringUnknown(),
+	})
+	createResp := &resource.CreateResponse{State: plan}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	var got DatasetShareResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.ID.ValueString() != datasetID || got.ShareToken.ValueString() != "tok1" || got.PublicURL.ValueString() != ts.URL+"/public/tok1/d" {
+		t.Errorf("unexpected state %+v", got)
+	}
+
+	deleteResp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() || shared {
+		t.Fatalf("expected the dataset to be unshared, got %v", deleteResp.Diagnostics)
+	}
+
+	goneResp := &resource.ReadResponse{State: readResp.State}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, goneResp)
+	if goneResp.Diagnostics.HasError() || !goneResp.State.Raw.IsNull() {
+		t.Errorf("expected an unshared dataset to leave state, got %v", goneResp.Diagnostics)
+	}
+}
+
+// TestDatasetPublicURL checks the web app host is worked out from the API's.
+func TestDatasetPublicURL(t *testing.T) {
+	cases := map[string]string{
+		"https://api.smith.langchain.com":    "https://smith.langchain.com/public/tok/d",
+		"https://eu.api.smith.langchain.com": "https://eu.smith.langchain.com/public/tok/d",
+		"https://langsmith.example.com":      "https://langsmith.example.com/public/tok/d",
+	}
+	for baseURL, want := range cases {
+		if got := datasetPublicURL(baseURL, "tok"); got != want {
+			t.Errorf("%s: expected %s, got %s", baseURL, want, got)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &DatasetSplitsResource{}
+	_ resource.ResourceWithImportState = &DatasetSplitsResource{}
+)
+
+// datasetBaseSplit is the split every example without one falls into. It's
+// always there, so it's never managed.
+const datasetBaseSplit = "base"
+
+// NewDatasetSplitsResource returns a new DatasetSplitsResource -- the pens a
+// herd gets sorted into, laid out before the first steer is driven in.
+func NewDatasetSplitsResource() resource.Resource {
+	return &DatasetSplitsResource{}
+}
+
+// DatasetSplitsResource manages the set of named splits a dataset's examples
+// may be sorted into. LangSmith only knows a split once an example is in it,
+// so a declared split with no examples lives in state alone, and a split
+// found on the dataset but not declared is drift: removing it takes it off
+// its examples.
+type DatasetSplitsResource struct {
+	client *client.Client
+}
+
+// DatasetSplitsResourceModel describes the Terraform state for a dataset's
+// splits.
+type DatasetSplitsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DatasetID   types.String `tfsdk:"dataset_id"`
+	Splits      types.Set    `tfsdk:"splits"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+// datasetSplitsAPIRequest is sent to PUT /api/v1/datasets/{id}/splits to
+// move examples into, or with Remove out of, a split.
+type datasetSplitsAPIRequest struct {
+	SplitName string   `json:"split_name"`
+	Examples  []string `json:"examples"`
+	Remove    bool     `json:"remove"`
+}
+
+func (r *DatasetSplitsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_splits"
+}
+
+func (r *DatasetSplitsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the named splits, such as `train`, `test`, and `validation`, that a LangSmith dataset's examples are sorted into. " +
+			"LangSmith only lists a split once an example is in it, so a declared split with no examples yet lives in Terraform state alone. " +
+			"A split found on the dataset that isn't declared shows up as drift, and the next apply takes it off its examples, returning them to the `base` split. " +
+			"Destroying the resource leaves the examples as they are. Import with the dataset ID.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the resource, the same as `dataset_id`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset whose splits are managed.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"splits": schema.SetAttribute{
+				MarkdownDescription: "The names of the dataset's splits. Every example not in one of them is in the implicit `base` split, which can't be listed here.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.NoneOf(datasetBaseSplit),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatasetSplitsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+func (r *DatasetSplitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatasetSplitsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// There's nothing to create until an example moves in; just make sure
+	// the dataset is there to hold the splits.
+	if _, err := listDatasetSplits(ctx, r.client, data.DatasetID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error reading dataset splits", err.Error())
+		return
+	}
+
+	data.ID = data.DatasetID
+	tflog.Trace(ctx, "created dataset splits resource", map[string]interface{}{"dataset_id": data.DatasetID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetSplitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatasetSplitsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	found, err := listDatasetSplits(ctx, r.client, data.DatasetID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dataset splits", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapDatasetSplitsToState(ctx, &data, found)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetSplitsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatasetSplitsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var planned, previous []string
+	resp.Diagnostics.Append(data.Splits.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Splits.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keep := make(map[string]bool, len(planned))
+	for _, split := range planned {
+		keep[split] = true
+	}
+	for _, split := range previous {
+		if !keep[split] {
+			r.removeSplit(ctx, data.DatasetID.ValueString(), split, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	data.ID = data.DatasetID
+	tflog.Trace(ctx, "updated dataset splits resource", map[string]interface{}{"dataset_id": data.DatasetID.ValueString(), "splits": len(planned)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetSplitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Stripping every split off every example would scatter the herd; the
+	// examples keep their splits and Terraform simply lets go.
+	tflog.Warn(ctx, "Dataset splits are left on their examples. Removing from Terraform state only.")
+}
+
+func (r *DatasetSplitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), req.ID)...)
+}
+
+// removeSplit takes split off every example in the dataset that's in it,
+// returning them to the base split.
+func (r *DatasetSplitsResource) removeSplit(ctx context.Context, datasetID, split string, diags *diag.Diagnostics) {
+	query := url.Values{}
+	query.Set("dataset", datasetID)
+	query.Set("splits", split)
+
+	examples, err := client.GetAll[exampleAPIResponse](ctx, r.client, "/api/v1/examples", query)
+	if err != nil {
+		diags.AddError("Error listing examples in split", fmt.Sprintf("Split %q: %s", split, err))
+		return
+	}
+	if len(examples) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(examples))
+	for _, example := range examples {
+		ids = append(ids, example.ID)
+	}
+
+	body := datasetSplitsAPIRequest{SplitName: split, Examples: ids, Remove: true}
+	if err := r.client.Put(ctx, "/api/v1/datasets/"+datasetID+"/splits", body, nil); err != nil {
+		diags.AddError("Error removing dataset split", fmt.Sprintf("Split %q: %s", split, err))
+		return
+	}
+	tflog.Debug(ctx, "removed dataset split from its examples", map[string]interface{}{"split": split, "examples": len(ids)})
+}
+
+// listDatasetSplits returns the splits the dataset's examples are in, leaving
+// out the base split.
+func listDatasetSplits(ctx context.Context, c *client.Client, datasetID string) ([]string, error) {
+	var splits []string
+	if err := c.Get(ctx, "/api/v1/datasets/"+datasetID+"/splits", nil, &splits); err != nil {
+		return nil, err
+	}
+
+	found := make([]string, 0, len(splits))
+	for _, split := range splits {
+		if split != datasetBaseSplit {
+			found = append(found, split)
+		}
+	}
+	return found, nil
+}
+
+// mapDatasetSplitsToState reconciles state with the splits found on the
+// dataset. Declared splits are kept whether or not any example is in them
+// yet, and splits added outside Terraform are brought in as drift. A freshly
+// imported resource adopts every split found.
+func mapDatasetSplitsToState(ctx context.Context, data *DatasetSplitsResourceModel, found []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var known []string
+	if !data.Splits.IsNull() && !data.Splits.IsUnknown() {
+		diags.Append(data.Splits.ElementsAs(ctx, &known, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	seen := make(map[string]bool, len(known)+len(found))
+	splits := make([]string, 0, len(known)+len(found))
+	for _, split := range append(known, found...) {
+		if !seen[split] {
+			seen[split] = true
+			splits = append(splits, split)
+		}
+	}
+	sort.Strings(splits)
+
+	set, d := types.SetValueFrom(ctx, types.StringType, splits)
+	diags.Append(d...)
+	data.Splits = set
+	data.ID = data.DatasetID
+	return diags
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestDatasetSplitsResource_reconcile checks Read keeps declared splits with
+// no examples and brings in splits added outside Terraform, and that
+// dropping a split takes it off its examples.
+func TestDatasetSplitsResource_reconcile(t *testing.T) {
+	const datasetID = "3f6c1e2a-1a3c-4e5b-8d7f-0a1b2c3d4e5f"
+	var removed []datasetSplitsAPIRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/datasets/"+datasetID+"/splits":
+			_, _ = w.Write([]byte(`["base", "train", "holdout"]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/examples":
+			if r.URL.Query().Get("offset") != "0" || r.URL.Query().Get("splits") != "holdout" {
+				_, _ = w.Write([]byte(`[]`))
+				return
+			}
+			_, _ = w.Write([]byte(`[{"id": "e1"}, {"id": "e2"}]`))
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/datasets/"+datasetID+"/splits":
+			var body datasetSplitsAPIRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			removed = append(removed, body)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &DatasetSplitsResource{client: client.NewClient(srv.URL, "key", "")}
+
+	splits := func(names ...string) types.Set {
+		set, _ := types.SetValueFrom(ctx, types.StringType, names)
+		return set
+	}
+	state := testResourceState(t, r, &DatasetSplitsResourceModel{
+		ID:        types.StringValue(datasetID),
+		DatasetID: types.StringValue(datasetID),
+		Splits:    splits("train", "validation"),
+	})
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	var got DatasetSplitsResourceModel
+	readResp.State.Get(ctx, &got)
+	if !got.Splits.Equal(splits("holdout", "train", "validation")) {
+		t.Errorf("expected the declared splits plus the stray one, got %s", got.Splits)
+	}
+
+	planned := got
+	planned.Splits = splits("train", "validation", "test")
+	plan := testResourceState(t, r, &planned)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("updating: %v", updateResp.Diagnostics)
+	}
+	if len(removed) != 1 || removed[0].SplitName != "holdout" || !removed[0].Remove || len(removed[0].Examples) != 2 {
+		t.Errorf("expected holdout to be taken off its two examples, got %+v", removed)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &DatasetTagResource{}
+	_ resource.ResourceWithImportState = &DatasetTagResource{}
+)
+
+// NewDatasetTagResource returns a resource for managing named tags on
+// dataset versions -- a mark in the tally book saying which head count the
+// drive was judged against.
+func NewDatasetTagResource() resource.Resource {
+	return &DatasetTagResource{}
+}
+
+// DatasetTagResource manages a named tag on a dataset version.
+type DatasetTagResource struct {
+	client *client.Client
+}
+
+// DatasetTagResourceModel maps the Terraform schema for a dataset tag.
+type DatasetTagResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DatasetID   types.String `tfsdk:"dataset_id"`
+	TagName     types.String `tfsdk:"tag_name"`
+	AsOf        types.String `tfsdk:"as_of"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+// datasetTagRequest is sent to PUT /api/v1/datasets/{id}/tags.
+type datasetTagRequest struct {
+	AsOf string `json:"as_of"`
+	Tag  string `json:"tag"`
+}
+
+// datasetVersionAPIResponse is the shape of a dataset version from the API.
+type datasetVersionAPIResponse struct {
+	AsOf string   `json:"as_of"`
+	Tags []string `json:"tags"`
+}
+
+func (r *DatasetTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_tag"
+}
+
+func (r *DatasetTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a named version tag on a LangSmith dataset. Tags like `prod` or `v1` point to the dataset as it stood at a given time, letting experiments run against a frozen version while the dataset keeps growing.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the tag, in the form `dataset_id/tag_name`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset to tag.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the tag (e.g., `prod`, `v1`).",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"as_of": schema.StringAttribute{
+				MarkdownDescription: "The RFC3339 timestamp of the dataset version the tag points to. Update this to move the tag to a different version.",
+				Required:            true,
+				Validators:          []validator.String{validTimestamp()},
+			},
+		},
+	}
+}
+
+func (r *DatasetTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
+		return
+	}
+	r.client = data.client
+}
+
+// put points the tag at the planned version. The same call creates the tag
+// or moves one that already exists.
+func (r *DatasetTagResource) put(ctx context.Context, data *DatasetTagResourceModel) error {
+	body := datasetTagRequest{
+		AsOf: data.AsOf.ValueString(),
+		Tag:  data.TagName.ValueString(),
+	}
+
+	var result datasetVersionAPIResponse
+	if err := r.client.Put(ctx, fmt.Sprintf("/api/v1/datasets/%s/tags", data.DatasetID.ValueString()), body, &result); err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(data.DatasetID.ValueString() + "/" + data.TagName.ValueString())
+	data.AsOf = datasetTagAsOf(data.AsOf, result.AsOf)
+	return nil
+}
+
+// datasetTagAsOf keeps the configured timestamp when the API hands back the
+// same instant spelled differently, so there's no diff over formatting.
+func datasetTagAsOf(prior types.String, asOf string) types.String {
+	if asOf == "" {
+		return prior
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		p, pok := parsePriceStartTime(prior.ValueString())
+		a, aok := parsePriceStartTime(asOf)
+		if pok && aok && p.Equal(a) {
+			return prior
+		}
+	}
+	return types.StringValue(asOf)
+}
+
+func (r *DatasetTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatasetTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	if err := r.put(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error creating dataset tag", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created dataset tag", map[string]interface{}{"id": data.ID.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatasetTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	query := url.Values{}
+	query.Set("tag", data.TagName.ValueString())
+
+	var result datasetVersionAPIResponse
+	err := r.client.Get(ctx, fmt.Sprintf("/api/v1/datasets/%s/version", data.DatasetID.ValueString()), query, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dataset tag", err.Error())
+		return
+	}
+
+	// A version without the tag means it was taken off behind our back.
+	if result.Tags != nil && !slices.Contains(result.Tags, data.TagName.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(data.DatasetID.ValueString() + "/" + data.TagName.ValueString())
+	data.AsOf = datasetTagAsOf(data.AsOf, result.AsOf)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatasetTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	if err := r.put(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Error updating dataset tag", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatasetTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatasetTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	query := url.Values{}
+	query.Set("tag", data.TagName.ValueString())
+
+	err := r.client.DeleteWithQuery(ctx, fmt.Sprintf("/api/v1/datasets/%s/tags", data.DatasetID.ValueString()), query)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting dataset tag", err.Error())
+	}
+}
+
+func (r *DatasetTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	// Import format: dataset_id/tag_name
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: dataset_id/tag_name")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag_name"), parts[1])...)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestDatasetTagResource_asOfValidation checks as_of must be a timestamp
+// the API can pin a version to.
+func TestDatasetTagResource_asOfValidation(t *testing.T) {
+	cases := map[string]bool{
+		"2025-06-01T00:00:00Z":             false,
+		"2025-06-01T08:30:00.123456-07:00": false,
+		"2025-06-01":                       true,
+		"last tuesday":                     true,
+	}
+
+	for asOf, wantErr := range cases {
+		t.Run(asOf, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_dataset_tag", map[string]tftypes.Value{
+				"dataset_id": tftypes.NewValue(tftypes.String, "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"),
+				"tag_name":   tftypes.NewValue(tftypes.String, "prod"),
+				"as_of":      tftypes.NewValue(tftypes.String, asOf),
+			})
+			if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "Invalid Timestamp"); got != wantErr {
+				t.Errorf("expected error %v, got %v", wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestDatasetTagResource_put checks the tag is put on the configured
+// version, and a timestamp the API spells differently doesn't cause a diff.
+func TestDatasetTagResource_put(t *testing.T) {
+	const datasetID = "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"
+
+	var sent datasetTagRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/datasets/"+datasetID+"/tags" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_, _ = w.Write([]byte(`{"as_of":"2025-06-01T00:00:00.000000+00:00","tags":["prod"]}`))
+	}))
+	defer ts.Close()
+
+	r := &DatasetTagResource{client: client.NewClient(ts.URL, "key", "")}
+	data := DatasetTagResourceModel{
+		ID:        types.StringUnknown(),
+		DatasetID: types.StringValue(datasetID),
+		TagName:   types.StringValue("prod"),
+		AsOf:      types.StringValue("2025-06-01T00:00:00Z"),
+	}
+
+	if err := r.put(context.Background(), &data); err != nil {
+		t.Fatalf("putting the tag: %v", err)
+	}
+	if sent.Tag != "prod" || sent.AsOf != "2025-06-01T00:00:00Z" {
+		t.Errorf("unexpected request body %+v", sent)
+	}
+	if data.ID.ValueString() != datasetID+"/prod" {
+		t.Errorf("unexpected ID %q", data.ID.ValueString())
+	}
+	if data.AsOf.ValueString() != "2025-06-01T00:00:00Z" {
+		t.Errorf("expected the configured as_of to be kept, got %q", data.AsOf.ValueString())
+	}
+
+	if got := datasetTagAsOf(types.StringValue("2025-06-01T00:00:00Z"), "2025-07-01T00:00:00+00:00"); got.ValueString() != "2025-07-01T00:00:00+00:00" {
+		t.Errorf("expected a moved tag to take the API's as_of, got %q", got.ValueString())
+	}
+	if got := datasetTagAsOf(types.StringNull(), "2025-07-01T00:00:00+00:00"); got.ValueString() != "2025-07-01T00:00:00+00:00" {
+		t.Errorf("expected an imported tag to take the API's as_of, got %q", got.ValueString())
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &ExampleDataSource{}
+
+// NewExampleDataSource returns a new ExampleDataSource, for reading back a
+// golden example somebody else already branded.
+func NewExampleDataSource() datasource.DataSource {
+	return &ExampleDataSource{}
+}
+
+// ExampleDataSource reads a single dataset example by ID, the read-only
+// counterpart to ExampleResource.
+type ExampleDataSource struct {
+	client *client.Client
+}
+
+// ExampleDataSourceModel holds the example's ID and what was found under it.
+type ExampleDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DatasetID   types.String `tfsdk:"dataset_id"`
+	Inputs      types.String `tfsdk:"inputs"`
+	Outputs     types.String `tfsdk:"outputs"`
+	Metadata    types.String `tfsdk:"metadata"`
+	Split       types.String `tfsdk:"split"`
+	SourceRunID types.String `tfsdk:"source_run_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	ModifiedAt  types.String `tfsdk:"modified_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+func (d *ExampleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_example"
+}
+
+func (d *ExampleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to read a dataset example by ID.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the example.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dataset the example belongs to.",
+				Computed:            true,
+			},
+			"inputs": schema.StringAttribute{
+				MarkdownDescription: "A JSON string of the example's inputs.",
+				Computed:            true,
+			},
+			"outputs": schema.StringAttribute{
+				MarkdownDescription: "A JSON string of the example's expected outputs, if any.",
+				Computed:            true,
+			},
+			"metadata": schema.StringAttribute{
+				MarkdownDescription: "A JSON string of the example's metadata, if any.",
+				Computed:            true,
+			},
+			"split": schema.StringAttribute{
+				MarkdownDescription: "The dataset split the example is in, if any.",
+				Computed:            true,
+			},
+			"source_run_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the run the example was drawn from, if any.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp.",
+				Computed:            true,
+			},
+			"modified_at": schema.StringAttribute{
+				MarkdownDescription: "The last modification timestamp.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ExampleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *ExampleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExampleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result exampleAPIResponse
+	err := d.client.Get(ctx, "/api/v1/examples/"+data.ID.ValueString(), nil, &result)
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Example Not Found", fmt.Sprintf("No example with ID %s.", data.ID.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading example", err.Error())
+		return
+	}
+
+	var mapped ExampleResourceModel
+	mapExampleResponseToState(&mapped, &result)
+
+	data.ID = mapped.ID
+	data.DatasetID = mapped.DatasetID
+	data.Inputs = mapped.Inputs
+	data.Outputs = mapped.Outputs
+	data.Metadata = mapped.Metadata
+	data.Split = mapped.Split
+	data.SourceRunID = mapped.SourceRunID
+	data.CreatedAt = mapped.CreatedAt
+	data.ModifiedAt = mapped.ModifiedAt
+
+	tflog.Trace(ctx, "read example data source", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
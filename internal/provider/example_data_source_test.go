@@ -0,0 +1,54 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestExampleDataSource_read checks an example is read back by ID, with
+// fields it doesn't have left null, and that a missing one fails the read.
+func TestExampleDataSource_read(t *testing.T) {
+	const id = "9b2d4e6f-1a3c-4e5b-8d7f-0a1b2c3d4e5f"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/examples/"+id {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"id": "` + id + `", "dataset_id": "d1",
+			"inputs": {"question": "Who runs Dodge?"}, "outputs": {"answer": "Matt Dillon"}, "metadata": null,
+			"split": "test", "source_run_id": null,
+			"created_at": "2025-01-01T00:00:00Z", "modified_at": "2025-01-02T00:00:00Z"
+		}`))
+	}))
+	defer srv.Close()
+
+	d := &ExampleDataSource{client: client.NewClient(srv.URL, "key", "")}
+
+	resp := testDataSourceRead(t, d, &ExampleDataSourceModel{ID: types.StringValue(id)})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", resp.Diagnostics)
+	}
+
+	var got ExampleDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.DatasetID.ValueString() != "d1" || got.Inputs.ValueString() != `{"question": "Who runs Dodge?"}` ||
+		got.Outputs.ValueString() != `{"answer": "Matt Dillon"}` || got.Split.ValueString() != "test" ||
+		!got.Metadata.IsNull() || !got.SourceRunID.IsNull() || got.ModifiedAt.ValueString() != "2025-01-02T00:00:00Z" {
+		t.Errorf("unexpected example %+v", got)
+	}
+
+	resp = testDataSourceRead(t, d, &ExampleDataSourceModel{ID: types.StringValue("0b2d4e6f-1a3c-4e5b-8d7f-0a1b2c3d4e5f")})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Example Not Found" {
+		t.Errorf("expected the missing example to fail, got %v", resp.Diagnostics)
+	}
+}
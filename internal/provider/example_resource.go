@@ -5,15 +5,26 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -21,10 +32,15 @@ import (
 )
 
 var (
-	_ resource.Resource                = &ExampleResource{}
-	_ resource.ResourceWithImportState = &ExampleResource{}
+	_ resource.Resource                   = &ExampleResource{}
+	_ resource.ResourceWithImportState    = &ExampleResource{}
+	_ resource.ResourceWithValidateConfig = &ExampleResource{}
+	_ resource.ResourceWithModifyPlan     = &ExampleResource{}
 )
 
+// exampleAttachmentPrefix marks attachment parts and attachment URL keys.
+const exampleAttachmentPrefix = "attachment."
+
 // NewExampleResource constructs a fresh ExampleResource for managing individual
 // entries in a LangSmith dataset.
 func NewExampleResource() resource.Resource {
@@ -40,15 +56,29 @@ type ExampleResource struct {
 // ExampleResourceModel holds the Terraform state for a dataset example,
 // including its inputs, outputs, and metadata.
 type ExampleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	DatasetID   types.String `tfsdk:"dataset_id"`
-	Inputs      types.String `tfsdk:"inputs"`
-	Outputs     types.String `tfsdk:"outputs"`
-	Metadata    types.String `tfsdk:"metadata"`
-	Split       types.String `tfsdk:"split"`
-	SourceRunID types.String `tfsdk:"source_run_id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	ModifiedAt  types.String `tfsdk:"modified_at"`
+	ID                types.String             `tfsdk:"id"`
+	DatasetID         types.String             `tfsdk:"dataset_id"`
+	Inputs            types.String             `tfsdk:"inputs"`
+	Outputs           types.String             `tfsdk:"outputs"`
+	Metadata          types.String             `tfsdk:"metadata"`
+	Split             types.String             `tfsdk:"split"`
+	SourceRunID       types.String             `tfsdk:"source_run_id"`
+	VerifySourceRunID types.Bool               `tfsdk:"verify_source_run_id"`
+	Attachments       []exampleAttachmentModel `tfsdk:"attachments"`
+	CreatedAt         types.String             `tfsdk:"created_at"`
+	ModifiedAt        types.String             `tfsdk:"modified_at"`
+	TenantID          types.String             `tfsdk:"tenant_id"`
+	WorkspaceID       types.String             `tfsdk:"workspace_id"`
+}
+
+// exampleAttachmentModel is one file riding along with an example: an image,
+// a clip of audio, anything the inputs can't carry as JSON.
+type exampleAttachmentModel struct {
+	Name          types.String `tfsdk:"name"`
+	MimeType      types.String `tfsdk:"mime_type"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	FilePath      types.String `tfsdk:"file_path"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
 }
 
 // exampleAPICreateRequest is the wire format for branding a new example into
@@ -85,6 +115,32 @@ type exampleAPIResponse struct {
 	SourceRunID *string         `json:"source_run_id"`
 	CreatedAt   string          `json:"created_at"`
 	ModifiedAt  string          `json:"modified_at"`
+	TenantID    string          `json:"tenant_id"`
+
+	AttachmentURLs map[string]exampleAttachmentURL `json:"attachment_urls"`
+}
+
+// exampleAttachmentURL is where the API keeps an attachment, and what kind it is.
+type exampleAttachmentURL struct {
+	PresignedURL string `json:"presigned_url"`
+	MimeType     string `json:"mime_type"`
+}
+
+// exampleMultipartBody is the JSON part of a multipart example upload: every
+// field but the inputs, outputs and attachments, which get parts of their own.
+type exampleMultipartBody struct {
+	ID          string          `json:"id,omitempty"`
+	DatasetID   string          `json:"dataset_id,omitempty"`
+	Metadata    json.RawMessage `json:"metadata,omitempty"`
+	Split       *string         `json:"split,omitempty"`
+	SourceRunID *string         `json:"source_run_id,omitempty"`
+}
+
+// exampleAttachmentsOperations tells a multipart update which of the
+// example's existing attachments to keep. Any not named are dropped.
+type exampleAttachmentsOperations struct {
+	Retain []string          `json:"retain"`
+	Rename map[string]string `json:"rename"`
 }
 
 func (r *ExampleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,6 +151,7 @@ func (r *ExampleResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith example within a dataset.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the example.",
 				Computed:            true,
@@ -112,14 +169,23 @@ func (r *ExampleResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"inputs": schema.StringAttribute{
 				MarkdownDescription: "JSON string containing the input data for the example.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"outputs": schema.StringAttribute{
 				MarkdownDescription: "JSON string containing the output data for the example.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"metadata": schema.StringAttribute{
 				MarkdownDescription: "JSON string containing metadata for the example.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"split": schema.StringAttribute{
 				MarkdownDescription: "The split for the example (e.g., `base`, `train`, `test`).",
@@ -130,6 +196,47 @@ func (r *ExampleResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"source_run_id": schema.StringAttribute{
 				MarkdownDescription: "The UUID of the source run for this example.",
 				Optional:            true,
+				Validators: []validator.String{
+					validUUID(),
+				},
+			},
+			"verify_source_run_id": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, checks that `source_run_id` refers to an existing run before creating or updating the example. Costs an extra API call, so it defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"attachments": schema.ListNestedAttribute{
+				MarkdownDescription: "Files attached to the example, such as images or audio for multimodal evaluations, uploaded through the multipart examples endpoint. " +
+					"The list is authoritative: attachments added outside Terraform are removed on the next apply.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The attachment's name, unique within the example.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"mime_type": schema.StringAttribute{
+							MarkdownDescription: "The attachment's media type, e.g. `image/png`.",
+							Required:            true,
+						},
+						"content_base64": schema.StringAttribute{
+							MarkdownDescription: "The attachment's content, base64-encoded. Exactly one of `content_base64` or `file_path` is required.",
+							Optional:            true,
+						},
+						"file_path": schema.StringAttribute{
+							MarkdownDescription: "The path of a local file holding the attachment's content. Exactly one of `content_base64` or `file_path` is required.",
+							Optional:            true,
+						},
+						"content_sha256": schema.StringAttribute{
+							MarkdownDescription: "The SHA-256 of the attachment's content, so a changed file is uploaded again. Empty for attachments read back from the API, whose content isn't downloaded.",
+							Computed:            true,
+						},
+					},
+				},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "The creation timestamp of the example.",
@@ -139,6 +246,13 @@ func (r *ExampleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The last modification timestamp of the example.",
 				Computed:            true,
 			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the example belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -148,16 +262,16 @@ func (r *ExampleResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *ExampleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -167,6 +281,23 @@ func (r *ExampleResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	if !r.verifySourceRun(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+
+	if len(data.Attachments) > 0 {
+		r.createWithAttachments(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Trace(ctx, "created example resource", map[string]interface{}{"id": data.ID.ValueString(), "attachments": len(data.Attachments)})
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	body := exampleAPICreateRequest{
 		DatasetID: data.DatasetID.ValueString(),
 		Inputs:    json.RawMessage(data.Inputs.ValueString()),
@@ -188,13 +319,17 @@ func (r *ExampleResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var result exampleAPIResponse
-	err := r.client.Post(ctx, "/api/v1/examples", body, &result)
+	err := r.client.Create(ctx, "/api/v1/examples", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating example", err.Error())
 		return
 	}
 
 	mapExampleResponseToState(&data, &result)
+	resolveTenantID(ctx, r.client, &data.TenantID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "created example resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -207,6 +342,8 @@ func (r *ExampleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result exampleAPIResponse
 	err := r.client.Get(ctx, "/api/v1/examples/"+data.ID.ValueString(), nil, &result)
 	if err != nil {
@@ -219,6 +356,13 @@ func (r *ExampleResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	mapExampleResponseToState(&data, &result)
+	resolveTenantID(ctx, r.client, &data.TenantID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.VerifySourceRunID.IsNull() {
+		data.VerifySourceRunID = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -230,6 +374,29 @@ func (r *ExampleResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	if !r.verifySourceRun(ctx, &data, &resp.Diagnostics) {
+		return
+	}
+
+	var state ExampleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Attachments) > 0 || len(state.Attachments) > 0 {
+		r.updateWithAttachments(ctx, &data, state.Attachments, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Trace(ctx, "updated example resource", map[string]interface{}{"id": data.ID.ValueString(), "attachments": len(data.Attachments)})
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	body := exampleAPIUpdateRequest{
 		Inputs: json.RawMessage(data.Inputs.ValueString()),
 	}
@@ -261,6 +428,10 @@ func (r *ExampleResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	mapExampleResponseToState(&data, &result)
+	resolveTenantID(ctx, r.client, &data.TenantID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "updated example resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -273,6 +444,8 @@ func (r *ExampleResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/examples/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting example", err.Error())
@@ -283,9 +456,384 @@ func (r *ExampleResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ExampleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ValidateConfig makes sure each attachment names exactly one source for its
+// content, that inline content is real base64, and that no two attachments
+// share a name.
+func (r *ExampleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var list types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attachments"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	var attachments []exampleAttachmentModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &attachments, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, a := range attachments {
+		at := path.Root("attachments").AtListIndex(i)
+
+		sourceKnown := !a.ContentBase64.IsUnknown() && !a.FilePath.IsUnknown()
+		if sourceKnown && a.ContentBase64.IsNull() == a.FilePath.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				at,
+				"Invalid Attachment Content",
+				"Set exactly one of content_base64 or file_path.",
+			)
+		}
+
+		if !a.ContentBase64.IsNull() && !a.ContentBase64.IsUnknown() {
+			if _, err := base64.StdEncoding.DecodeString(a.ContentBase64.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					at.AtName("content_base64"),
+					"Invalid Attachment Content",
+					fmt.Sprintf("content_base64 isn't valid base64: %s", err),
+				)
+			}
+		}
+
+		if a.Name.IsNull() || a.Name.IsUnknown() {
+			continue
+		}
+		if seen[a.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				at.AtName("name"),
+				"Duplicate Attachment Name",
+				fmt.Sprintf("More than one attachment is named %q.", a.Name.ValueString()),
+			)
+		}
+		seen[a.Name.ValueString()] = true
+	}
+}
+
+// ModifyPlan works out each attachment's content hash at plan time, so a
+// file that's changed on disk shows up as a change to the example.
+func (r *ExampleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var list types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attachments"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+
+	var attachments []exampleAttachmentModel
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &attachments, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, a := range attachments {
+		at := path.Root("attachments").AtListIndex(i)
+
+		hash, err := exampleAttachmentHash(a)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(at, "Error Reading Attachment", err.Error())
+			continue
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, at.AtName("content_sha256"), hash)...)
+	}
+}
+
+// createWithAttachments creates the example through the multipart endpoint,
+// attachments and all, then reads it back.
+func (r *ExampleResource) createWithAttachments(ctx context.Context, data *ExampleResourceModel, diags *diag.Diagnostics) {
+	if err := hashExampleAttachments(data.Attachments); err != nil {
+		diags.AddError("Error reading attachment", err.Error())
+		return
+	}
+
+	id, err := newExampleID()
+	if err != nil {
+		diags.AddError("Error creating example", err.Error())
+		return
+	}
+
+	body := exampleMultipartBody{
+		Metadata:    exampleOptionalJSON(data.Metadata),
+		Split:       exampleOptionalString(data.Split),
+		SourceRunID: exampleOptionalString(data.SourceRunID),
+	}
+	parts, err := exampleMultipartParts(id, body, data, data.Attachments)
+	if err != nil {
+		diags.AddError("Error creating example", err.Error())
+		return
+	}
+
+	if err := r.client.PostMultipart(ctx, exampleMultipartPath(data.DatasetID.ValueString()), parts, nil); err != nil {
+		diags.AddError("Error creating example", err.Error())
+		return
+	}
+
+	r.readBack(ctx, id, data, diags)
+}
+
+// updateWithAttachments updates the example through the multipart endpoint.
+// Attachments whose name, type and content are unchanged are kept as they
+// are; the rest are uploaded again, and any left out of the plan are dropped.
+func (r *ExampleResource) updateWithAttachments(ctx context.Context, data *ExampleResourceModel, prior []exampleAttachmentModel, diags *diag.Diagnostics) {
+	id := data.ID.ValueString()
+
+	if err := hashExampleAttachments(data.Attachments); err != nil {
+		diags.AddError("Error reading attachment", err.Error())
+		return
+	}
+
+	priorByName := make(map[string]exampleAttachmentModel, len(prior))
+	for _, a := range prior {
+		priorByName[a.Name.ValueString()] = a
+	}
+
+	ops := exampleAttachmentsOperations{Retain: []string{}, Rename: map[string]string{}}
+	var uploads []exampleAttachmentModel
+	for _, a := range data.Attachments {
+		old, ok := priorByName[a.Name.ValueString()]
+		if ok && old.MimeType.Equal(a.MimeType) && !old.ContentSHA256.IsNull() && old.ContentSHA256.Equal(a.ContentSHA256) {
+			ops.Retain = append(ops.Retain, a.Name.ValueString())
+			continue
+		}
+		uploads = append(uploads, a)
+	}
+
+	body := exampleMultipartBody{
+		ID:          id,
+		DatasetID:   data.DatasetID.ValueString(),
+		Metadata:    exampleOptionalJSON(data.Metadata),
+		Split:       exampleOptionalString(data.Split),
+		SourceRunID: exampleOptionalString(data.SourceRunID),
+	}
+	parts, err := exampleMultipartParts(id, body, data, uploads)
+	if err != nil {
+		diags.AddError("Error updating example", err.Error())
+		return
+	}
+
+	opsJSON, err := json.Marshal(ops)
+	if err != nil {
+		diags.AddError("Error updating example", err.Error())
+		return
+	}
+	parts = append(parts, client.MultipartPart{Name: id + ".attachments_operations", Content: opsJSON})
+
+	if err := r.client.PatchMultipart(ctx, exampleMultipartPath(data.DatasetID.ValueString()), parts, nil); err != nil {
+		diags.AddError("Error updating example", err.Error())
+		return
+	}
+
+	r.readBack(ctx, id, data, diags)
+}
+
+// readBack fetches the example after a multipart write, which doesn't answer
+// with the example itself.
+func (r *ExampleResource) readBack(ctx context.Context, id string, data *ExampleResourceModel, diags *diag.Diagnostics) {
+	var result exampleAPIResponse
+	if err := r.client.Get(ctx, "/api/v1/examples/"+id, nil, &result); err != nil {
+		diags.AddError("Error reading example", err.Error())
+		return
+	}
+	mapExampleResponseToState(data, &result)
+	resolveTenantID(ctx, r.client, &data.TenantID, diags)
+}
+
+// exampleMultipartPath is the multipart examples endpoint for a dataset. It
+// lives outside /api/v1, under the platform routes.
+func exampleMultipartPath(datasetID string) string {
+	return "/v1/platform/datasets/" + datasetID + "/examples"
+}
+
+// exampleMultipartParts lays out an example as multipart parts: its JSON body
+// under its ID, then its inputs, outputs and the given attachments, each
+// named after the ID.
+func exampleMultipartParts(id string, body exampleMultipartBody, data *ExampleResourceModel, attachments []exampleAttachmentModel) ([]client.MultipartPart, error) {
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := []client.MultipartPart{
+		{Name: id, Content: bodyJSON},
+		{Name: id + ".inputs", Content: []byte(data.Inputs.ValueString())},
+	}
+	if outputs := exampleOptionalJSON(data.Outputs); outputs != nil {
+		parts = append(parts, client.MultipartPart{Name: id + ".outputs", Content: outputs})
+	}
+
+	for _, a := range attachments {
+		content, _, err := exampleAttachmentContent(a)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, client.MultipartPart{
+			Name: id + "." + exampleAttachmentPrefix + a.Name.ValueString(),
+			// The API wants the length alongside the type, the way the SDK sends it.
+			ContentType: fmt.Sprintf("%s; length=%d", a.MimeType.ValueString(), len(content)),
+			Content:     content,
+		})
+	}
+
+	return parts, nil
+}
+
+// exampleAttachmentContent loads an attachment's content from wherever it's
+// configured. known is false when the source isn't known until apply.
+func exampleAttachmentContent(a exampleAttachmentModel) (content []byte, known bool, err error) {
+	switch {
+	case !a.ContentBase64.IsNull() && !a.ContentBase64.IsUnknown():
+		content, err = base64.StdEncoding.DecodeString(a.ContentBase64.ValueString())
+		if err != nil {
+			return nil, false, fmt.Errorf("attachment %q: decoding content_base64: %w", a.Name.ValueString(), err)
+		}
+		return content, true, nil
+	case !a.FilePath.IsNull() && !a.FilePath.IsUnknown():
+		content, err = os.ReadFile(a.FilePath.ValueString())
+		if err != nil {
+			return nil, false, fmt.Errorf("attachment %q: %w", a.Name.ValueString(), err)
+		}
+		return content, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// exampleAttachmentHash works out an attachment's content hash, or unknown
+// when its content can't be had until apply.
+func exampleAttachmentHash(a exampleAttachmentModel) (types.String, error) {
+	content, known, err := exampleAttachmentContent(a)
+	if err != nil || !known {
+		return types.StringUnknown(), err
+	}
+	sum := sha256.Sum256(content)
+	return types.StringValue(hex.EncodeToString(sum[:])), nil
+}
+
+// hashExampleAttachments fills in the content hash of every attachment, for
+// those whose content only became known at apply.
+func hashExampleAttachments(attachments []exampleAttachmentModel) error {
+	for i := range attachments {
+		hash, err := exampleAttachmentHash(attachments[i])
+		if err != nil {
+			return err
+		}
+		if hash.IsUnknown() {
+			return fmt.Errorf("attachment %q has no content", attachments[i].Name.ValueString())
+		}
+		attachments[i].ContentSHA256 = hash
+	}
+	return nil
+}
+
+// exampleOptionalJSON hands back an optional JSON attribute for a request, or
+// nil when it's unset.
+func exampleOptionalJSON(v types.String) json.RawMessage {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return json.RawMessage(v.ValueString())
+}
+
+// exampleOptionalString hands back an optional string attribute for a
+// request, or nil when it's unset.
+func exampleOptionalString(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+// newExampleID mints a random (version 4) UUID. Multipart uploads name their
+// parts after the example's ID, so it has to be chosen before the example
+// exists.
+func newExampleID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generating example ID: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// mapExampleAttachments lines up the attachments the API holds with those in
+// state. Known attachments keep their configured source and hash, taking the
+// API's word on the type; those gone from the API are dropped; and any the
+// API holds that state doesn't are adopted by name and type alone.
+func mapExampleAttachments(prior []exampleAttachmentModel, urls map[string]exampleAttachmentURL) []exampleAttachmentModel {
+	held := make(map[string]exampleAttachmentURL, len(urls))
+	for key, u := range urls {
+		held[strings.TrimPrefix(key, exampleAttachmentPrefix)] = u
+	}
+	if len(held) == 0 {
+		if prior == nil {
+			return nil
+		}
+		return []exampleAttachmentModel{}
+	}
+
+	attachments := make([]exampleAttachmentModel, 0, len(held))
+	for _, a := range prior {
+		u, ok := held[a.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		delete(held, a.Name.ValueString())
+		if u.MimeType != "" {
+			a.MimeType = types.StringValue(u.MimeType)
+		}
+		attachments = append(attachments, a)
+	}
+
+	names := make([]string, 0, len(held))
+	for name := range held {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attachments = append(attachments, exampleAttachmentModel{
+			Name:          types.StringValue(name),
+			MimeType:      types.StringValue(held[name].MimeType),
+			ContentBase64: types.StringNull(),
+			FilePath:      types.StringNull(),
+			ContentSHA256: types.StringNull(),
+		})
+	}
+
+	return attachments
+}
+
+// verifySourceRun confirms the source run actually exists when the user has
+// asked for the check. Returns false if the example shouldn't be written.
+func (r *ExampleResource) verifySourceRun(ctx context.Context, data *ExampleResourceModel, diags *diag.Diagnostics) bool {
+	if !data.VerifySourceRunID.ValueBool() || data.SourceRunID.IsNull() || data.SourceRunID.IsUnknown() {
+		return true
+	}
+
+	err := r.client.Get(ctx, "/api/v1/runs/"+data.SourceRunID.ValueString(), nil, nil)
+	if client.IsNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("source_run_id"),
+			"Source Run Not Found",
+			fmt.Sprintf("No run with ID %s exists. Check the ID, or set verify_source_run_id = false to skip this check.", data.SourceRunID.ValueString()),
+		)
+		return false
+	}
+	if err != nil {
+		diags.AddError("Error verifying source run", err.Error())
+		return false
+	}
+
+	return true
+}
+
 // mapExampleResponseToState translates the API response into Terraform state,
 // handling the JSON fields with the care of a good trail cook.
 func mapExampleResponseToState(data *ExampleResourceModel, result *exampleAPIResponse) {
@@ -322,6 +870,11 @@ func mapExampleResponseToState(data *ExampleResourceModel, result *exampleAPIRes
 		data.SourceRunID = types.StringNull()
 	}
 
+	data.Attachments = mapExampleAttachments(data.Attachments, result.AttachmentURLs)
+
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.ModifiedAt = types.StringValue(result.ModifiedAt)
+	if result.TenantID != "" {
+		data.TenantID = types.StringValue(result.TenantID)
+	}
 }
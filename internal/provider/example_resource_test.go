@@ -0,0 +1,375 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const testExampleTenantID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+// TestExampleResource_sourceRunID makes sure a source run ID has to look like
+// a UUID before it gets anywhere near the API.
+func TestExampleResource_sourceRunID(t *testing.T) {
+	cases := map[string]struct {
+		id      string
+		wantErr bool
+	}{
+		"lowercase uuid": {id: "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"},
+		"uppercase uuid": {id: "6F1C2B3A-9D4E-4F5A-8B7C-0D1E2F3A4B5C"},
+		"malformed":      {id: "not-a-run-id", wantErr: true},
+		"missing dashes": {id: "6f1c2b3a9d4e4f5a8b7c0d1e2f3a4b5c", wantErr: true},
+		"trailing junk":  {id: "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c/x", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_example", map[string]tftypes.Value{
+				"dataset_id":    tftypes.NewValue(tftypes.String, "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"),
+				"inputs":        tftypes.NewValue(tftypes.String, `{"question":"who runs Dodge?"}`),
+				"source_run_id": tftypes.NewValue(tftypes.String, tc.id),
+			})
+			gotErr := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "UUID")
+			if gotErr != tc.wantErr {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// testExampleAttachmentType is the Terraform type of one attachment entry.
+var testExampleAttachmentType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"name":           tftypes.String,
+	"mime_type":      tftypes.String,
+	"content_base64": tftypes.String,
+	"file_path":      tftypes.String,
+	"content_sha256": tftypes.String,
+}}
+
+// TestExampleResource_attachmentsValidation checks each attachment names
+// exactly one source of valid content, under a name of its own.
+func TestExampleResource_attachmentsValidation(t *testing.T) {
+	attachment := func(name, content, file string) tftypes.Value {
+		str := func(v string) tftypes.Value {
+			if v == "" {
+				return tftypes.NewValue(tftypes.String, nil)
+			}
+			return tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(testExampleAttachmentType, map[string]tftypes.Value{
+			"name":           str(name),
+			"mime_type":      str("image/png"),
+			"content_base64": str(content),
+			"file_path":      str(file),
+			"content_sha256": str(""),
+		})
+	}
+
+	cases := map[string]struct {
+		attachments []tftypes.Value
+		wantErr     string
+	}{
+		"inline content": {
+			attachments: []tftypes.Value{attachment("badge", "iVBORw0K", "")},
+		},
+		"file": {
+			attachments: []tftypes.Value{attachment("badge", "", "badge.png")},
+		},
+		"both sources": {
+			attachments: []tftypes.Value{attachment("badge", "iVBORw0K", "badge.png")},
+			wantErr:     "exactly one of content_base64 or file_path",
+		},
+		"no source": {
+			attachments: []tftypes.Value{attachment("badge", "", "")},
+			wantErr:     "exactly one of content_base64 or file_path",
+		},
+		"bad base64": {
+			attachments: []tftypes.Value{attachment("badge", "not base64!", "")},
+			wantErr:     "isn't valid base64",
+		},
+		"duplicate names": {
+			attachments: []tftypes.Value{attachment("badge", "iVBORw0K", ""), attachment("badge", "", "badge.png")},
+			wantErr:     "Duplicate Attachment Name",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_example", map[string]tftypes.Value{
+				"dataset_id":  tftypes.NewValue(tftypes.String, "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"),
+				"inputs":      tftypes.NewValue(tftypes.String, `{"question":"what's on the badge?"}`),
+				"attachments": tftypes.NewValue(tftypes.List{ElementType: testExampleAttachmentType}, tc.attachments),
+			})
+			if tc.wantErr == "" {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// testExampleAttachmentServer stands in for the multipart examples endpoint,
+// recording the parts of each upload, and answers reads with the attachments
+// it holds.
+type testExampleAttachmentServer struct {
+	parts map[string]string
+	held  map[string]exampleAttachmentURL
+}
+
+func (s *testExampleAttachmentServer) handler(t *testing.T, datasetID string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/platform/datasets/"+datasetID+"/examples":
+			reader, err := r.MultipartReader()
+			if err != nil {
+				t.Errorf("reading multipart body: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			s.parts = map[string]string{}
+			for {
+				part, err := reader.NextPart()
+				if err != nil {
+					break
+				}
+				content, _ := io.ReadAll(part)
+				s.parts[part.FormName()] = part.Header.Get("Content-Type") + "|" + string(content)
+			}
+
+			// Drop whatever the update didn't retain, then take the uploads.
+			for name := range s.parts {
+				if !strings.HasSuffix(name, ".attachments_operations") {
+					continue
+				}
+				var ops exampleAttachmentsOperations
+				_ = json.Unmarshal([]byte(strings.SplitN(s.parts[name], "|", 2)[1]), &ops)
+				retained := map[string]exampleAttachmentURL{}
+				for _, n := range ops.Retain {
+					retained[exampleAttachmentPrefix+n] = s.held[exampleAttachmentPrefix+n]
+				}
+				s.held = retained
+			}
+			for name, part := range s.parts {
+				if i := strings.Index(name, "."+exampleAttachmentPrefix); i >= 0 {
+					mimeType := strings.SplitN(strings.SplitN(part, "|", 2)[0], ";", 2)[0]
+					s.held[name[i+1:]] = exampleAttachmentURL{PresignedURL: "https://files.example/" + name, MimeType: mimeType}
+				}
+			}
+			_, _ = w.Write([]byte(`{"count":1}`))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/examples/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/v1/examples/")
+			_ = json.NewEncoder(w).Encode(exampleAPIResponse{
+				ID:             id,
+				DatasetID:      datasetID,
+				Inputs:         json.RawMessage(`{"question":"what's on the badge?"}`),
+				TenantID:       testExampleTenantID,
+				AttachmentURLs: s.held,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+// TestExampleResource_attachmentsUpload checks attachments are uploaded from
+// inline content and from files, and that an update only sends the ones
+// that changed.
+func TestExampleResource_attachmentsUpload(t *testing.T) {
+	const datasetID = "6f1c2b3a-9d4e-4f5a-8b7c-0d1e2f3a4b5c"
+
+	srv := &testExampleAttachmentServer{held: map[string]exampleAttachmentURL{}}
+	ts := httptest.NewServer(srv.handler(t, datasetID))
+	defer ts.Close()
+
+	file := filepath.Join(t.TempDir(), "howl.wav")
+	if err := os.WriteFile(file, []byte("RIFF-howl"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &ExampleResource{client: client.NewClient(ts.URL, "key", "")}
+	data := ExampleResourceModel{
+		DatasetID:   types.StringValue(datasetID),
+		Inputs:      types.StringValue(`{"question":"what's on the badge?"}`),
+		Outputs:     types.StringNull(),
+		Metadata:    types.StringNull(),
+		Split:       types.StringValue("base"),
+		SourceRunID: types.StringNull(),
+		Attachments: []exampleAttachmentModel{
+			{
+				Name:          types.StringValue("badge"),
+				MimeType:      types.StringValue("image/png"),
+				ContentBase64: types.StringValue(base64.StdEncoding.EncodeToString([]byte("PNG-star"))),
+				FilePath:      types.StringNull(),
+				ContentSHA256: types.StringUnknown(),
+			},
+			{
+				Name:          types.StringValue("howl"),
+				MimeType:      types.StringValue("audio/wav"),
+				ContentBase64: types.StringNull(),
+				FilePath:      types.StringValue(file),
+				ContentSHA256: types.StringUnknown(),
+			},
+		},
+	}
+
+	var diags diag.Diagnostics
+	r.createWithAttachments(context.Background(), &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("creating: %v", diags)
+	}
+
+	id := data.ID.ValueString()
+	if len(id) != 36 {
+		t.Fatalf("expected a UUID for the example, got %q", id)
+	}
+	if got := srv.parts[id+".attachment.badge"]; got != "image/png; length=8|PNG-star" {
+		t.Errorf("unexpected badge part %q", got)
+	}
+	if got := srv.parts[id+".attachment.howl"]; got != "audio/wav; length=9|RIFF-howl" {
+		t.Errorf("unexpected howl part %q", got)
+	}
+	if got := srv.parts[id+".inputs"]; !strings.HasSuffix(got, `|{"question":"what's on the badge?"}`) {
+		t.Errorf("unexpected inputs part %q", got)
+	}
+	if data.TenantID.ValueString() != testExampleTenantID {
+		t.Errorf("expected the tenant ID from the response, got %s", data.TenantID)
+	}
+	if len(data.Attachments) != 2 || data.Attachments[0].ContentSHA256.IsUnknown() || data.Attachments[1].FilePath.ValueString() != file {
+		t.Fatalf("expected both attachments in state with their hashes, got %+v", data.Attachments)
+	}
+
+	// Change the file, keep the badge, and add nothing else.
+	prior := append([]exampleAttachmentModel(nil), data.Attachments...)
+	if err := os.WriteFile(file, []byte("RIFF-howl-louder"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	data.Attachments[1].ContentSHA256 = types.StringUnknown()
+
+	r.updateWithAttachments(context.Background(), &data, prior, &diags)
+	if diags.HasError() {
+		t.Fatalf("updating: %v", diags)
+	}
+
+	if _, ok := srv.parts[id+".attachment.badge"]; ok {
+		t.Error("expected the unchanged badge to be retained, not uploaded again")
+	}
+	if got := srv.parts[id+".attachment.howl"]; got != "audio/wav; length=16|RIFF-howl-louder" {
+		t.Errorf("unexpected howl part %q", got)
+	}
+	if got := srv.parts[id+".attachments_operations"]; !strings.Contains(got, `"retain":["badge"]`) {
+		t.Errorf("expected the badge to be retained, got %q", got)
+	}
+	if len(data.Attachments) != 2 || data.Attachments[1].ContentSHA256.Equal(prior[1].ContentSHA256) {
+		t.Errorf("expected the howl's hash to change, got %+v", data.Attachments)
+	}
+}
+
+// TestExampleResource_attachmentsMapping checks Read keeps configured
+// attachments, drops those gone from the API, and adopts the ones it
+// doesn't know.
+func TestExampleResource_attachmentsMapping(t *testing.T) {
+	prior := []exampleAttachmentModel{
+		{Name: types.StringValue("badge"), MimeType: types.StringValue("image/png"), ContentBase64: types.StringValue("UE5H"), FilePath: types.StringNull(), ContentSHA256: types.StringValue("abc")},
+		{Name: types.StringValue("gone"), MimeType: types.StringValue("image/png"), ContentBase64: types.StringValue("UE5H"), FilePath: types.StringNull(), ContentSHA256: types.StringValue("def")},
+	}
+	urls := map[string]exampleAttachmentURL{
+		"attachment.badge": {MimeType: "image/png"},
+		"attachment.map":   {MimeType: "image/jpeg"},
+	}
+
+	got := mapExampleAttachments(prior, urls)
+	names := make([]string, 0, len(got))
+	for _, a := range got {
+		names = append(names, a.Name.ValueString())
+	}
+	if strings.Join(names, ",") != "badge,map" {
+		t.Fatalf("expected badge kept, gone dropped and map adopted, got %v", names)
+	}
+	if got[0].ContentSHA256.ValueString() != "abc" || got[0].ContentBase64.ValueString() != "UE5H" {
+		t.Errorf("expected the badge to keep its configured content, got %+v", got[0])
+	}
+	if got[1].MimeType.ValueString() != "image/jpeg" || !got[1].ContentSHA256.IsNull() {
+		t.Errorf("expected the adopted map to carry only its name and type, got %+v", got[1])
+	}
+
+	if mapExampleAttachments(nil, nil) != nil {
+		t.Error("expected no attachments to stay null")
+	}
+	if got := mapExampleAttachments(prior, nil); got == nil || len(got) != 0 {
+		t.Errorf("expected configured attachments gone from the API to read back empty, got %+v", got)
+	}
+
+	ids := map[string]bool{}
+	for i := 0; i < 20; i++ {
+		id, err := newExampleID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !uuidRegexp.MatchString(id) || id[14] != '4' {
+			t.Errorf("expected a version 4 UUID, got %q", id)
+		}
+		ids[id] = true
+	}
+	if len(ids) != 20 {
+		t.Error("expected every example ID to be unique")
+	}
+}
+
+// TestExampleResource_tenantIDFallback checks an example whose response
+// doesn't say which workspace it's in gets its tenant ID from the current
+// workspace, looked up only the once.
+func TestExampleResource_tenantIDFallback(t *testing.T) {
+	lookups := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/workspaces/current":
+			lookups++
+			_, _ = w.Write([]byte(`{"id":"` + testExampleTenantID + `"}`))
+		case "/api/v1/examples/e1":
+			_, _ = w.Write([]byte(`{"id":"e1","dataset_id":"d1","inputs":{"q":"who runs Dodge?"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	r := &ExampleResource{client: client.NewClient(ts.URL, "key", "")}
+	for range 2 {
+		data := ExampleResourceModel{TenantID: types.StringUnknown()}
+		var diags diag.Diagnostics
+		r.readBack(context.Background(), "e1", &data, &diags)
+		if diags.HasError() {
+			t.Fatalf("reading: %v", diags)
+		}
+		if data.TenantID.ValueString() != testExampleTenantID {
+			t.Errorf("expected the current workspace's tenant ID, got %s", data.TenantID)
+		}
+	}
+	if lookups != 1 {
+		t.Errorf("expected the current workspace to be looked up once, got %d", lookups)
+	}
+}
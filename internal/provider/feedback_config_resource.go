@@ -8,7 +8,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -23,8 +26,9 @@ import (
 )
 
 var (
-	_ resource.Resource                = &FeedbackConfigResource{}
-	_ resource.ResourceWithImportState = &FeedbackConfigResource{}
+	_ resource.Resource                     = &FeedbackConfigResource{}
+	_ resource.ResourceWithImportState      = &FeedbackConfigResource{}
+	_ resource.ResourceWithConfigValidators = &FeedbackConfigResource{}
 )
 
 // NewFeedbackConfigResource returns a new FeedbackConfigResource.
@@ -41,15 +45,31 @@ type FeedbackConfigResource struct {
 // FeedbackConfigResourceModel is the Terraform state for a feedback config.
 // Keyed by feedback_key rather than a UUID -- this one marches to its own drum.
 type FeedbackConfigResourceModel struct {
-	ID                 types.String  `tfsdk:"id"`
-	FeedbackKey        types.String  `tfsdk:"feedback_key"`
-	FeedbackType       types.String  `tfsdk:"feedback_type"`
-	Min                types.Float64 `tfsdk:"min"`
-	Max                types.Float64 `tfsdk:"max"`
-	Categories         types.String  `tfsdk:"categories"`
-	IsLowerScoreBetter types.Bool    `tfsdk:"is_lower_score_better"`
-	TenantID           types.String  `tfsdk:"tenant_id"`
-	ModifiedAt         types.String  `tfsdk:"modified_at"`
+	ID                 types.String            `tfsdk:"id"`
+	FeedbackKey        types.String            `tfsdk:"feedback_key"`
+	FeedbackType       types.String            `tfsdk:"feedback_type"`
+	Min                types.Float64           `tfsdk:"min"`
+	Max                types.Float64           `tfsdk:"max"`
+	Categories         types.String            `tfsdk:"categories"`
+	Category           []feedbackCategoryModel `tfsdk:"category"`
+	IsLowerScoreBetter types.Bool              `tfsdk:"is_lower_score_better"`
+	CaseSensitiveKey   types.Bool              `tfsdk:"case_sensitive_key"`
+	TenantID           types.String            `tfsdk:"tenant_id"`
+	ModifiedAt         types.String            `tfsdk:"modified_at"`
+	WorkspaceID        types.String            `tfsdk:"workspace_id"`
+}
+
+// feedbackCategoryModel is one typed category block: the score it stands for,
+// and what to call it.
+type feedbackCategoryModel struct {
+	Value types.Float64 `tfsdk:"value"`
+	Label types.String  `tfsdk:"label"`
+}
+
+// feedbackCategory is a category as the API knows it.
+type feedbackCategory struct {
+	Value float64 `json:"value"`
+	Label *string `json:"label,omitempty"`
 }
 
 // feedbackConfigCreateRequest is the request body for creating or updating a feedback config.
@@ -76,18 +96,32 @@ func (r *FeedbackConfigResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a feedback score configuration in LangSmith.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The identifier (same as feedback_key).",
+				MarkdownDescription: "The identifier: the feedback key as the API spells it. It differs from `feedback_key` only in casing, and only when `case_sensitive_key` is `false`.",
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"feedback_key": schema.StringAttribute{
-				MarkdownDescription: "The feedback key name.",
+				MarkdownDescription: "The feedback key name. Changing it forces a new feedback config, since the API can't rename one.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"case_sensitive_key": schema.BoolAttribute{
+				MarkdownDescription: "Whether `feedback_key` must match the API's key exactly. Defaults to `true`. " +
+					"When `false`, a key whose casing was changed outside Terraform is still found and managed, and a new config is refused if its key already exists in another casing, rather than created as a near-duplicate.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
 			},
 			"feedback_type": schema.StringAttribute{
-				MarkdownDescription: "The feedback type: `continuous` or `categorical`.",
+				MarkdownDescription: "The feedback type: `continuous` or `categorical`. A `continuous` config requires `min` and `max`; a `categorical` one requires categories.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("continuous", "categorical"),
+				},
 			},
 			"min": schema.Float64Attribute{
 				MarkdownDescription: "Minimum score value (for continuous type).",
@@ -98,8 +132,14 @@ func (r *FeedbackConfigResource) Schema(ctx context.Context, req resource.Schema
 				Optional:            true,
 			},
 			"categories": schema.StringAttribute{
-				MarkdownDescription: "JSON array of category objects for categorical type, e.g. `[{\"value\": 1, \"label\": \"good\"}]`.",
-				Optional:            true,
+				MarkdownDescription: "JSON array of category objects for categorical type, e.g. `[{\"value\": 1, \"label\": \"good\"}]`. " +
+					"Deprecated: use `category` blocks instead. Always reflects the categories the API holds, however they were configured.",
+				DeprecationMessage: "Use category blocks instead. The categories attribute will be removed in a future major version.",
+				Optional:           true,
+				Computed:           true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"is_lower_score_better": schema.BoolAttribute{
 				MarkdownDescription: "Whether a lower score is better.",
@@ -116,6 +156,118 @@ func (r *FeedbackConfigResource) Schema(ctx context.Context, req resource.Schema
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"category": schema.ListNestedBlock{
+				MarkdownDescription: "A category a `categorical` score can take. Repeat the block for each category.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.Float64Attribute{
+							MarkdownDescription: "The score recorded for the category.",
+							Required:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The name shown for the category, e.g. `good`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *FeedbackConfigResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		feedbackConfigTypeFieldsValidator{},
+	}
+}
+
+// feedbackConfigTypeFieldsValidator makes sure each feedback type brings the
+// fields it needs and none it doesn't: `continuous` configs take min and max,
+// `categorical` ones take categories, and the two are never mixed. It also
+// makes sure categories are given one way, as typed blocks or the deprecated
+// JSON, not both. Otherwise the API takes the config and reads back something
+// else entirely.
+type feedbackConfigTypeFieldsValidator struct{}
+
+func (v feedbackConfigTypeFieldsValidator) Description(ctx context.Context) string {
+	return "continuous configs require min and max and no categories; categorical configs require categories and no min or max"
+}
+
+func (v feedbackConfigTypeFieldsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`continuous` configs require `min` and `max` and no categories; `categorical` configs require `category` blocks or `categories` and no `min` or `max`"
+}
+
+func (v feedbackConfigTypeFieldsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FeedbackConfigResourceModel
+	var blocks types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("feedback_type"), &data.FeedbackType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min"), &data.Min)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max"), &data.Max)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("categories"), &data.Categories)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("category"), &blocks)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasBlocks := blocks.IsUnknown() || (!blocks.IsNull() && len(blocks.Elements()) > 0)
+	if hasBlocks && !data.Categories.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("categories"),
+			"Conflicting Feedback Categories",
+			"Set either category blocks or the deprecated categories attribute, not both.",
+		)
+	}
+
+	if data.FeedbackType.IsNull() || data.FeedbackType.IsUnknown() {
+		return
+	}
+	feedbackType := data.FeedbackType.ValueString()
+
+	if feedbackType == "categorical" && !hasBlocks && data.Categories.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("category"),
+			"Missing Categories For Categorical Type",
+			"A categorical feedback config needs at least one category block.",
+		)
+	}
+
+	if feedbackType != "categorical" {
+		if !data.Categories.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("categories"),
+				"Categories Require Categorical Type",
+				fmt.Sprintf("categories can only be set when feedback_type is \"categorical\", got %q.", feedbackType),
+			)
+		}
+		if hasBlocks {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("category"),
+				"Categories Require Categorical Type",
+				fmt.Sprintf("category blocks can only be set when feedback_type is \"categorical\", got %q.", feedbackType),
+			)
+		}
+	}
+
+	bounds := []struct {
+		name  string
+		value types.Float64
+	}{{"min", data.Min}, {"max", data.Max}}
+	for _, bound := range bounds {
+		if feedbackType == "continuous" && bound.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(bound.name),
+				"Missing Bounds For Continuous Type",
+				fmt.Sprintf("%s is required when feedback_type is \"continuous\".", bound.name),
+			)
+		}
+		if feedbackType != "continuous" && !bound.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(bound.name),
+				"Bounds Require Continuous Type",
+				fmt.Sprintf("%s can only be set when feedback_type is \"continuous\", got %q.", bound.name, feedbackType),
+			)
+		}
 	}
 }
 
@@ -123,13 +275,13 @@ func (r *FeedbackConfigResource) Configure(ctx context.Context, req resource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
 		return
 	}
-	r.client = c
+	r.client = data.client
 }
 
 // buildFeedbackConfig assembles the nested config map from flat Terraform attributes,
@@ -144,7 +296,10 @@ func (r *FeedbackConfigResource) buildFeedbackConfig(data *FeedbackConfigResourc
 	if !data.Max.IsNull() {
 		config["max"] = data.Max.ValueFloat64()
 	}
-	if !data.Categories.IsNull() && data.Categories.ValueString() != "" {
+	switch {
+	case len(data.Category) > 0:
+		config["categories"] = buildFeedbackCategories(data.Category)
+	case !data.Categories.IsNull() && !data.Categories.IsUnknown() && data.Categories.ValueString() != "":
 		var categories []interface{}
 		if err := json.Unmarshal([]byte(data.Categories.ValueString()), &categories); err == nil {
 			config["categories"] = categories
@@ -153,6 +308,37 @@ func (r *FeedbackConfigResource) buildFeedbackConfig(data *FeedbackConfigResourc
 	return config
 }
 
+// buildFeedbackCategories turns typed category blocks into the API's
+// categories.
+func buildFeedbackCategories(blocks []feedbackCategoryModel) []feedbackCategory {
+	categories := make([]feedbackCategory, 0, len(blocks))
+	for _, block := range blocks {
+		categories = append(categories, feedbackCategory{
+			Value: block.Value.ValueFloat64(),
+			Label: block.Label.ValueStringPointer(),
+		})
+	}
+	return categories
+}
+
+// decomposeFeedbackCategories breaks the API's categories back down into
+// typed blocks, the reverse of buildFeedbackCategories.
+func decomposeFeedbackCategories(raw json.RawMessage) ([]feedbackCategoryModel, error) {
+	var categories []feedbackCategory
+	if err := json.Unmarshal(raw, &categories); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]feedbackCategoryModel, 0, len(categories))
+	for _, category := range categories {
+		blocks = append(blocks, feedbackCategoryModel{
+			Value: types.Float64Value(category.Value),
+			Label: types.StringPointerValue(category.Label),
+		})
+	}
+	return blocks, nil
+}
+
 func (r *FeedbackConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FeedbackConfigResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -160,6 +346,23 @@ func (r *FeedbackConfigResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// A key that only differs in casing from one that's already there would
+	// make a near-duplicate rather than the config the user meant.
+	if !data.CaseSensitiveKey.ValueBool() {
+		configs, err := r.listFeedbackConfigs(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading feedback configs", err.Error())
+			return
+		}
+		if existing := findFeedbackConfig(configs, data.FeedbackKey.ValueString(), false); existing != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("feedback_key"), "Feedback Config Already Exists",
+				fmt.Sprintf("A feedback config with key %q already exists. Import it instead of creating %q alongside it.", existing.FeedbackKey, data.FeedbackKey.ValueString()))
+			return
+		}
+	}
+
 	body := feedbackConfigCreateRequest{
 		FeedbackKey:    data.FeedbackKey.ValueString(),
 		FeedbackConfig: r.buildFeedbackConfig(&data),
@@ -169,16 +372,32 @@ func (r *FeedbackConfigResource) Create(ctx context.Context, req resource.Create
 		body.IsLowerScoreBetter = &v
 	}
 
-	err := r.client.Post(ctx, "/api/v1/feedback-configs", body, nil)
+	err := r.client.Create(ctx, "/api/v1/feedback-configs", body, nil)
+	adopted := client.IsConflict(err)
+	if adopted {
+		// The key is already taken, most likely by an earlier create whose
+		// answer never made it back. Adopt it and bring it in line.
+		tflog.Info(ctx, "feedback config already exists, adopting it", map[string]interface{}{"key": body.FeedbackKey})
+		err = r.client.Patch(ctx, "/api/v1/feedback-configs", body, nil)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating feedback config", err.Error())
 		return
 	}
+	if adopted {
+		resp.Diagnostics.AddWarning(
+			"Adopted Existing Feedback Config",
+			fmt.Sprintf("A feedback config with key %q already existed, so instead of creating a new one the provider adopted it and updated it to match. If it was managed elsewhere, it's managed here now.", body.FeedbackKey),
+		)
+	}
 
 	data.ID = types.StringValue(data.FeedbackKey.ValueString())
 
-	// POST doesn't return the resource, so we circle back to read the computed fields
+	// POST doesn't return the resource, so we circle back to read the computed fields.
+	// Blocks can't be computed; keep them as planned so apply stays consistent.
+	planned := data.Category
 	found := r.readFeedbackConfig(ctx, &data, &resp.Diagnostics)
+	data.Category = planned
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,6 +418,8 @@ func (r *FeedbackConfigResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	found := r.readFeedbackConfig(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -211,34 +432,71 @@ func (r *FeedbackConfigResource) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// listFeedbackConfigs fetches every feedback config in the workspace.
+func (r *FeedbackConfigResource) listFeedbackConfigs(ctx context.Context) ([]feedbackConfigAPIResponse, error) {
+	return client.GetAll[feedbackConfigAPIResponse](ctx, r.client, "/api/v1/feedback-configs", nil)
+}
+
+// findFeedbackConfig picks the config with the given key. An exact match
+// always wins; failing that, and only when caseSensitive is off, a key that
+// differs in casing alone will do.
+func findFeedbackConfig(configs []feedbackConfigAPIResponse, feedbackKey string, caseSensitive bool) *feedbackConfigAPIResponse {
+	for i := range configs {
+		if configs[i].FeedbackKey == feedbackKey {
+			return &configs[i]
+		}
+	}
+	if caseSensitive {
+		return nil
+	}
+	for i := range configs {
+		if strings.EqualFold(configs[i].FeedbackKey, feedbackKey) {
+			return &configs[i]
+		}
+	}
+	return nil
+}
+
+// feedbackConfigAPIKey is the key the API knows the config by: the ID read
+// back from it, or the configured key before there's been a read.
+func feedbackConfigAPIKey(data *FeedbackConfigResourceModel) string {
+	if !data.ID.IsNull() && !data.ID.IsUnknown() && data.ID.ValueString() != "" {
+		return data.ID.ValueString()
+	}
+	return data.FeedbackKey.ValueString()
+}
+
 // readFeedbackConfig searches the full list of configs to find ours by key.
 // The API doesn't offer a direct lookup, so we ride through the whole herd.
 func (r *FeedbackConfigResource) readFeedbackConfig(ctx context.Context, data *FeedbackConfigResourceModel, diags *diag.Diagnostics) bool {
-	var configs []feedbackConfigAPIResponse
-	err := r.client.Get(ctx, "/api/v1/feedback-configs", nil, &configs)
+	configs, err := r.listFeedbackConfigs(ctx)
 	if err != nil {
 		diags.AddError("Error reading feedback configs", err.Error())
 		return false
 	}
 
+	// Imports arrive without the setting; they get the default.
+	if data.CaseSensitiveKey.IsNull() || data.CaseSensitiveKey.IsUnknown() {
+		data.CaseSensitiveKey = types.BoolValue(true)
+	}
+
 	feedbackKey := data.FeedbackKey.ValueString()
 	if feedbackKey == "" {
 		feedbackKey = data.ID.ValueString()
 	}
 
-	var found *feedbackConfigAPIResponse
-	for i := range configs {
-		if configs[i].FeedbackKey == feedbackKey {
-			found = &configs[i]
-			break
-		}
-	}
+	found := findFeedbackConfig(configs, feedbackKey, data.CaseSensitiveKey.ValueBool())
 	if found == nil {
 		return false
 	}
 
+	// The ID follows the API's spelling so updates and deletes reach the
+	// right config. A key matched regardless of casing keeps the configured
+	// spelling, so a casing change made elsewhere doesn't show up as drift.
 	data.ID = types.StringValue(found.FeedbackKey)
-	data.FeedbackKey = types.StringValue(found.FeedbackKey)
+	if data.CaseSensitiveKey.ValueBool() {
+		data.FeedbackKey = types.StringValue(found.FeedbackKey)
+	}
 	data.TenantID = types.StringValue(found.TenantID)
 	data.ModifiedAt = types.StringValue(found.ModifiedAt)
 	data.IsLowerScoreBetter = types.BoolValue(found.IsLowerScoreBetter)
@@ -256,15 +514,27 @@ func (r *FeedbackConfigResource) readFeedbackConfig(ctx context.Context, data *F
 	} else {
 		data.Max = types.Float64Null()
 	}
-	if cats, ok := found.FeedbackConfig["categories"]; ok {
+	// Typed blocks are refreshed when they're in use, or on import, when
+	// nothing is known yet. A config still on the deprecated JSON keeps its
+	// blocks empty.
+	refreshBlocks := len(data.Category) > 0 || data.Categories.IsNull() || data.Categories.IsUnknown()
+	if cats, ok := found.FeedbackConfig["categories"]; ok && cats != nil {
 		catsJSON, err := json.Marshal(cats)
 		if err != nil {
 			diags.AddError("Error serializing categories", err.Error())
 			return false
 		}
 		data.Categories = types.StringValue(string(catsJSON))
+		if refreshBlocks {
+			if blocks, err := decomposeFeedbackCategories(catsJSON); err == nil {
+				data.Category = blocks
+			}
+		}
 	} else {
 		data.Categories = types.StringNull()
+		if refreshBlocks {
+			data.Category = nil
+		}
 	}
 	return true
 }
@@ -276,8 +546,10 @@ func (r *FeedbackConfigResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := feedbackConfigCreateRequest{
-		FeedbackKey:    data.FeedbackKey.ValueString(),
+		FeedbackKey:    feedbackConfigAPIKey(&data),
 		FeedbackConfig: r.buildFeedbackConfig(&data),
 	}
 	if !data.IsLowerScoreBetter.IsNull() {
@@ -291,7 +563,9 @@ func (r *FeedbackConfigResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	planned := data.Category
 	found := r.readFeedbackConfig(ctx, &data, &resp.Diagnostics)
+	data.Category = planned
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -310,8 +584,12 @@ func (r *FeedbackConfigResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// The state's ID is the key as the API last spelled it, which is what
+	// the delete has to name.
 	q := url.Values{}
-	q.Set("feedback_key", data.FeedbackKey.ValueString())
+	q.Set("feedback_key", feedbackConfigAPIKey(&data))
 	err := r.client.DeleteWithQuery(ctx, "/api/v1/feedback-configs", q)
 	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error deleting feedback config", err.Error())
@@ -319,6 +597,7 @@ func (r *FeedbackConfigResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *FeedbackConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feedback_key"), req.ID)...)
 }
@@ -0,0 +1,319 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestFeedbackConfigResource_categoryBlocks checks typed category blocks are
+// sent as the API's categories, and come back as the same blocks.
+func TestFeedbackConfigResource_categoryBlocks(t *testing.T) {
+	r := &FeedbackConfigResource{}
+	data := FeedbackConfigResourceModel{
+		FeedbackType: types.StringValue("categorical"),
+		Min:          types.Float64Null(),
+		Max:          types.Float64Null(),
+		Categories:   types.StringUnknown(),
+		Category: []feedbackCategoryModel{
+			{Value: types.Float64Value(1), Label: types.StringValue("good")},
+			{Value: types.Float64Value(0), Label: types.StringNull()},
+		},
+	}
+
+	raw, err := json.Marshal(r.buildFeedbackConfig(&data))
+	if err != nil {
+		t.Fatalf("encoding config: %s", err)
+	}
+	if !jsonSemanticallyEqual(string(raw), `{"type": "categorical", "categories": [{"value": 1, "label": "good"}, {"value": 0}]}`) {
+		t.Errorf("unexpected feedback config: %s", raw)
+	}
+
+	back, err := decomposeFeedbackCategories(json.RawMessage(`[{"value": 1, "label": "good"}, {"value": 0}]`))
+	if err != nil {
+		t.Fatalf("decomposing categories: %s", err)
+	}
+	if len(back) != len(data.Category) {
+		t.Fatalf("expected %d blocks, got %+v", len(data.Category), back)
+	}
+	for i := range back {
+		if !back[i].Value.Equal(data.Category[i].Value) || !back[i].Label.Equal(data.Category[i].Label) {
+			t.Errorf("category %d: expected %+v, got %+v", i, data.Category[i], back[i])
+		}
+	}
+
+	// The deprecated JSON still works when there are no blocks.
+	data.Category = nil
+	data.Categories = types.StringValue(`[{"value": 2, "label": "great"}]`)
+	raw, err = json.Marshal(r.buildFeedbackConfig(&data))
+	if err != nil {
+		t.Fatalf("encoding config: %s", err)
+	}
+	if !jsonSemanticallyEqual(string(raw), `{"type": "categorical", "categories": [{"value": 2, "label": "great"}]}`) {
+		t.Errorf("unexpected feedback config from categories JSON: %s", raw)
+	}
+}
+
+// TestFeedbackConfigResource_typeFields checks each feedback type gets the
+// fields it needs, continuous and categorical fields can't be mixed, and
+// categories are given only one way.
+func TestFeedbackConfigResource_typeFields(t *testing.T) {
+	categoryType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"value": tftypes.Number,
+		"label": tftypes.String,
+	}}
+	categories := tftypes.NewValue(tftypes.List{ElementType: categoryType}, []tftypes.Value{
+		tftypes.NewValue(categoryType, map[string]tftypes.Value{
+			"value": tftypes.NewValue(tftypes.Number, 1),
+			"label": tftypes.NewValue(tftypes.String, "good"),
+		}),
+	})
+
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr string
+	}{
+		"continuous with bounds": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "continuous"),
+				"min":           tftypes.NewValue(tftypes.Number, 0),
+				"max":           tftypes.NewValue(tftypes.Number, 1),
+			},
+		},
+		"categorical with blocks": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "categorical"),
+				"category":      categories,
+			},
+		},
+		"continuous with blocks": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "continuous"),
+				"category":      categories,
+			},
+			wantErr: "Categories Require Categorical Type",
+		},
+		"continuous with categories JSON": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "continuous"),
+				"categories":    tftypes.NewValue(tftypes.String, `[{"value": 1}]`),
+			},
+			wantErr: "Categories Require Categorical Type",
+		},
+		"categorical with max": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "categorical"),
+				"category":      categories,
+				"max":           tftypes.NewValue(tftypes.Number, 1),
+			},
+			wantErr: "Bounds Require Continuous Type",
+		},
+		"continuous without max": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "continuous"),
+				"min":           tftypes.NewValue(tftypes.Number, 0),
+			},
+			wantErr: "Missing Bounds For Continuous Type",
+		},
+		"categorical without categories": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "categorical"),
+			},
+			wantErr: "Missing Categories For Categorical Type",
+		},
+		"categorical with categories JSON": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "categorical"),
+				"categories":    tftypes.NewValue(tftypes.String, `[{"value": 1}]`),
+			},
+		},
+		"unknown type": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "freeform"),
+			},
+			wantErr: "feedback_type",
+		},
+		"blocks and categories JSON": {
+			attrs: map[string]tftypes.Value{
+				"feedback_type": tftypes.NewValue(tftypes.String, "categorical"),
+				"category":      categories,
+				"categories":    tftypes.NewValue(tftypes.String, `[{"value": 1}]`),
+			},
+			wantErr: "Conflicting Feedback Categories",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["feedback_key"] = tftypes.NewValue(tftypes.String, "correctness")
+			diags := testValidateResourceConfig(t, "langsmith_feedback_config", tc.attrs)
+			if tc.wantErr == "" {
+				if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") {
+					t.Errorf("expected no errors, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestFeedbackConfigResource_keyCasing checks a key recased outside Terraform:
+// a case-sensitive config lets it go, while a case-insensitive one keeps
+// managing it, deletes it by the API's spelling, and won't create a
+// near-duplicate beside it. Either way no config is left orphaned.
+func TestFeedbackConfigResource_keyCasing(t *testing.T) {
+	ctx := context.Background()
+
+	var keys []string
+	var posted, deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("offset") != "0" {
+				_, _ = w.Write([]byte(`[]`))
+				return
+			}
+			configs := []feedbackConfigAPIResponse{}
+			for _, k := range keys {
+				configs = append(configs, feedbackConfigAPIResponse{FeedbackKey: k, FeedbackConfig: map[string]interface{}{"type": "continuous", "min": 0.0, "max": 1.0}})
+			}
+			_ = json.NewEncoder(w).Encode(configs)
+		case http.MethodPost:
+			var body feedbackConfigCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			posted = append(posted, body.FeedbackKey)
+			keys = append(keys, body.FeedbackKey)
+		case http.MethodDelete:
+			key := r.URL.Query().Get("feedback_key")
+			deleted = append(deleted, key)
+			for i, k := range keys {
+				if k == key {
+					keys = append(keys[:i], keys[i+1:]...)
+					break
+				}
+			}
+		}
+	}))
+	defer ts.Close()
+
+	r := &FeedbackConfigResource{client: client.NewClient(ts.URL, "key", "")}
+	model := func(caseSensitive bool) FeedbackConfigResourceModel {
+		return FeedbackConfigResourceModel{
+			ID:                 types.StringValue("correctness"),
+			FeedbackKey:        types.StringValue("correctness"),
+			FeedbackType:       types.StringValue("continuous"),
+			Min:                types.Float64Value(0),
+			Max:                types.Float64Value(1),
+			IsLowerScoreBetter: types.BoolValue(false),
+			CaseSensitiveKey:   types.BoolValue(caseSensitive),
+		}
+	}
+
+	// Someone recased the key in the UI.
+	keys = []string{"Correctness"}
+
+	sensitiveModel, insensitiveModel := model(true), model(false)
+	sensitive := testResourceState(t, r, &sensitiveModel)
+	readResp := &resource.ReadResponse{State: sensitive}
+	r.Read(ctx, resource.ReadRequest{State: sensitive}, readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Errorf("expected a case-sensitive config to let the recased key go, got %v", readResp.Diagnostics)
+	}
+
+	insensitive := testResourceState(t, r, &insensitiveModel)
+	readResp = &resource.ReadResponse{State: insensitive}
+	r.Read(ctx, resource.ReadRequest{State: insensitive}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	var got FeedbackConfigResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "Correctness" || got.FeedbackKey.ValueString() != "correctness" {
+		t.Errorf("expected the API's spelling in id and the configured one in feedback_key, got %s and %s", got.ID, got.FeedbackKey)
+	}
+
+	plan := model(false)
+	plan.ID = types.StringUnknown()
+	planState := testResourceState(t, r, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: planState.Schema, Raw: tftypes.NewValue(planState.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(planState)}, createResp)
+	if !createResp.Diagnostics.HasError() || createResp.Diagnostics[0].Summary() != "Feedback Config Already Exists" || len(posted) != 0 {
+		t.Errorf("expected the near-duplicate to be refused, got %v and posts %v", createResp.Diagnostics, posted)
+	}
+
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, &resource.DeleteResponse{})
+	if len(deleted) != 1 || deleted[0] != "Correctness" || len(keys) != 0 {
+		t.Errorf("expected the recased config to be deleted by the API's spelling, deleted %v and left %v", deleted, keys)
+	}
+}
+
+// TestFeedbackConfigResource_adoptOnConflict checks a create refused because
+// the key is taken adopts the existing config and brings it in line.
+func TestFeedbackConfigResource_adoptOnConflict(t *testing.T) {
+	ctx := context.Background()
+
+	config := map[string]interface{}{"type": "continuous", "min": 0.0, "max": 5.0}
+	var patched int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("offset") != "0" {
+				_, _ = w.Write([]byte(`[]`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode([]feedbackConfigAPIResponse{{FeedbackKey: "correctness", FeedbackConfig: config}})
+		case http.MethodPost:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"detail": "Feedback config already exists"}`))
+		case http.MethodPatch:
+			var body feedbackConfigCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			patched++
+			config = body.FeedbackConfig
+		}
+	}))
+	defer ts.Close()
+
+	r := &FeedbackConfigResource{client: client.NewClient(ts.URL, "key", "")}
+	plan := FeedbackConfigResourceModel{
+		ID:                 types.StringUnknown(),
+		FeedbackKey:        types.StringValue("correctness"),
+		FeedbackType:       types.StringValue("continuous"),
+		Min:                types.Float64Value(0),
+		Max:                types.Float64Value(1),
+		IsLowerScoreBetter: types.BoolValue(false),
+		CaseSensitiveKey:   types.BoolValue(true),
+	}
+	planState := testResourceState(t, r, &plan)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: planState.Schema, Raw: tftypes.NewValue(planState.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(planState)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+
+	var got FeedbackConfigResourceModel
+	createResp.State.Get(ctx, &got)
+	if patched != 1 || got.ID.ValueString() != "correctness" || got.Max.ValueFloat64() != 1 {
+		t.Errorf("expected the existing config to be adopted and patched, got %d patches and %+v", patched, got)
+	}
+	if warnings := createResp.Diagnostics.Warnings(); len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), `"correctness"`) {
+		t.Errorf("expected a warning naming the adopted config, got %v", createResp.Diagnostics)
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &FeedbackResource{}
+	_ resource.ResourceWithImportState = &FeedbackResource{}
+)
+
+// NewFeedbackResource returns a new FeedbackResource -- a judge's mark
+// scratched into the record of a run.
+func NewFeedbackResource() resource.Resource {
+	return &FeedbackResource{}
+}
+
+// FeedbackResource manages a single piece of feedback on a run, such as the
+// seed scores an evaluation pipeline starts from.
+type FeedbackResource struct {
+	client *client.Client
+}
+
+// FeedbackResourceModel describes the Terraform state for a feedback entry.
+type FeedbackResourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	RunID       types.String  `tfsdk:"run_id"`
+	Key         types.String  `tfsdk:"key"`
+	Score       types.Float64 `tfsdk:"score"`
+	Value       types.String  `tfsdk:"value"`
+	Comment     types.String  `tfsdk:"comment"`
+	Correction  types.String  `tfsdk:"correction"`
+	CreatedAt   types.String  `tfsdk:"created_at"`
+	WorkspaceID types.String  `tfsdk:"workspace_id"`
+}
+
+// feedbackCreateRequest records new feedback on a run.
+type feedbackCreateRequest struct {
+	RunID      string          `json:"run_id"`
+	Key        string          `json:"key"`
+	Score      *float64        `json:"score,omitempty"`
+	Value      json.RawMessage `json:"value,omitempty"`
+	Comment    *string         `json:"comment,omitempty"`
+	Correction json.RawMessage `json:"correction,omitempty"`
+}
+
+// feedbackUpdateRequest changes what the feedback says. Every field is sent,
+// so one dropped from the config is cleared rather than left standing.
+type feedbackUpdateRequest struct {
+	Score      *float64        `json:"score"`
+	Value      json.RawMessage `json:"value"`
+	Comment    *string         `json:"comment"`
+	Correction json.RawMessage `json:"correction"`
+}
+
+// feedbackAPIResponse is the API's record of a feedback entry.
+type feedbackAPIResponse struct {
+	ID         string          `json:"id"`
+	RunID      string          `json:"run_id"`
+	Key        string          `json:"key"`
+	Score      *float64        `json:"score"`
+	Value      json.RawMessage `json:"value"`
+	Comment    *string         `json:"comment"`
+	Correction json.RawMessage `json:"correction"`
+	CreatedAt  string          `json:"created_at"`
+}
+
+func (r *FeedbackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feedback"
+}
+
+func (r *FeedbackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a piece of feedback on a LangSmith run, such as a golden score seeded for an evaluation pipeline.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the feedback.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the run the feedback is about. Changing it forces new feedback.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The feedback key, e.g. `correctness`. Changing it forces new feedback.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"score": schema.Float64Attribute{
+				MarkdownDescription: "The numeric score.",
+				Optional:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "A non-numeric value, such as a category label. A JSON object or array is sent as JSON; anything else as a string.",
+				Optional:            true,
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "A free-text comment explaining the feedback.",
+				Optional:            true,
+			},
+			"correction": schema.StringAttribute{
+				MarkdownDescription: "What the run should have produced, as a JSON document.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the feedback was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FeedbackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *FeedbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeedbackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	update, diags := buildFeedbackRequest(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := feedbackCreateRequest{
+		RunID:      data.RunID.ValueString(),
+		Key:        data.Key.ValueString(),
+		Score:      update.Score,
+		Value:      update.Value,
+		Comment:    update.Comment,
+		Correction: update.Correction,
+	}
+
+	var result feedbackAPIResponse
+	err := r.client.Create(ctx, "/api/v1/feedback", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating feedback", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapFeedbackResponseToState(&data, &result)...)
+	tflog.Trace(ctx, "created feedback resource", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeedbackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeedbackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result feedbackAPIResponse
+	err := r.client.Get(ctx, "/api/v1/feedback/"+data.ID.ValueString(), nil, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading feedback", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapFeedbackResponseToState(&data, &result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeedbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FeedbackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	body, diags := buildFeedbackRequest(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result feedbackAPIResponse
+	err := r.client.Patch(ctx, "/api/v1/feedback/"+data.ID.ValueString(), body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating feedback", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(mapFeedbackResponseToState(&data, &result)...)
+	tflog.Trace(ctx, "updated feedback resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeedbackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FeedbackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, "/api/v1/feedback/"+data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting feedback", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted feedback resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+func (r *FeedbackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// buildFeedbackRequest gathers what the feedback says into the API's shape.
+// Create adds the run and key on top.
+func buildFeedbackRequest(data *FeedbackResourceModel) (feedbackUpdateRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var body feedbackUpdateRequest
+
+	if !data.Score.IsNull() && !data.Score.IsUnknown() {
+		v := data.Score.ValueFloat64()
+		body.Score = &v
+	}
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		value, err := blockValueJSON(data.Value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("value"), "Invalid Feedback Value", err.Error())
+			return body, diags
+		}
+		body.Value = value
+	}
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
+		v := data.Comment.ValueString()
+		body.Comment = &v
+	}
+	if !data.Correction.IsNull() && !data.Correction.IsUnknown() {
+		correction := data.Correction.ValueString()
+		if !json.Valid([]byte(correction)) {
+			diags.AddAttributeError(path.Root("correction"), "Invalid Correction JSON",
+				"The correction must be a JSON document, e.g. jsonencode({ answer = \"Dodge City\" }).")
+			return body, diags
+		}
+		body.Correction = json.RawMessage(correction)
+	}
+
+	return body, diags
+}
+
+// mapFeedbackResponseToState maps the API response onto Terraform state. A
+// JSON value or correction keeps its configured spelling while it means the
+// same thing.
+func mapFeedbackResponseToState(data *FeedbackResourceModel, result *feedbackAPIResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(result.ID)
+	data.RunID = types.StringValue(result.RunID)
+	data.Key = types.StringValue(result.Key)
+	data.Score = types.Float64PointerValue(result.Score)
+	data.Comment = types.StringPointerValue(result.Comment)
+
+	if len(result.Value) == 0 || string(result.Value) == "null" {
+		data.Value = types.StringNull()
+	} else {
+		value, err := blockValueString(result.Value)
+		if err != nil {
+			diags.AddError("Error reading feedback value", err.Error())
+			return diags
+		}
+		if data.Value.IsNull() || data.Value.IsUnknown() || !jsonSemanticallyEqual(data.Value.ValueString(), value) {
+			data.Value = types.StringValue(value)
+		}
+	}
+
+	switch {
+	case len(result.Correction) == 0 || string(result.Correction) == "null":
+		data.Correction = types.StringNull()
+	case data.Correction.IsNull() || data.Correction.IsUnknown() || !jsonSemanticallyEqual(data.Correction.ValueString(), string(result.Correction)):
+		data.Correction = types.StringValue(string(result.Correction))
+	}
+
+	if result.CreatedAt != "" {
+		data.CreatedAt = types.StringValue(result.CreatedAt)
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	return diags
+}
@@ -0,0 +1,132 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const testFeedbackRunID = "3c9e1f2a-4b5d-4e6f-8a7b-9c0d1e2f3a4b"
+
+// TestFeedbackResource_crud walks feedback through create, read, update and
+// delete against a stand-in API, checking JSON keeps its configured spelling
+// and that fields dropped from the config are cleared.
+func TestFeedbackResource_crud(t *testing.T) {
+	var stored map[string]json.RawMessage
+	deleted := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/feedback":
+			_ = json.NewDecoder(r.Body).Decode(&stored)
+			stored["id"] = json.RawMessage(`"f1"`)
+			stored["created_at"] = json.RawMessage(`"2025-06-01T00:00:00Z"`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/feedback/f1":
+			var body map[string]json.RawMessage
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			for k, v := range body {
+				stored[k] = v
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/feedback/f1":
+			if deleted {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/feedback/f1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &FeedbackResource{client: client.NewClient(ts.URL, "key", "")}
+	emptyState := func(s tfsdk.State) tfsdk.State {
+		return tfsdk.State{Schema: s.Schema, Raw: tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)}
+	}
+
+	created := FeedbackResourceModel{
+		ID:         types.StringUnknown(),
+		RunID:      types.StringValue(testFeedbackRunID),
+		Key:        types.StringValue("correctness"),
+		Score:      types.Float64Value(1),
+		Value:      types.StringValue(`{"verdict": "guilty"}`),
+		Comment:    types.StringValue("Matches the golden answer"),
+		Correction: types.StringValue(`{ "answer": "Dodge City" }`),
+		CreatedAt:  types.StringUnknown(),
+	}
+	plan := testResourceState(t, r, &created)
+	createResp := &resource.CreateResponse{State: emptyState(plan)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+	if string(stored["value"]) != `{"verdict":"guilty"}` || string(stored["correction"]) != `{"answer":"Dodge City"}` {
+		t.Errorf("expected the value and correction to be sent as JSON, got %s and %s", stored["value"], stored["correction"])
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	var got FeedbackResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "f1" || got.Score.ValueFloat64() != 1 || got.CreatedAt.ValueString() == "" {
+		t.Errorf("unexpected feedback %+v", got)
+	}
+	if !got.Value.Equal(created.Value) || !got.Correction.Equal(created.Correction) {
+		t.Errorf("expected the configured JSON spelling to be kept, got %s and %s", got.Value, got.Correction)
+	}
+
+	updated := got
+	updated.Score = types.Float64Null()
+	updated.Value = types.StringValue("innocent")
+	updated.Comment = types.StringNull()
+	updatePlan := testResourceState(t, r, &updated)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(updatePlan), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("updating: %v", updateResp.Diagnostics)
+	}
+	if string(stored["score"]) != "null" || string(stored["comment"]) != "null" || string(stored["value"]) != `"innocent"` {
+		t.Errorf("expected the score and comment to be cleared and the value sent as a string, got %v", stored)
+	}
+	updateResp.State.Get(ctx, &got)
+	if !got.Score.IsNull() || got.Value.ValueString() != "innocent" {
+		t.Errorf("unexpected updated feedback %+v", got)
+	}
+
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &resource.DeleteResponse{})
+	goneResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, goneResp)
+	if !deleted || !goneResp.State.Raw.IsNull() {
+		t.Error("expected the deleted feedback to be removed from state")
+	}
+}
+
+// TestFeedbackResource_invalidCorrection checks a correction that isn't JSON
+// is turned away before anything reaches the API.
+func TestFeedbackResource_invalidCorrection(t *testing.T) {
+	_, diags := buildFeedbackRequest(&FeedbackResourceModel{Correction: types.StringValue("Dodge City")})
+	if !diags.HasError() || diags[0].Summary() != "Invalid Correction JSON" {
+		t.Errorf("expected an invalid correction error, got %v", diags)
+	}
+}
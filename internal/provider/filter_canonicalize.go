@@ -0,0 +1,196 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// canonicalizeFilter rewrites a LangSmith filter expression, such as
+// `and(eq(feedback_key, "correctness"), gte(latency, 5))`, into one canonical
+// spelling so two filters that mean the same thing compare equal:
+//
+//   - whitespace is collapsed to a single space after each comma;
+//   - operator names are lowercased;
+//   - strings are double-quoted;
+//   - the arguments of `and` and `or`, which don't care about order, are sorted.
+//
+// Field names and values are left alone, since the API treats them as case
+// sensitive. Anything that doesn't parse is returned trimmed but otherwise
+// untouched; turning bad filters away is validFilter's job, not this one's.
+func canonicalizeFilter(filter string) string {
+	p := &filterParser{input: filter}
+	node, err := p.parse()
+	if err != nil {
+		return strings.TrimSpace(filter)
+	}
+	return node.String()
+}
+
+// filterNode is one piece of a parsed filter: a call with arguments, a list,
+// or a bare literal.
+type filterNode struct {
+	call    string
+	list    bool
+	args    []*filterNode
+	literal string
+}
+
+func (n *filterNode) String() string {
+	if n.call == "" && !n.list {
+		return n.literal
+	}
+
+	args := make([]string, len(n.args))
+	for i, a := range n.args {
+		args[i] = a.String()
+	}
+	if n.call == "and" || n.call == "or" {
+		sort.Strings(args)
+	}
+
+	if n.list {
+		return "[" + strings.Join(args, ", ") + "]"
+	}
+	return n.call + "(" + strings.Join(args, ", ") + ")"
+}
+
+// filterParser is a small recursive-descent parser for the filter DSL.
+type filterParser struct {
+	input string
+	pos   int
+}
+
+func (p *filterParser) parse() (*filterNode, error) {
+	node, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.input[p.pos:], p.pos)
+	}
+	return node, nil
+}
+
+func (p *filterParser) parseExpr() (*filterNode, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+
+	switch c := p.input[p.pos]; {
+	case c == '"' || c == '\'':
+		s, err := p.parseString(c)
+		if err != nil {
+			return nil, err
+		}
+		return &filterNode{literal: strconv.Quote(s)}, nil
+	case c == '[':
+		p.pos++
+		args, err := p.parseArgs(']')
+		if err != nil {
+			return nil, err
+		}
+		return &filterNode{list: true, args: args}, nil
+	}
+
+	word := p.parseWord()
+	if word == "" {
+		return nil, fmt.Errorf("unexpected %q at offset %d", p.input[p.pos], p.pos)
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] == '(' {
+		p.pos++
+		args, err := p.parseArgs(')')
+		if err != nil {
+			return nil, err
+		}
+		return &filterNode{call: strings.ToLower(word), args: args}, nil
+	}
+	return &filterNode{literal: word}, nil
+}
+
+// parseArgs reads a comma-separated argument list up to and including the
+// closing delimiter.
+func (p *filterParser) parseArgs(closing byte) ([]*filterNode, error) {
+	var args []*filterNode
+
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] == closing {
+		p.pos++
+		return args, nil
+	}
+
+	for {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return nil, fmt.Errorf("missing %q", closing)
+		}
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+		case closing:
+			p.pos++
+			return args, nil
+		default:
+			return nil, fmt.Errorf("unexpected %q at offset %d", p.input[p.pos], p.pos)
+		}
+	}
+}
+
+// parseString reads a quoted string, honoring backslash escapes, and returns
+// its contents.
+func (p *filterParser) parseString(quote byte) (string, error) {
+	var b strings.Builder
+	for p.pos++; p.pos < len(p.input); p.pos++ {
+		c := p.input[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.input):
+			p.pos++
+			b.WriteByte(p.input[p.pos])
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated string")
+}
+
+// parseWord reads an operator name, field name, number, or other bare token.
+func (p *filterParser) parseWord() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("_.-+:", r) {
+			break
+		}
+		p.pos += size
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *filterParser) skipSpace() {
+	for p.pos < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.pos += size
+	}
+}
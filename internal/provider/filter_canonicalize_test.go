@@ -0,0 +1,92 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestCanonicalizeFilter(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want string
+	}{
+		"already canonical": {
+			in:   `eq(feedback_key, "correctness")`,
+			want: `eq(feedback_key, "correctness")`,
+		},
+		"whitespace": {
+			in:   "  eq( feedback_key ,\n\t\"correctness\" )  ",
+			want: `eq(feedback_key, "correctness")`,
+		},
+		"operator casing": {
+			in:   `AND(Eq(run_type, "llm"), GTE(latency, 5))`,
+			want: `and(eq(run_type, "llm"), gte(latency, 5))`,
+		},
+		"single quotes": {
+			in:   `eq(name, 'Matt Dillon')`,
+			want: `eq(name, "Matt Dillon")`,
+		},
+		"escaped quotes": {
+			in:   `eq(name, 'Doc \'Adams\'')`,
+			want: `eq(name, "Doc 'Adams'")`,
+		},
+		"and arguments sorted": {
+			in:   `and(gte(latency, 5), eq(run_type, "llm"))`,
+			want: `and(eq(run_type, "llm"), gte(latency, 5))`,
+		},
+		"nested or sorted": {
+			in:   `and(or(eq(name, "b"), eq(name, "a")), has(tags, "prod"))`,
+			want: `and(has(tags, "prod"), or(eq(name, "a"), eq(name, "b")))`,
+		},
+		"non-commutative order kept": {
+			in:   `gte(5, latency)`,
+			want: `gte(5, latency)`,
+		},
+		"field and value case kept": {
+			in:   `eq(Metadata_Key, "Production")`,
+			want: `eq(Metadata_Key, "Production")`,
+		},
+		"lists": {
+			in:   `in(run_type,["llm" ,'chain'])`,
+			want: `in(run_type, ["llm", "chain"])`,
+		},
+		"no arguments": {
+			in:   `is_root( )`,
+			want: `is_root()`,
+		},
+		"negative and decimal numbers": {
+			in:   `gt(feedback_score,-0.5)`,
+			want: `gt(feedback_score, -0.5)`,
+		},
+		"non-ASCII bare values": {
+			in:   "eq( name ,\u00a0Café)",
+			want: `eq(name, Café)`,
+		},
+		"unparseable left alone": {
+			in:   `  eq(feedback_key, "unterminated)  `,
+			want: `eq(feedback_key, "unterminated)`,
+		},
+		"trailing junk left alone": {
+			in:   `eq(a, 1) eq(b, 2)`,
+			want: `eq(a, 1) eq(b, 2)`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := canonicalizeFilter(tc.in); got != tc.want {
+				t.Errorf("canonicalizeFilter(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestCanonicalizeFilter_equivalent checks that differently written filters
+// with the same meaning land on the same canonical form.
+func TestCanonicalizeFilter_equivalent(t *testing.T) {
+	a := canonicalizeFilter(`and(eq(feedback_key, "correctness"), lt(feedback_score, 0.5))`)
+	b := canonicalizeFilter("AND( LT(feedback_score,0.5),\n  Eq(feedback_key,'correctness') )")
+	if a != b {
+		t.Errorf("expected equal canonical forms, got %q and %q", a, b)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &FilterViewResource{}
+	_ resource.ResourceWithImportState = &FilterViewResource{}
+)
+
+// NewFilterViewResource returns a new FilterViewResource -- a wanted poster
+// pinned up in the project, so everyone looks for the same faces.
+func NewFilterViewResource() resource.Resource {
+	return &FilterViewResource{}
+}
+
+// FilterViewResource manages a saved filter view on a tracing project.
+type FilterViewResource struct {
+	client *client.Client
+}
+
+// FilterViewResourceModel describes the Terraform state for a filter view.
+type FilterViewResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	SessionID       types.String `tfsdk:"session_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Filter          types.String `tfsdk:"filter"`
+	DisplaySettings types.String `tfsdk:"display_settings"`
+	WorkspaceID     types.String `tfsdk:"workspace_id"`
+}
+
+// filterViewRequest is the body for saving a view. Every field is sent on an
+// update, so one dropped from the config is cleared.
+type filterViewRequest struct {
+	DisplayName     string          `json:"display_name"`
+	Description     *string         `json:"description"`
+	FilterString    string          `json:"filter_string"`
+	DisplaySettings json.RawMessage `json:"display_settings"`
+}
+
+// filterViewAPIResponse is the API's record of a saved view.
+type filterViewAPIResponse struct {
+	ID              string          `json:"id"`
+	SessionID       string          `json:"session_id"`
+	DisplayName     string          `json:"display_name"`
+	Description     *string         `json:"description"`
+	FilterString    string          `json:"filter_string"`
+	DisplaySettings json.RawMessage `json:"display_settings"`
+}
+
+func (r *FilterViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filter_view"
+}
+
+func (r *FilterViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a saved filter view on a LangSmith tracing project, such as an `errors` or `slow traces` view shared across projects. " +
+			"Import with `session_id/view_id`.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the view.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project to save the view in. Changing it forces a new view.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the view.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the view.",
+				Optional:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "The run filter expression the view applies, e.g. `eq(status, \"error\")`.",
+				Required:            true,
+				Validators:          []validator.String{validFilter()},
+			},
+			"display_settings": schema.StringAttribute{
+				MarkdownDescription: "How the view lays out its runs, such as visible columns, as a JSON document.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FilterViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *FilterViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FilterViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	body, diags := buildFilterViewRequest(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result filterViewAPIResponse
+	err := r.client.Create(ctx, "/api/v1/sessions/"+data.SessionID.ValueString()+"/views", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating filter view", err.Error())
+		return
+	}
+
+	mapFilterViewResponseToState(&data, &result)
+	tflog.Trace(ctx, "created filter view resource", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FilterViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FilterViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result filterViewAPIResponse
+	err := r.client.Get(ctx, filterViewPath(&data), nil, &result)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading filter view", err.Error())
+		return
+	}
+
+	mapFilterViewResponseToState(&data, &result)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FilterViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FilterViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	body, diags := buildFilterViewRequest(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result filterViewAPIResponse
+	err := r.client.Patch(ctx, filterViewPath(&data), body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating filter view", err.Error())
+		return
+	}
+
+	mapFilterViewResponseToState(&data, &result)
+	tflog.Trace(ctx, "updated filter view resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FilterViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FilterViewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, filterViewPath(&data))
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting filter view", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted filter view resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+// ImportState handles importing a filter view. Views live under their
+// project, so the import ID is "session_id/view_id".
+func (r *FilterViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	sessionID, viewID, ok := strings.Cut(req.ID, "/")
+	if !ok || sessionID == "" || viewID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'session_id/view_id', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("session_id"), sessionID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), viewID)...)
+}
+
+// filterViewPath is where a saved view lives in the API.
+func filterViewPath(data *FilterViewResourceModel) string {
+	return "/api/v1/sessions/" + data.SessionID.ValueString() + "/views/" + data.ID.ValueString()
+}
+
+// buildFilterViewRequest assembles the request body from the plan.
+func buildFilterViewRequest(data *FilterViewResourceModel) (filterViewRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := filterViewRequest{
+		DisplayName:  data.Name.ValueString(),
+		FilterString: data.Filter.ValueString(),
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		v := data.Description.ValueString()
+		body.Description = &v
+	}
+	if !data.DisplaySettings.IsNull() && !data.DisplaySettings.IsUnknown() {
+		settings := data.DisplaySettings.ValueString()
+		if !json.Valid([]byte(settings)) {
+			diags.AddAttributeError(path.Root("display_settings"), "Invalid Display Settings JSON",
+				"The display settings must be a JSON document, e.g. jsonencode({ columns = [\"name\", \"latency\"] }).")
+			return body, diags
+		}
+		body.DisplaySettings = json.RawMessage(settings)
+	}
+
+	return body, diags
+}
+
+// mapFilterViewResponseToState maps the API response onto Terraform state.
+// The filter and display settings keep their configured spelling while they
+// mean the same thing.
+func mapFilterViewResponseToState(data *FilterViewResourceModel, result *filterViewAPIResponse) {
+	data.ID = types.StringValue(result.ID)
+	if result.SessionID != "" {
+		data.SessionID = types.StringValue(result.SessionID)
+	}
+	data.Name = types.StringValue(result.DisplayName)
+	data.Description = types.StringPointerValue(result.Description)
+	if data.Filter.IsNull() || data.Filter.IsUnknown() || canonicalizeFilter(data.Filter.ValueString()) != canonicalizeFilter(result.FilterString) {
+		data.Filter = types.StringValue(result.FilterString)
+	}
+
+	switch {
+	case len(result.DisplaySettings) == 0 || string(result.DisplaySettings) == "null":
+		data.DisplaySettings = types.StringNull()
+	case data.DisplaySettings.IsNull() || data.DisplaySettings.IsUnknown() || !jsonSemanticallyEqual(data.DisplaySettings.ValueString(), string(result.DisplaySettings)):
+		data.DisplaySettings = types.StringValue(string(result.DisplaySettings))
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const testFilterViewSessionID = "5e8d2c1b-7a6f-4e3d-9c2b-1a0f9e8d7c6b"
+
+// TestFilterViewResource_crud walks a view through create, read, update and
+// delete against a stand-in API that re-spells what it's given.
+func TestFilterViewResource_crud(t *testing.T) {
+	viewPath := "/api/v1/sessions/" + testFilterViewSessionID + "/views"
+	var stored filterViewAPIResponse
+	deleted := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == viewPath:
+			var body filterViewRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			stored = filterViewAPIResponse{
+				ID:              "v1",
+				SessionID:       testFilterViewSessionID,
+				DisplayName:     body.DisplayName,
+				Description:     body.Description,
+				FilterString:    canonicalizeFilter(body.FilterString),
+				DisplaySettings: body.DisplaySettings,
+			}
+		case r.Method == http.MethodPatch && r.URL.Path == viewPath+"/v1":
+			var body filterViewRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			stored.DisplayName = body.DisplayName
+			stored.Description = body.Description
+			stored.FilterString = body.FilterString
+			stored.DisplaySettings = body.DisplaySettings
+		case r.Method == http.MethodGet && r.URL.Path == viewPath+"/v1":
+			if deleted {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		case r.Method == http.MethodDelete && r.URL.Path == viewPath+"/v1":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(stored)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &FilterViewResource{client: client.NewClient(ts.URL, "key", "")}
+	emptyState := func(s tfsdk.State) tfsdk.State {
+		return tfsdk.State{Schema: s.Schema, Raw: tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)}
+	}
+
+	created := FilterViewResourceModel{
+		ID:              types.StringUnknown(),
+		SessionID:       types.StringValue(testFilterViewSessionID),
+		Name:            types.StringValue("errors"),
+		Description:     types.StringValue("Runs that ended in trouble"),
+		Filter:          types.StringValue(`EQ(status,'error')`),
+		DisplaySettings: types.StringValue(`{ "columns": ["name", "latency"] }`),
+	}
+	plan := testResourceState(t, r, &created)
+	createResp := &resource.CreateResponse{State: emptyState(plan)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	var got FilterViewResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "v1" || got.Name.ValueString() != "errors" {
+		t.Errorf("unexpected view %+v", got)
+	}
+	if !got.Filter.Equal(created.Filter) || !got.DisplaySettings.Equal(created.DisplaySettings) {
+		t.Errorf("expected the configured spelling to be kept, got %s and %s", got.Filter, got.DisplaySettings)
+	}
+
+	updated := got
+	updated.Filter = types.StringValue(`gt(latency, 10)`)
+	updated.Description = types.StringNull()
+	updated.DisplaySettings = types.StringNull()
+	updatePlan := testResourceState(t, r, &updated)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(updatePlan), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("updating: %v", updateResp.Diagnostics)
+	}
+	if stored.FilterString != `gt(latency, 10)` || stored.Description != nil || string(stored.DisplaySettings) != "null" {
+		t.Errorf("expected the update to reach the API and clear what was dropped, got %+v", stored)
+	}
+
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &resource.DeleteResponse{})
+	goneResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, goneResp)
+	if !deleted || !goneResp.State.Raw.IsNull() {
+		t.Error("expected the deleted view to be removed from state")
+	}
+}
+
+// TestFilterViewResource_import checks the import ID carries both the project
+// and the view.
+func TestFilterViewResource_import(t *testing.T) {
+	r := &FilterViewResource{}
+
+	resp := testImportState(t, r, testFilterViewSessionID+"/v1")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("importing: %v", resp.Diagnostics)
+	}
+	var sessionID, id types.String
+	resp.State.GetAttribute(context.Background(), path.Root("session_id"), &sessionID)
+	resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+	if sessionID.ValueString() != testFilterViewSessionID || id.ValueString() != "v1" {
+		t.Errorf("unexpected import %s/%s", sessionID, id)
+	}
+
+	if resp := testImportState(t, r, "v1"); !resp.Diagnostics.HasError() {
+		t.Error("expected an import ID without the project to be turned away")
+	}
+}
@@ -0,0 +1,500 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &ModelPriceMapHistoryResource{}
+	_ resource.ResourceWithImportState    = &ModelPriceMapHistoryResource{}
+	_ resource.ResourceWithValidateConfig = &ModelPriceMapHistoryResource{}
+	_ resource.ResourceWithModifyPlan     = &ModelPriceMapHistoryResource{}
+)
+
+// NewModelPriceMapHistoryResource returns a new ModelPriceMapHistoryResource --
+// the general store's ledger, with every price change written down in order.
+func NewModelPriceMapHistoryResource() resource.Resource {
+	return &ModelPriceMapHistoryResource{}
+}
+
+// ModelPriceMapHistoryResource manages every model price map entry that shares
+// a name as one ordered history of price versions. Prices change over time;
+// this keeps the old ones on the books instead of leaving orphans behind.
+type ModelPriceMapHistoryResource struct {
+	client *client.Client
+}
+
+// ModelPriceMapHistoryResourceModel holds the Terraform state for a price
+// history: the settings shared by every version, and the versions themselves.
+type ModelPriceMapHistoryResourceModel struct {
+	ID           types.String             `tfsdk:"id"`
+	Name         types.String             `tfsdk:"name"`
+	MatchPattern types.String             `tfsdk:"match_pattern"`
+	Provider     types.String             `tfsdk:"model_provider"`
+	MatchPath    types.List               `tfsdk:"match_path"`
+	Versions     []modelPriceVersionModel `tfsdk:"versions"`
+	WorkspaceID  types.String             `tfsdk:"workspace_id"`
+}
+
+// modelPriceVersionModel is one entry in the ledger: a price and the moment it
+// took effect.
+type modelPriceVersionModel struct {
+	ID             types.String  `tfsdk:"id"`
+	StartTime      types.String  `tfsdk:"start_time"`
+	PromptCost     types.Float64 `tfsdk:"prompt_cost"`
+	CompletionCost types.Float64 `tfsdk:"completion_cost"`
+}
+
+func (r *ModelPriceMapHistoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_price_map_history"
+}
+
+func (r *ModelPriceMapHistoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the full pricing history of a model as an ordered list of time-versioned LangSmith model price map entries sharing one name. " +
+			"Versions are reconciled on update: new start times are created, changed prices are updated, and versions removed from the list are deleted. " +
+			"Every price map entry with this name is considered part of the history, so don't also manage them with `langsmith_model_price_map`.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the history, which is its `name`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The model name shared by every version in the history.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"match_pattern": schema.StringAttribute{
+				MarkdownDescription: "A regex pattern to match model names, applied to every version.",
+				Required:            true,
+			},
+			"model_provider": schema.StringAttribute{
+				MarkdownDescription: "The model provider name (e.g., `openai`, `anthropic`), applied to every version.",
+				Optional:            true,
+			},
+			"match_path": schema.ListAttribute{
+				MarkdownDescription: "Paths to match for model identification, applied to every version. Defaults to `[\"model\", \"model_name\", \"model_id\", \"model_path\", \"endpoint_name\"]`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"versions": schema.ListNestedAttribute{
+				MarkdownDescription: "The price versions, ordered by `start_time` from oldest to newest. Only the first version may omit `start_time`.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the price map entry backing this version.",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "When this price takes effect: an RFC 3339 timestamp such as `2024-08-06T00:00:00Z`, the same without a zone for UTC, or a date such as `2024-08-06`. Omit on the first version for a price with no start date.",
+							Optional:            true,
+						},
+						"prompt_cost": schema.Float64Attribute{
+							MarkdownDescription: "The cost per prompt token.",
+							Required:            true,
+						},
+						"completion_cost": schema.Float64Attribute{
+							MarkdownDescription: "The cost per completion token.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ModelPriceMapHistoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+// ValidateConfig checks the versions are in order: start times unique and
+// ascending, with only the first allowed to go without one.
+func (r *ModelPriceMapHistoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var versions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("versions"), &versions)...)
+	if resp.Diagnostics.HasError() || versions.IsNull() || versions.IsUnknown() {
+		return
+	}
+
+	var items []modelPriceVersionModel
+	resp.Diagnostics.Append(versions.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous time.Time
+	for i, v := range items {
+		if v.StartTime.IsUnknown() {
+			return
+		}
+		attrPath := path.Root("versions").AtListIndex(i).AtName("start_time")
+
+		if v.StartTime.IsNull() {
+			if i > 0 {
+				resp.Diagnostics.AddAttributeError(attrPath, "Missing Start Time",
+					"Only the first price version may omit start_time.")
+			}
+			continue
+		}
+
+		t, ok := parsePriceStartTime(v.StartTime.ValueString())
+		if !ok {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid Start Time",
+				fmt.Sprintf("start_time %q is not a timestamp such as \"2024-08-06T00:00:00Z\", \"2024-08-06T00:00:00\" (UTC), or \"2024-08-06\".", v.StartTime.ValueString()))
+			return
+		}
+		if !previous.IsZero() && !t.After(previous) {
+			resp.Diagnostics.AddAttributeError(attrPath, "Price Versions Out Of Order",
+				"Price versions must be listed by start_time from oldest to newest, with no two sharing a start time.")
+			return
+		}
+		previous = t
+	}
+}
+
+// ModifyPlan carries each version's entry ID over from state when its start
+// time is unchanged, so only genuinely new versions show up as unknown.
+func (r *ModelPriceMapHistoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ModelPriceMapHistoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]types.String{}
+	for _, v := range state.Versions {
+		ids[priceVersionKey(v.StartTime)] = v.ID
+	}
+	for i, v := range plan.Versions {
+		if v.StartTime.IsUnknown() {
+			continue
+		}
+		if id, ok := ids[priceVersionKey(v.StartTime)]; ok {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("versions").AtListIndex(i).AtName("id"), id)...)
+		}
+	}
+}
+
+func (r *ModelPriceMapHistoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ModelPriceMapHistoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	r.reconcile(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created model price map history resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelPriceMapHistoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ModelPriceMapHistoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	name := data.ID.ValueString()
+	if name == "" {
+		name = data.Name.ValueString()
+	}
+
+	entries, err := listModelPriceMapVersions(ctx, r.client, name)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading model price map history", err.Error())
+		return
+	}
+
+	if len(entries) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapModelPriceMapHistoryToState(ctx, &data, entries, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelPriceMapHistoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ModelPriceMapHistoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	r.reconcile(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "updated model price map history resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelPriceMapHistoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ModelPriceMapHistoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	entries, err := listModelPriceMapVersions(ctx, r.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading model price map history", err.Error())
+		return
+	}
+
+	for _, e := range entries {
+		err := r.client.Delete(ctx, "/api/v1/model-price-map/"+e.ID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error deleting model price map version", err.Error())
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted model price map history resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+// ImportState brings in the full history by model name.
+func (r *ModelPriceMapHistoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+// reconcile squares the API's ledger with the planned versions. Existing
+// entries are matched by start time: matches are updated in place, new start
+// times are created, and entries no longer in the plan are deleted, as are
+// extra entries sharing a start time, which draw a warning. On success data
+// holds the entry ID of every version.
+func (r *ModelPriceMapHistoryResource) reconcile(ctx context.Context, data *ModelPriceMapHistoryResourceModel, diags *diag.Diagnostics) {
+	name := data.Name.ValueString()
+
+	existing, err := listModelPriceMapVersions(ctx, r.client, name)
+	if err != nil {
+		diags.AddError("Error reading model price map history", err.Error())
+		return
+	}
+	byKey := make(map[string]modelPriceMapAPIResponse, len(existing))
+	var duplicates []modelPriceMapAPIResponse
+	for _, e := range existing {
+		key := priceVersionKey(types.StringPointerValue(e.StartTime))
+		if d, ok := byKey[key]; ok {
+			duplicates = append(duplicates, d)
+			diags.AddWarning("Duplicate Model Price Map Versions",
+				fmt.Sprintf("Entries %s and %s for %q take effect at the same start time, so only one can be the price. %s is kept and %s will be deleted.",
+					d.ID, e.ID, name, e.ID, d.ID))
+		}
+		byKey[key] = e
+	}
+
+	var matchPath []string
+	if !data.MatchPath.IsNull() && !data.MatchPath.IsUnknown() {
+		diags.Append(data.MatchPath.ElementsAs(ctx, &matchPath, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	for i, v := range data.Versions {
+		body := modelPriceMapAPIRequest{
+			Name:           name,
+			MatchPattern:   data.MatchPattern.ValueString(),
+			PromptCost:     v.PromptCost.ValueFloat64(),
+			CompletionCost: v.CompletionCost.ValueFloat64(),
+			Provider:       data.Provider.ValueStringPointer(),
+			StartTime:      v.StartTime.ValueStringPointer(),
+			MatchPath:      matchPath,
+		}
+
+		key := priceVersionKey(v.StartTime)
+		var result modelPriceMapAPIResponse
+		if e, ok := byKey[key]; ok {
+			delete(byKey, key)
+			if err := r.client.Put(ctx, "/api/v1/model-price-map/"+e.ID, body, &result); err != nil {
+				diags.AddError("Error updating model price map version", err.Error())
+				return
+			}
+			if result.ID == "" {
+				result.ID = e.ID
+			}
+		} else {
+			if err := r.client.Create(ctx, "/api/v1/model-price-map", body, &result); err != nil {
+				diags.AddError("Error creating model price map version", err.Error())
+				return
+			}
+		}
+		data.Versions[i].ID = types.StringValue(result.ID)
+	}
+
+	// Whatever's left in the ledger was dropped from the config, or shadowed
+	// by another entry with its start time.
+	leftovers := duplicates
+	for _, e := range byKey {
+		leftovers = append(leftovers, e)
+	}
+	for _, e := range leftovers {
+		if err := r.client.Delete(ctx, "/api/v1/model-price-map/"+e.ID); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error deleting model price map version", err.Error())
+			return
+		}
+	}
+
+	data.ID = types.StringValue(name)
+}
+
+// listModelPriceMapVersions collects every price map entry with the given
+// name, oldest start time first.
+func listModelPriceMapVersions(ctx context.Context, c *client.Client, name string) ([]modelPriceMapAPIResponse, error) {
+	all, err := client.GetAll[modelPriceMapAPIResponse](ctx, c, "/api/v1/model-price-map", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []modelPriceMapAPIResponse
+	for _, e := range all {
+		if e.Name == name {
+			entries = append(entries, e)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return priceVersionKey(types.StringPointerValue(entries[i].StartTime)) <
+			priceVersionKey(types.StringPointerValue(entries[j].StartTime))
+	})
+	return entries, nil
+}
+
+// mapModelPriceMapHistoryToState lays the API's entries into state. Shared
+// settings come from the newest version. Start times that name the same
+// instant as the prior state keep the user's spelling, so a reformatted
+// timestamp from the API isn't read as drift.
+func mapModelPriceMapHistoryToState(ctx context.Context, data *ModelPriceMapHistoryResourceModel, entries []modelPriceMapAPIResponse, diagnostics *diag.Diagnostics) {
+	prior := map[string]types.String{}
+	for _, v := range data.Versions {
+		prior[priceVersionKey(v.StartTime)] = v.StartTime
+	}
+
+	latest := entries[len(entries)-1]
+	data.ID = types.StringValue(latest.Name)
+	data.Name = types.StringValue(latest.Name)
+	data.MatchPattern = types.StringValue(latest.MatchPattern)
+	data.Provider = types.StringPointerValue(latest.Provider)
+
+	if len(latest.MatchPath) > 0 {
+		matchPathList, diags := types.ListValueFrom(ctx, types.StringType, latest.MatchPath)
+		diagnostics.Append(diags...)
+		data.MatchPath = matchPathList
+	} else {
+		data.MatchPath = types.ListNull(types.StringType)
+	}
+
+	versions := make([]modelPriceVersionModel, 0, len(entries))
+	for _, e := range entries {
+		startTime := types.StringPointerValue(e.StartTime)
+		if p, ok := prior[priceVersionKey(startTime)]; ok {
+			startTime = p
+		}
+		versions = append(versions, modelPriceVersionModel{
+			ID:             types.StringValue(e.ID),
+			StartTime:      startTime,
+			PromptCost:     types.Float64Value(e.PromptCost),
+			CompletionCost: types.Float64Value(e.CompletionCost),
+		})
+	}
+	data.Versions = versions
+}
+
+// priceStartTimeLayouts are the timestamp spellings we accept from users and
+// from the API, which sometimes leaves off the zone.
+var priceStartTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+func parsePriceStartTime(s string) (time.Time, bool) {
+	for _, layout := range priceStartTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// priceVersionKey turns a start time into a key that sorts chronologically
+// and matches however the instant is spelled. A missing start time sorts
+// first, as the price that was in effect from the beginning.
+func priceVersionKey(startTime types.String) string {
+	if startTime.IsNull() || startTime.IsUnknown() {
+		return ""
+	}
+	if t, ok := parsePriceStartTime(startTime.ValueString()); ok {
+		return t.Format("2006-01-02T15:04:05.000000000Z")
+	}
+	return startTime.ValueString()
+}
@@ -0,0 +1,261 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// testPriceMapServer keeps an in-memory price list behind the model price map
+// endpoints, and counts the writes made against it.
+type testPriceMapServer struct {
+	mu      sync.Mutex
+	entries map[string]modelPriceMapAPIResponse
+	nextID  int
+	posts   int
+	puts    int
+	deletes int
+}
+
+func (s *testPriceMapServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, "/api/v1/model-price-map/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/model-price-map":
+		list := []modelPriceMapAPIResponse{}
+		if r.URL.Query().Get("offset") == "0" {
+			for _, e := range s.entries {
+				list = append(list, e)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/model-price-map":
+		s.posts++
+		s.nextID++
+		e := s.decode(r)
+		e.ID = fmt.Sprintf("mp%d", s.nextID)
+		s.entries[e.ID] = e
+		_ = json.NewEncoder(w).Encode(e)
+	case r.Method == http.MethodPut:
+		s.puts++
+		e := s.decode(r)
+		e.ID = id
+		s.entries[id] = e
+		_ = json.NewEncoder(w).Encode(e)
+	case r.Method == http.MethodDelete:
+		s.deletes++
+		delete(s.entries, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *testPriceMapServer) decode(r *http.Request) modelPriceMapAPIResponse {
+	var body modelPriceMapAPIRequest
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	return modelPriceMapAPIResponse{
+		Name:           body.Name,
+		MatchPattern:   body.MatchPattern,
+		PromptCost:     body.PromptCost,
+		CompletionCost: body.CompletionCost,
+		Provider:       body.Provider,
+		StartTime:      body.StartTime,
+		MatchPath:      body.MatchPath,
+	}
+}
+
+func testPriceHistoryModel(versions ...modelPriceVersionModel) *ModelPriceMapHistoryResourceModel {
+	return &ModelPriceMapHistoryResourceModel{
+		ID:           types.StringValue("gpt-4o"),
+		Name:         types.StringValue("gpt-4o"),
+		MatchPattern: types.StringValue("gpt-4o.*"),
+		Provider:     types.StringValue("openai"),
+		MatchPath:    types.ListNull(types.StringType),
+		Versions:     versions,
+	}
+}
+
+// TestModelPriceMapHistoryResource_addVersion checks that adding a version to
+// the ledger creates just that entry, leaves the old price on the books, and
+// that a dropped version is cleared out.
+func TestModelPriceMapHistoryResource_addVersion(t *testing.T) {
+	srv := &testPriceMapServer{entries: map[string]modelPriceMapAPIResponse{}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	r := &ModelPriceMapHistoryResource{client: client.NewClient(ts.URL, "key", "")}
+	ctx := context.Background()
+
+	original := modelPriceVersionModel{
+		ID:             types.StringUnknown(),
+		StartTime:      types.StringNull(),
+		PromptCost:     types.Float64Value(0.000005),
+		CompletionCost: types.Float64Value(0.000015),
+	}
+	createPlan := testResourceState(t, r, testPriceHistoryModel(original))
+	createResp := &resource.CreateResponse{State: createPlan}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(createPlan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+
+	var created ModelPriceMapHistoryResourceModel
+	createResp.State.Get(ctx, &created)
+	if srv.posts != 1 || len(created.Versions) != 1 || created.Versions[0].ID.ValueString() != "mp1" {
+		t.Fatalf("expected one created version mp1, got %d posts and %+v", srv.posts, created.Versions)
+	}
+
+	// A price cut arrives: keep the original price, add the new one.
+	kept := created.Versions[0]
+	cut := modelPriceVersionModel{
+		ID:             types.StringUnknown(),
+		StartTime:      types.StringValue("2024-08-06T00:00:00Z"),
+		PromptCost:     types.Float64Value(0.0000025),
+		CompletionCost: types.Float64Value(0.00001),
+	}
+	updatePlan := testResourceState(t, r, testPriceHistoryModel(kept, cut))
+	updateResp := &resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(updatePlan), State: createResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("update: %v", updateResp.Diagnostics)
+	}
+
+	if srv.posts != 2 || srv.deletes != 0 {
+		t.Errorf("expected a single new entry and no deletes, got %d posts and %d deletes", srv.posts, srv.deletes)
+	}
+	if len(srv.entries) != 2 {
+		t.Fatalf("expected both versions on the books, got %v", srv.entries)
+	}
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", readResp.Diagnostics)
+	}
+	var read ModelPriceMapHistoryResourceModel
+	readResp.State.Get(ctx, &read)
+	if len(read.Versions) != 2 {
+		t.Fatalf("expected two versions, got %+v", read.Versions)
+	}
+	if read.Versions[0].ID.ValueString() != "mp1" || !read.Versions[0].StartTime.IsNull() {
+		t.Errorf("expected the original price first, got %+v", read.Versions[0])
+	}
+	if read.Versions[1].ID.ValueString() != "mp2" || read.Versions[1].PromptCost.ValueFloat64() != 0.0000025 {
+		t.Errorf("expected the price cut second, got %+v", read.Versions[1])
+	}
+
+	// Retire the original price; only it should be deleted.
+	retirePlan := testResourceState(t, r, testPriceHistoryModel(read.Versions[1]))
+	retireResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(retirePlan), State: readResp.State}, retireResp)
+	if retireResp.Diagnostics.HasError() {
+		t.Fatalf("update: %v", retireResp.Diagnostics)
+	}
+	if _, ok := srv.entries["mp1"]; ok || len(srv.entries) != 1 {
+		t.Errorf("expected only mp2 to remain, got %v", srv.entries)
+	}
+}
+
+// TestModelPriceMapHistoryResource_duplicateStartTimes checks two entries on
+// the books with the same start time draw a warning, and the one not kept
+// is deleted rather than left orphaned.
+func TestModelPriceMapHistoryResource_duplicateStartTimes(t *testing.T) {
+	dateOnly, full := "2024-08-06", "2024-08-06T00:00:00Z"
+	srv := &testPriceMapServer{entries: map[string]modelPriceMapAPIResponse{
+		"old1": {ID: "old1", Name: "gpt-4o", MatchPattern: "gpt-4o.*", StartTime: &dateOnly},
+		"old2": {ID: "old2", Name: "gpt-4o", MatchPattern: "gpt-4o.*", StartTime: &full},
+	}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	r := &ModelPriceMapHistoryResource{client: client.NewClient(ts.URL, "key", "")}
+	plan := testResourceState(t, r, testPriceHistoryModel(modelPriceVersionModel{
+		ID:             types.StringUnknown(),
+		StartTime:      types.StringValue(full),
+		PromptCost:     types.Float64Value(0.0000025),
+		CompletionCost: types.Float64Value(0.00001),
+	}))
+	resp := &resource.CreateResponse{State: plan}
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", resp.Diagnostics)
+	}
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "old1") || !strings.Contains(warnings[0].Detail(), "old2") {
+		t.Errorf("expected a warning naming both entries, got %v", resp.Diagnostics)
+	}
+	if len(srv.entries) != 1 || srv.puts != 1 || srv.deletes != 1 || srv.posts != 0 {
+		t.Errorf("expected one entry updated and its twin deleted, got %v (%d puts, %d deletes, %d posts)", srv.entries, srv.puts, srv.deletes, srv.posts)
+	}
+}
+
+// TestModelPriceMapHistoryResource_versionOrder checks versions must be listed
+// oldest first, with only the first allowed to skip its start time.
+func TestModelPriceMapHistoryResource_versionOrder(t *testing.T) {
+	versionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":              tftypes.String,
+		"start_time":      tftypes.String,
+		"prompt_cost":     tftypes.Number,
+		"completion_cost": tftypes.Number,
+	}}
+	version := func(startTime interface{}) tftypes.Value {
+		return tftypes.NewValue(versionType, map[string]tftypes.Value{
+			"id":              tftypes.NewValue(tftypes.String, nil),
+			"start_time":      tftypes.NewValue(tftypes.String, startTime),
+			"prompt_cost":     tftypes.NewValue(tftypes.Number, 0.000001),
+			"completion_cost": tftypes.NewValue(tftypes.Number, 0.000002),
+		})
+	}
+
+	cases := map[string]struct {
+		versions []tftypes.Value
+		wantErr  string
+	}{
+		"ordered":           {versions: []tftypes.Value{version(nil), version("2024-01-01T00:00:00Z"), version("2024-06-01T00:00:00Z")}},
+		"out of order":      {versions: []tftypes.Value{version("2024-06-01T00:00:00Z"), version("2024-01-01T00:00:00Z")}, wantErr: "Out Of Order"},
+		"duplicate":         {versions: []tftypes.Value{version("2024-01-01T00:00:00Z"), version("2024-01-01T00:00:00+00:00")}, wantErr: "Out Of Order"},
+		"late null":         {versions: []tftypes.Value{version("2024-01-01T00:00:00Z"), version(nil)}, wantErr: "Missing Start Time"},
+		"not a timestamp":   {versions: []tftypes.Value{version("last tuesday")}, wantErr: "Invalid Start Time"},
+		"space separated":   {versions: []tftypes.Value{version("2024-01-01 00:00:00")}, wantErr: "Invalid Start Time"},
+		"date only is fine": {versions: []tftypes.Value{version("2024-01-01"), version("2024-02-01T00:00:00Z")}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_model_price_map_history", map[string]tftypes.Value{
+				"name":          tftypes.NewValue(tftypes.String, "gpt-4o"),
+				"match_pattern": tftypes.NewValue(tftypes.String, "gpt-4o.*"),
+				"versions":      tftypes.NewValue(tftypes.List{ElementType: versionType}, tc.versions),
+			})
+			if tc.wantErr == "" {
+				if len(diags) != 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
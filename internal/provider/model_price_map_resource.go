@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -52,6 +53,7 @@ type ModelPriceMapResourceModel struct {
 	MatchPath             types.List    `tfsdk:"match_path"`
 	PromptCostDetails     types.String  `tfsdk:"prompt_cost_details"`
 	CompletionCostDetails types.String  `tfsdk:"completion_cost_details"`
+	WorkspaceID           types.String  `tfsdk:"workspace_id"`
 }
 
 // modelPriceMapAPIRequest is the request body for creating/updating a model price map.
@@ -88,6 +90,7 @@ func (r *ModelPriceMapResource) Schema(ctx context.Context, req resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith model price map entry for tracking costs of LLM usage.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the model price map entry.",
 				Computed:            true,
@@ -116,8 +119,13 @@ func (r *ModelPriceMapResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 			},
 			"start_time": schema.StringAttribute{
-				MarkdownDescription: "The effective start time for this price map entry.",
-				Optional:            true,
+				MarkdownDescription: "The effective start time for this price map entry. The API keeps one entry per start time, so changing it " +
+					"replaces the entry rather than moving the old price to a new date. Replacement deletes the old entry; to keep earlier " +
+					"prices for cost backfills, give each start time its own resource, or manage them together with `langsmith_model_price_map_history`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"match_path": schema.ListAttribute{
 				MarkdownDescription: "Paths to match for model identification. Defaults to `[\"model\", \"model_name\", \"model_id\", \"model_path\", \"endpoint_name\"]`.",
@@ -127,10 +135,16 @@ func (r *ModelPriceMapResource) Schema(ctx context.Context, req resource.SchemaR
 			"prompt_cost_details": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded cost details object for prompt tokens — the fine print on what you owe.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"completion_cost_details": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded cost details object for completion tokens — every last cent accounted for.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 		},
 	}
@@ -141,16 +155,16 @@ func (r *ModelPriceMapResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *ModelPriceMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -160,6 +174,8 @@ func (r *ModelPriceMapResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := modelPriceMapAPIRequest{
 		Name:           data.Name.ValueString(),
 		MatchPattern:   data.MatchPattern.ValueString(),
@@ -192,7 +208,7 @@ func (r *ModelPriceMapResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	var result modelPriceMapAPIResponse
-	err := r.client.Post(ctx, "/api/v1/model-price-map", body, &result)
+	err := r.client.Create(ctx, "/api/v1/model-price-map", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating model price map", err.Error())
 		return
@@ -211,8 +227,9 @@ func (r *ModelPriceMapResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	var results []modelPriceMapAPIResponse
-	err := r.client.Get(ctx, "/api/v1/model-price-map", nil, &results)
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	results, err := client.GetAll[modelPriceMapAPIResponse](ctx, r.client, "/api/v1/model-price-map", nil)
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -247,6 +264,8 @@ func (r *ModelPriceMapResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := modelPriceMapAPIRequest{
 		Name:           data.Name.ValueString(),
 		MatchPattern:   data.MatchPattern.ValueString(),
@@ -298,6 +317,8 @@ func (r *ModelPriceMapResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/model-price-map/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting model price map", err.Error())
@@ -307,8 +328,43 @@ func (r *ModelPriceMapResource) Delete(ctx context.Context, req resource.DeleteR
 	tflog.Trace(ctx, "deleted model price map resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+// ImportState takes an entry's ID, or `name:<model name>` to look the entry
+// up by name. When several entries share the name, one per start time, the
+// newest is taken and a warning names the others.
 func (r *ModelPriceMapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	name, byName := strings.CutPrefix(req.ID, "name:")
+	if !byName {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	entries, err := listModelPriceMapVersions(ctx, r.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing model price map", err.Error())
+		return
+	}
+	if len(entries) == 0 {
+		resp.Diagnostics.AddError(
+			"Error importing model price map",
+			fmt.Sprintf("no model price map entry found with name %q", name),
+		)
+		return
+	}
+
+	newest := entries[len(entries)-1]
+	if len(entries) > 1 {
+		others := make([]string, 0, len(entries)-1)
+		for _, e := range entries[:len(entries)-1] {
+			others = append(others, e.ID)
+		}
+		resp.Diagnostics.AddWarning(
+			"Several Model Price Map Entries Share a Name",
+			fmt.Sprintf("%d entries are named %q, one per start time. Imported the newest, %s; the others (%s) can be imported by ID, "+
+				"or managed together with langsmith_model_price_map_history.", len(entries), name, newest.ID, strings.Join(others, ", ")),
+		)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), newest.ID)...)
 }
 
 // mapModelPriceMapResponseToState settles up the API response into Terraform state,
@@ -326,10 +382,13 @@ func mapModelPriceMapResponseToState(ctx context.Context, data *ModelPriceMapRes
 		data.Provider = types.StringNull()
 	}
 
-	if result.StartTime != nil {
-		data.StartTime = types.StringValue(*result.StartTime)
-	} else {
+	// A start time the API spells differently but that names the same instant
+	// keeps the configured spelling; otherwise it would be read as a change
+	// and force a replacement.
+	if result.StartTime == nil {
 		data.StartTime = types.StringNull()
+	} else if priceVersionKey(data.StartTime) != priceVersionKey(types.StringPointerValue(result.StartTime)) || data.StartTime.IsNull() {
+		data.StartTime = types.StringValue(*result.StartTime)
 	}
 
 	if len(result.MatchPath) > 0 {
@@ -0,0 +1,108 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestModelPriceMapResource_importByName checks a `name:` import finds the
+// entry with that name, taking the newest start time with a warning when
+// there are several, and that anything else imports as an ID.
+func TestModelPriceMapResource_importByName(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/model-price-map" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if r.URL.Query().Get("offset") != "0" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": "p1", "name": "gpt-4o", "start_time": "2025-06-01T00:00:00Z"},
+			{"id": "p2", "name": "gpt-4o", "start_time": "2025-09-01T00:00:00Z"},
+			{"id": "p3", "name": "gpt-4o", "start_time": null},
+			{"id": "p4", "name": "claude", "start_time": null}
+		]`))
+	}))
+	defer ts.Close()
+
+	cases := map[string]struct {
+		importID string
+		wantID   string
+		wantWarn bool
+		wantErr  string
+	}{
+		"by name":      {importID: "name:claude", wantID: "p4"},
+		"newest":       {importID: "name:gpt-4o", wantID: "p2", wantWarn: true},
+		"by ID":        {importID: "p3", wantID: "p3"},
+		"unknown name": {importID: "name:llama", wantErr: "no model price map entry found"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &ModelPriceMapResource{client: client.NewClient(ts.URL, "key", "")}
+			state := testResourceState(t, r, &ModelPriceMapResourceModel{MatchPath: types.ListNull(types.StringType)})
+			resp := &resource.ImportStateResponse{State: state}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: tc.importID}, resp)
+
+			if tc.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), tc.wantErr) {
+					t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("importing: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.wantWarn {
+				t.Errorf("expected warning %v, got %v", tc.wantWarn, resp.Diagnostics)
+			}
+
+			var id types.String
+			resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+			if id.ValueString() != tc.wantID {
+				t.Errorf("expected ID %q, got %s", tc.wantID, id)
+			}
+		})
+	}
+}
+
+// TestMapModelPriceMapResponseToState_startTime checks a start time the API
+// reformats keeps its configured spelling, so it doesn't force a replacement.
+func TestMapModelPriceMapResponseToState_startTime(t *testing.T) {
+	june, reformatted, july := "2025-06-01T00:00:00Z", "2025-06-01T00:00:00.000000", "2025-07-01T00:00:00Z"
+	cases := map[string]struct {
+		prior    types.String
+		reported *string
+		want     types.String
+	}{
+		"same instant": {prior: types.StringValue("2025-06-01T00:00:00Z"), reported: &reformatted, want: types.StringValue("2025-06-01T00:00:00Z")},
+		"moved":        {prior: types.StringValue("2025-06-01T00:00:00Z"), reported: &july, want: types.StringValue("2025-07-01T00:00:00Z")},
+		"imported":     {prior: types.StringNull(), reported: &june, want: types.StringValue("2025-06-01T00:00:00Z")},
+		"none":         {prior: types.StringValue("2025-06-01T00:00:00Z"), want: types.StringNull()},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := &ModelPriceMapResourceModel{StartTime: tc.prior}
+			var diags diag.Diagnostics
+			mapModelPriceMapResponseToState(context.Background(), data, &modelPriceMapAPIResponse{ID: "p1", StartTime: tc.reported}, &diags)
+			if !data.StartTime.Equal(tc.want) {
+				t.Errorf("expected start_time %s, got %s", tc.want, data.StartTime)
+			}
+		})
+	}
+}
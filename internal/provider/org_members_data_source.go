@@ -0,0 +1,148 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &OrgMembersDataSource{}
+
+// NewOrgMembersDataSource returns a new OrgMembersDataSource, for calling
+// roll on everyone who rides for the organization.
+func NewOrgMembersDataSource() datasource.DataSource {
+	return &OrgMembersDataSource{}
+}
+
+// OrgMembersDataSource lists the organization's members and the role each
+// holds, across every workspace, for auditing who has organization-level
+// access.
+type OrgMembersDataSource struct {
+	client *client.Client
+}
+
+// OrgMembersDataSourceModel holds the role filter and the members found.
+type OrgMembersDataSourceModel struct {
+	RoleID  types.String          `tfsdk:"role_id"`
+	Members []orgMemberEntryModel `tfsdk:"members"`
+}
+
+// orgMemberEntryModel is one member of the organization.
+type orgMemberEntryModel struct {
+	UserID   types.String `tfsdk:"user_id"`
+	Email    types.String `tfsdk:"email"`
+	FullName types.String `tfsdk:"full_name"`
+	RoleID   types.String `tfsdk:"role_id"`
+}
+
+func (d *OrgMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_members"
+}
+
+func (d *OrgMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the organization's members and their organization roles. Pending invites aren't included; see `langsmith_organization_invite`.",
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "Only return members holding this organization role.",
+				Optional:            true,
+				Validators:          []validator.String{validUUID()},
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "The members found, in the order the API lists them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "The member's user ID.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The member's email address.",
+							Computed:            true,
+						},
+						"full_name": schema.StringAttribute{
+							MarkdownDescription: "The member's full name, when they've given one.",
+							Computed:            true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the member's organization role.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrgMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *OrgMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrgMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := listOrganizationMembers(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization members", err.Error())
+		return
+	}
+
+	filtered := !data.RoleID.IsNull()
+	data.Members = make([]orgMemberEntryModel, 0, len(members))
+	for _, m := range members {
+		if filtered && m.RoleID != data.RoleID.ValueString() {
+			continue
+		}
+		fullName := types.StringNull()
+		if m.FullName != "" {
+			fullName = types.StringValue(m.FullName)
+		}
+		data.Members = append(data.Members, orgMemberEntryModel{
+			UserID:   types.StringValue(m.UserID),
+			Email:    types.StringValue(m.Email),
+			FullName: fullName,
+			RoleID:   types.StringValue(m.RoleID),
+		})
+	}
+
+	tflog.Trace(ctx, "read org members data source", map[string]interface{}{"members": len(data.Members)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listOrganizationMembers pages through the organization's roster, keeping
+// the members who've accepted their invites.
+func listOrganizationMembers(ctx context.Context, c *client.Client) ([]organizationMemberAPIResponse, error) {
+	return client.GetAllIn(ctx, c, "/api/v1/orgs/current/members", nil, func(roster *organizationMembersAPIResponse) []organizationMemberAPIResponse {
+		return roster.Members
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestOrgMembersDataSource_read checks every page of the roster is read,
+// pending invites are left out, and role_id narrows the list.
+func TestOrgMembersDataSource_read(t *testing.T) {
+	const admin, viewer = "11111111-1111-4111-8111-111111111111", "22222222-2222-4222-8222-222222222222"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/orgs/current/members" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var members []string
+		for i := offset; i < client.DefaultPageSize+1 && i < offset+client.DefaultPageSize; i++ {
+			role, name := viewer, ""
+			if i == 0 || i == client.DefaultPageSize {
+				role, name = admin, fmt.Sprintf("Admin %d", i)
+			}
+			members = append(members, fmt.Sprintf(`{"id": "m%d", "user_id": "u%d", "email": "u%d@example.com", "full_name": %q, "role_id": %q}`, i, i, i, name, role))
+		}
+		_, _ = w.Write([]byte(`{"members": [` + strings.Join(members, ",") + `], "pending": [{"id": "p1", "email": "new@example.com", "role_id": "` + admin + `"}]}`))
+	}))
+	defer srv.Close()
+
+	d := &OrgMembersDataSource{client: client.NewClient(srv.URL, "key", "")}
+
+	resp := testDataSourceRead(t, d, &OrgMembersDataSourceModel{RoleID: types.StringNull()})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", resp.Diagnostics)
+	}
+	var got OrgMembersDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if len(got.Members) != client.DefaultPageSize+1 || !got.Members[1].FullName.IsNull() {
+		t.Fatalf("expected every member across both pages, got %d", len(got.Members))
+	}
+
+	resp = testDataSourceRead(t, d, &OrgMembersDataSourceModel{RoleID: types.StringValue(admin)})
+	resp.State.Get(context.Background(), &got)
+	if len(got.Members) != 2 || got.Members[1].UserID.ValueString() != fmt.Sprintf("u%d", client.DefaultPageSize) ||
+		got.Members[0].FullName.ValueString() != "Admin 0" || got.Members[0].Email.ValueString() != "u0@example.com" {
+		t.Errorf("expected the two admins, got %+v", got.Members)
+	}
+}
@@ -7,12 +7,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -20,8 +28,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &OrgRoleResource{}
-	_ resource.ResourceWithImportState = &OrgRoleResource{}
+	_ resource.Resource                     = &OrgRoleResource{}
+	_ resource.ResourceWithImportState      = &OrgRoleResource{}
+	_ resource.ResourceWithConfigValidators = &OrgRoleResource{}
+	_ resource.ResourceWithModifyPlan       = &OrgRoleResource{}
 )
 
 // NewOrgRoleResource returns a new OrgRoleResource, ready to pin a badge
@@ -42,6 +52,7 @@ type OrgRoleResourceModel struct {
 	DisplayName    types.String `tfsdk:"display_name"`
 	Description    types.String `tfsdk:"description"`
 	Permissions    types.String `tfsdk:"permissions"`
+	Permission     types.Set    `tfsdk:"permission"`
 	Name           types.String `tfsdk:"name"`
 	OrganizationID types.String `tfsdk:"organization_id"`
 	AccessScope    types.String `tfsdk:"access_scope"`
@@ -100,8 +111,23 @@ func (r *OrgRoleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"permissions": schema.StringAttribute{
-				MarkdownDescription: "JSON-encoded array of permissions assigned to the role.",
-				Required:            true,
+				MarkdownDescription: "JSON-encoded array of permissions assigned to the role. " +
+					"Deprecated: use `permission` instead. Always reflects the permissions the API holds, however they were configured.",
+				DeprecationMessage: "Use the permission attribute instead. The permissions attribute will be removed in a future major version.",
+				Optional:           true,
+				Computed:           true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
+			},
+			"permission": schema.SetAttribute{
+				MarkdownDescription: "The permissions granted by the role, such as `projects:read`. Each is checked against the organization's permission catalog at plan time; the `langsmith_organization_role` data source lists them. Exactly one of `permission` or `permissions` must be set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The internal name of the role.",
@@ -124,16 +150,16 @@ func (r *OrgRoleResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *OrgRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -145,7 +171,7 @@ func (r *OrgRoleResource) Create(ctx context.Context, req resource.CreateRequest
 
 	body := orgRoleCreateRequest{
 		DisplayName: data.DisplayName.ValueString(),
-		Permissions: json.RawMessage(data.Permissions.ValueString()),
+		Permissions: orgRolePermissionsJSON(&data),
 	}
 
 	if !data.Description.IsNull() && !data.Description.IsUnknown() {
@@ -154,13 +180,16 @@ func (r *OrgRoleResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var result orgRoleAPIResponse
-	err := r.client.Post(ctx, "/api/v1/orgs/current/roles", body, &result)
+	err := r.client.Create(ctx, "/api/v1/orgs/current/roles", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating organization role", err.Error())
 		return
 	}
 
+	// permission isn't computed; keep it as planned so apply stays consistent.
+	permission := data.Permission
 	mapOrgRoleResponseToState(&data, &result)
+	data.Permission = permission
 	tflog.Trace(ctx, "created organization role resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -210,7 +239,7 @@ func (r *OrgRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	body := orgRoleUpdateRequest{
 		DisplayName: data.DisplayName.ValueString(),
-		Permissions: json.RawMessage(data.Permissions.ValueString()),
+		Permissions: orgRolePermissionsJSON(&data),
 	}
 
 	if !data.Description.IsNull() && !data.Description.IsUnknown() {
@@ -225,7 +254,10 @@ func (r *OrgRoleResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// permission isn't computed; keep it as planned so apply stays consistent.
+	permission := data.Permission
 	mapOrgRoleResponseToState(&data, &result)
+	data.Permission = permission
 	tflog.Trace(ctx, "updated organization role resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -247,6 +279,79 @@ func (r *OrgRoleResource) Delete(ctx context.Context, req resource.DeleteRequest
 	tflog.Trace(ctx, "deleted organization role resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+func (r *OrgRoleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("permission"), path.MatchRoot("permissions")),
+	}
+}
+
+// ModifyPlan checks each permission against the organization's catalog, so a
+// typo shows up in the plan instead of as an API error halfway through apply.
+func (r *OrgRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var permissions types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permission"), &permissions)...)
+	if resp.Diagnostics.HasError() || permissions.IsNull() || permissions.IsUnknown() {
+		return
+	}
+
+	checkRolePermissions(ctx, r.client, path.Root("permission"), orgRolePermissionNames(permissions), "", &resp.Diagnostics)
+}
+
+// checkRolePermissions checks role permissions against the organization's
+// catalog, and, when scope is given, that each can be granted at that scope.
+// When the catalog can't be read, the check is skipped with a warning.
+func checkRolePermissions(ctx context.Context, c *client.Client, at path.Path, names []string, scope string, diags *diag.Diagnostics) {
+	catalog, _, err := readPermissionCatalog(ctx, c)
+	if err != nil {
+		diags.AddAttributeWarning(
+			at,
+			"Permissions Not Checked",
+			fmt.Sprintf("The permission catalog couldn't be read, so the role's permissions weren't checked before apply: %s", err),
+		)
+		return
+	}
+	// A catalog pieced together from the built-in roles might not be
+	// complete, and an empty one has nothing to say.
+	if len(catalog) == 0 {
+		return
+	}
+
+	known := make(map[string]*string, len(catalog))
+	for _, p := range catalog {
+		known[p.Name] = p.AccessScope
+	}
+
+	var unknown, outOfScope []string
+	for _, name := range names {
+		permissionScope, ok := known[name]
+		switch {
+		case !ok:
+			unknown = append(unknown, fmt.Sprintf("%q", name))
+		case scope != "" && permissionScope != nil && *permissionScope != scope:
+			outOfScope = append(outOfScope, fmt.Sprintf("%q (%s)", name, *permissionScope))
+		}
+	}
+	if len(unknown) > 0 {
+		diags.AddAttributeError(
+			at,
+			"Unknown Permission",
+			fmt.Sprintf("The organization has no permission named %s. The langsmith_organization_role data source lists the permissions that can be granted.",
+				strings.Join(unknown, ", ")),
+		)
+	}
+	if len(outOfScope) > 0 {
+		diags.AddAttributeError(
+			at,
+			"Permission Out Of Scope",
+			fmt.Sprintf("Only %s-scoped permissions can be granted here. These belong to another scope: %s.", scope, strings.Join(outOfScope, ", ")),
+		)
+	}
+}
+
 func (r *OrgRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -267,9 +372,60 @@ func mapOrgRoleResponseToState(data *OrgRoleResourceModel, result *orgRoleAPIRes
 		data.Description = types.StringNull()
 	}
 
+	// The typed set is refreshed when it's in use, or on import, when nothing
+	// is known yet. A role still on the deprecated JSON leaves it null.
+	if !data.Permission.IsNull() || data.Permissions.IsNull() {
+		if names, err := permissionNames(result.Permissions); err == nil {
+			data.Permission = orgRolePermissionSet(names)
+		}
+	}
+
 	if len(result.Permissions) > 0 && string(result.Permissions) != "null" {
 		data.Permissions = types.StringValue(string(result.Permissions))
 	} else {
 		data.Permissions = types.StringNull()
 	}
 }
+
+// orgRolePermissionsJSON picks the permissions to send: the typed set, sorted
+// so the request doesn't shuffle between runs, or the deprecated JSON as
+// given.
+func orgRolePermissionsJSON(data *OrgRoleResourceModel) json.RawMessage {
+	if !data.Permission.IsNull() && !data.Permission.IsUnknown() {
+		// A list of strings always marshals.
+		raw, _ := json.Marshal(orgRolePermissionNames(data.Permission))
+		return raw
+	}
+	return json.RawMessage(data.Permissions.ValueString())
+}
+
+// orgRolePermissionNames lists the known permissions in a set, sorted.
+func orgRolePermissionNames(set types.Set) []string {
+	names := make([]string, 0, len(set.Elements()))
+	for _, v := range set.Elements() {
+		if str, ok := v.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
+			names = append(names, str.ValueString())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// orgRolePermissionSet builds the permission set from the API's names, null
+// when the role grants nothing.
+func orgRolePermissionSet(names []string) types.Set {
+	if len(names) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+
+	elems := make([]attr.Value, 0, len(sorted))
+	for i, name := range sorted {
+		if i > 0 && name == sorted[i-1] {
+			continue
+		}
+		elems = append(elems, types.StringValue(name))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
@@ -4,7 +4,18 @@
 package provider
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // TestAccOrgRoleResource_basic pins a badge on a new role and makes sure
@@ -15,3 +26,133 @@ import (
 func TestAccOrgRoleResource_basic(t *testing.T) {
 	t.Skip("Requires organization:manage permission (enterprise tier)")
 }
+
+// testOrgRolePermissions builds a permission set from the given names.
+func testOrgRolePermissions(names ...string) types.Set {
+	elems := make([]attr.Value, 0, len(names))
+	for _, name := range names {
+		elems = append(elems, types.StringValue(name))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// TestOrgRoleResource_permissionConfig checks a role's permissions are given
+// exactly one way: the typed set or the deprecated JSON.
+func TestOrgRoleResource_permissionConfig(t *testing.T) {
+	permissionSet := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "projects:read"),
+	})
+
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr bool
+	}{
+		"typed set": {
+			attrs: map[string]tftypes.Value{"permission": permissionSet},
+		},
+		"deprecated json": {
+			attrs: map[string]tftypes.Value{"permissions": tftypes.NewValue(tftypes.String, `["projects:read"]`)},
+		},
+		"both": {
+			attrs: map[string]tftypes.Value{
+				"permission":  permissionSet,
+				"permissions": tftypes.NewValue(tftypes.String, `["projects:read"]`),
+			},
+			wantErr: true,
+		},
+		"neither": {
+			attrs:   map[string]tftypes.Value{},
+			wantErr: true,
+		},
+		"empty set": {
+			attrs:   map[string]tftypes.Value{"permission": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{})},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["display_name"] = tftypes.NewValue(tftypes.String, "deputy")
+			diags := testValidateResourceConfig(t, "langsmith_org_role", tc.attrs)
+			if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, ""); got != tc.wantErr {
+				t.Errorf("expected error=%t, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestOrgRoleResource_permissionCatalog checks permissions missing from the
+// organization's catalog fail the plan, and that a catalog we can't read
+// only draws a warning.
+func TestOrgRoleResource_permissionCatalog(t *testing.T) {
+	catalogUp := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/orgs/permissions" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !catalogUp {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"name": "projects:read"}, {"name": "datasets:read"}, {"name": "datasets:create"}]`))
+	}))
+	defer ts.Close()
+
+	r := &OrgRoleResource{client: client.NewClient(ts.URL, "key", "")}
+	plan := func(permissions types.Set) *resource.ModifyPlanResponse {
+		return testModifyPlan(t, r, &OrgRoleResourceModel{
+			ID:             types.StringUnknown(),
+			DisplayName:    types.StringValue("deputy"),
+			Description:    types.StringNull(),
+			Permissions:    types.StringUnknown(),
+			Permission:     permissions,
+			Name:           types.StringUnknown(),
+			OrganizationID: types.StringUnknown(),
+			AccessScope:    types.StringUnknown(),
+		})
+	}
+
+	if resp := plan(testOrgRolePermissions("projects:read", "datasets:create")); resp.Diagnostics.HasError() {
+		t.Errorf("expected known permissions to plan, got %v", resp.Diagnostics)
+	}
+
+	resp := plan(testOrgRolePermissions("projects:read", "datasets:burn", "projects:raed"))
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Unknown Permission" {
+		t.Fatalf("expected an unknown permission error, got %v", resp.Diagnostics)
+	}
+	if want := `The organization has no permission named "datasets:burn", "projects:raed". The langsmith_organization_role data source lists the permissions that can be granted.`; resp.Diagnostics[0].Detail() != want {
+		t.Errorf("unexpected detail %q", resp.Diagnostics[0].Detail())
+	}
+
+	catalogUp = false
+	resp = plan(testOrgRolePermissions("projects:raed"))
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected only a warning when the catalog can't be read, got %v", resp.Diagnostics)
+	}
+}
+
+// TestOrgRoleResource_permissionMapping checks permissions are sent sorted and
+// read back into the typed set, whichever shape the API uses, while a role
+// on the deprecated JSON keeps its set null.
+func TestOrgRoleResource_permissionMapping(t *testing.T) {
+	typed := OrgRoleResourceModel{Permission: testOrgRolePermissions("workspaces:manage", "projects:read")}
+	if got := string(orgRolePermissionsJSON(&typed)); got != `["projects:read","workspaces:manage"]` {
+		t.Errorf("unexpected permissions JSON %s", got)
+	}
+
+	for _, raw := range []string{`["workspaces:manage", "projects:read"]`, `[{"name": "projects:read"}, {"name": "workspaces:manage"}]`} {
+		data := OrgRoleResourceModel{Permission: types.SetNull(types.StringType), Permissions: types.StringNull()}
+		mapOrgRoleResponseToState(&data, &orgRoleAPIResponse{ID: "role1", Permissions: json.RawMessage(raw)})
+		if !data.Permission.Equal(testOrgRolePermissions("projects:read", "workspaces:manage")) {
+			t.Errorf("expected %s to be read into the set, got %s", raw, data.Permission)
+		}
+	}
+
+	legacy := OrgRoleResourceModel{Permission: types.SetNull(types.StringType), Permissions: types.StringValue(`["projects:read"]`)}
+	mapOrgRoleResponseToState(&legacy, &orgRoleAPIResponse{ID: "role1", Permissions: json.RawMessage(`["projects:read"]`)})
+	if !legacy.Permission.IsNull() || legacy.Permissions.ValueString() != `["projects:read"]` {
+		t.Errorf("expected a role on the deprecated JSON to keep it, got %+v", legacy)
+	}
+}
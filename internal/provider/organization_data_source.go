@@ -31,8 +31,8 @@ type OrganizationDataSource struct {
 }
 
 // OrganizationDataSourceModel holds the read-only attributes for the current org:
-// display name, whether it is a personal account, the plan tier, and how much
-// room is left on the ranch.
+// display name, whether it is a personal account, the plan tier, how much
+// room is left on the ranch, and which features the plan lets it use.
 type OrganizationDataSourceModel struct {
 	ID                   types.String `tfsdk:"id"`
 	DisplayName          types.String `tfsdk:"display_name"`
@@ -41,6 +41,10 @@ type OrganizationDataSourceModel struct {
 	Tier                 types.String `tfsdk:"tier"`
 	ReachedMaxWorkspaces types.Bool   `tfsdk:"reached_max_workspaces"`
 	Disabled             types.Bool   `tfsdk:"disabled"`
+	MaxWorkspaces        types.Int64  `tfsdk:"max_workspaces"`
+	SSOEnabled           types.Bool   `tfsdk:"sso_enabled"`
+	RBACEnabled          types.Bool   `tfsdk:"rbac_enabled"`
+	BulkExportEnabled    types.Bool   `tfsdk:"bulk_export_enabled"`
 }
 
 // orgDataSourceAPIResponse is the API response for the org endpoint.
@@ -52,6 +56,13 @@ type orgDataSourceAPIResponse struct {
 	Tier                 string  `json:"tier"`
 	ReachedMaxWorkspaces *bool   `json:"reached_max_workspaces"`
 	Disabled             *bool   `json:"disabled"`
+
+	Config struct {
+		MaxWorkspaces    *int64 `json:"max_workspaces"`
+		CanUseSAMLSSO    *bool  `json:"can_use_saml_sso"`
+		CanUseRBAC       *bool  `json:"can_use_rbac"`
+		CanUseBulkExport *bool  `json:"can_use_bulk_export"`
+	} `json:"config"`
 }
 
 func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,7 +71,7 @@ func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.Me
 
 func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Use this data source to retrieve information about the current LangSmith organization.",
+		MarkdownDescription: "Use this data source to retrieve information about the current LangSmith organization, including its plan tier and the features the plan allows, for config that depends on them.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the organization.",
@@ -90,6 +101,22 @@ func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "Whether the organization is disabled.",
 				Computed:            true,
 			},
+			"max_workspaces": schema.Int64Attribute{
+				MarkdownDescription: "The most workspaces the organization's plan allows.",
+				Computed:            true,
+			},
+			"sso_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the organization's plan allows SAML single sign-on, and with it `langsmith_sso_settings`.",
+				Computed:            true,
+			},
+			"rbac_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the organization's plan allows custom roles, and with them `langsmith_org_role` and `langsmith_workspace_role`.",
+				Computed:            true,
+			},
+			"bulk_export_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the organization's plan allows bulk exports.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -99,16 +126,16 @@ func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = c
+	d.client = data.client
 }
 
 func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -149,6 +176,11 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		data.Disabled = types.BoolNull()
 	}
 
+	data.MaxWorkspaces = types.Int64PointerValue(result.Config.MaxWorkspaces)
+	data.SSOEnabled = types.BoolPointerValue(result.Config.CanUseSAMLSSO)
+	data.RBACEnabled = types.BoolPointerValue(result.Config.CanUseRBAC)
+	data.BulkExportEnabled = types.BoolPointerValue(result.Config.CanUseBulkExport)
+
 	tflog.Trace(ctx, "read organization data source", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
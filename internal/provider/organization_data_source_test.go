@@ -4,9 +4,14 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // TestAccOrganizationDataSource_basic verifies the organization data source
@@ -27,3 +32,57 @@ func TestAccOrganizationDataSource_basic(t *testing.T) {
 		},
 	})
 }
+
+// TestOrganizationDataSource_read checks the org's details and plan features
+// are read, and that features the API keeps quiet about come back null.
+func TestOrganizationDataSource_read(t *testing.T) {
+	cases := map[string]struct {
+		body  string
+		check func(t *testing.T, got OrganizationDataSourceModel)
+	}{
+		"enterprise": {
+			body: `{"id": "o1", "display_name": "Dodge City", "is_personal": false, "tier": "enterprise", "reached_max_workspaces": false, "disabled": false,
+				"config": {"max_workspaces": 25, "can_use_saml_sso": true, "can_use_rbac": true, "can_use_bulk_export": false}}`,
+			check: func(t *testing.T, got OrganizationDataSourceModel) {
+				if got.ID.ValueString() != "o1" || got.DisplayName.ValueString() != "Dodge City" || got.Tier.ValueString() != "enterprise" {
+					t.Errorf("unexpected organization %+v", got)
+				}
+				if got.MaxWorkspaces.ValueInt64() != 25 || !got.SSOEnabled.ValueBool() || !got.RBACEnabled.ValueBool() || got.BulkExportEnabled.ValueBool() {
+					t.Errorf("unexpected features %+v", got)
+				}
+			},
+		},
+		"no config": {
+			body: `{"id": "o2", "display_name": "Long Branch", "is_personal": true, "tier": "free"}`,
+			check: func(t *testing.T, got OrganizationDataSourceModel) {
+				if !got.MaxWorkspaces.IsNull() || !got.SSOEnabled.IsNull() || !got.RBACEnabled.IsNull() || !got.BulkExportEnabled.IsNull() {
+					t.Errorf("expected null features, got %+v", got)
+				}
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/orgs/current" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			d := &OrganizationDataSource{client: client.NewClient(srv.URL, "key", "")}
+			resp := testDataSourceRead(t, d, &OrganizationDataSourceModel{})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading: %v", resp.Diagnostics)
+			}
+
+			var got OrganizationDataSourceModel
+			resp.State.Get(context.Background(), &got)
+			tc.check(t, got)
+		})
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &OrganizationInviteResource{}
+	_ resource.ResourceWithImportState = &OrganizationInviteResource{}
+)
+
+const (
+	// organizationInvitePending is the status of an invite still waiting on
+	// an answer.
+	organizationInvitePending = "pending"
+
+	// organizationInviteAccepted is the status of an invite that's been
+	// taken up, once retain_on_accept keeps it around.
+	organizationInviteAccepted = "accepted"
+)
+
+// NewOrganizationInviteResource returns a new OrganizationInviteResource,
+// ready to send word down the trail to a new hand.
+func NewOrganizationInviteResource() resource.Resource {
+	return &OrganizationInviteResource{}
+}
+
+// OrganizationInviteResource manages a pending invitation to the LangSmith
+// organization. It's how a rider who hasn't shown up in town yet, and so has
+// no user_id, gets asked to join the outfit.
+type OrganizationInviteResource struct {
+	client *client.Client
+}
+
+// OrganizationInviteResourceModel describes the Terraform state for an
+// organization invite.
+type OrganizationInviteResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Email          types.String `tfsdk:"email"`
+	RoleID         types.String `tfsdk:"role_id"`
+	RetainOnAccept types.Bool   `tfsdk:"retain_on_accept"`
+	Status         types.String `tfsdk:"status"`
+	UserID         types.String `tfsdk:"user_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// organizationInviteCreateRequest is the invitation itself.
+type organizationInviteCreateRequest struct {
+	Email  string `json:"email"`
+	RoleID string `json:"role_id"`
+}
+
+// organizationMemberAPIResponse is one entry on the organization's roster,
+// whether the invite is still pending or long since accepted.
+type organizationMemberAPIResponse struct {
+	ID        string `json:"id"`
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	FullName  string `json:"full_name"`
+	RoleID    string `json:"role_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+// organizationMembersAPIResponse is the organization's roster: those who've
+// signed on, and those who've yet to answer.
+type organizationMembersAPIResponse struct {
+	Members []organizationMemberAPIResponse `json:"members"`
+	Pending []organizationMemberAPIResponse `json:"pending"`
+}
+
+// organizationRosterEntry is one name on the roster, flagged by which list
+// it came off of.
+type organizationRosterEntry struct {
+	organizationMemberAPIResponse
+	pending bool
+}
+
+// listOrganizationRoster pages through the organization's roster, keeping
+// the pending invites along with the members who've accepted theirs.
+func listOrganizationRoster(ctx context.Context, c *client.Client) ([]organizationRosterEntry, error) {
+	return client.GetAllIn(ctx, c, "/api/v1/orgs/current/members", nil, func(roster *organizationMembersAPIResponse) []organizationRosterEntry {
+		entries := make([]organizationRosterEntry, 0, len(roster.Pending)+len(roster.Members))
+		for _, invite := range roster.Pending {
+			entries = append(entries, organizationRosterEntry{organizationMemberAPIResponse: invite, pending: true})
+		}
+		for _, member := range roster.Members {
+			entries = append(entries, organizationRosterEntry{organizationMemberAPIResponse: member})
+		}
+		return entries
+	})
+}
+
+func (r *OrganizationInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invite"
+}
+
+func (r *OrganizationInviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a pending invitation to the LangSmith organization, for users who don't have a `user_id` yet. " +
+			"Once the invite is accepted it's removed from state, unless `retain_on_accept` is set. Destroying a pending invite revokes it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the pending invite.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address to invite.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The organization role ID the user receives on accepting.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retain_on_accept": schema.BoolAttribute{
+				MarkdownDescription: "Keep the invite in state after it's accepted, with `status` set to `accepted` and `user_id` filled in, rather than removing it. " +
+					"Destroying an accepted invite only removes it from state; it doesn't remove the member. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the invite: `pending` or `accepted`.",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The user ID of the new member, once the invite has been accepted.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the invite was sent.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationInviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *OrganizationInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := organizationInviteCreateRequest{
+		Email:  data.Email.ValueString(),
+		RoleID: data.RoleID.ValueString(),
+	}
+
+	var result organizationMemberAPIResponse
+	err := r.client.Create(ctx, "/api/v1/orgs/current/members", body, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization invite", err.Error())
+		return
+	}
+
+	mapOrganizationInviteToState(&data, &result, organizationInvitePending)
+	tflog.Trace(ctx, "created organization invite resource", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationInviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Import leaves the flag unset; take the default.
+	if data.RetainOnAccept.IsNull() {
+		data.RetainOnAccept = types.BoolValue(false)
+	}
+
+	roster, err := listOrganizationRoster(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization members", err.Error())
+		return
+	}
+
+	for i := range roster {
+		if roster[i].pending && roster[i].ID == data.ID.ValueString() {
+			mapOrganizationInviteToState(&data, &roster[i].organizationMemberAPIResponse, organizationInvitePending)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// The invite's off the pending list: either it was answered, or it was
+	// revoked out from under us.
+	if data.RetainOnAccept.ValueBool() {
+		for i := range roster {
+			if !roster[i].pending && strings.EqualFold(roster[i].Email, data.Email.ValueString()) {
+				mapOrganizationInviteToState(&data, &roster[i].organizationMemberAPIResponse, organizationInviteAccepted)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				return
+			}
+		}
+	}
+
+	tflog.Info(ctx, "organization invite is no longer pending, removing from state", map[string]interface{}{"id": data.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only ever changes retain_on_accept, which never leaves Terraform;
+// everything else forces a new invite.
+func (r *OrganizationInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationInviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OrganizationInviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Status = state.Status
+	data.UserID = state.UserID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationInviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An accepted invite has nothing left to revoke; the member stays put.
+	if data.Status.ValueString() == organizationInviteAccepted {
+		return
+	}
+
+	err := r.client.Delete(ctx, "/api/v1/orgs/current/members/"+data.ID.ValueString()+"/pending")
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error revoking organization invite", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "revoked organization invite resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+func (r *OrganizationInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapOrganizationInviteToState maps a roster entry onto Terraform state. Once
+// the invite is accepted, only status and user_id follow the member; the
+// invite's own record, including a role changed since, is left as it was so
+// it never reads as drift and forces a fresh invite.
+func mapOrganizationInviteToState(data *OrganizationInviteResourceModel, result *organizationMemberAPIResponse, status string) {
+	data.Status = types.StringValue(status)
+
+	if status == organizationInviteAccepted {
+		data.UserID = types.StringValue(result.UserID)
+		return
+	}
+
+	data.ID = types.StringValue(result.ID)
+	data.RoleID = types.StringValue(result.RoleID)
+	data.UserID = types.StringNull()
+
+	// The API may fold the address to lowercase; keep the configured spelling.
+	if !strings.EqualFold(data.Email.ValueString(), result.Email) {
+		data.Email = types.StringValue(result.Email)
+	}
+
+	if result.CreatedAt != "" {
+		data.CreatedAt = types.StringValue(result.CreatedAt)
+	} else if data.CreatedAt.IsUnknown() {
+		data.CreatedAt = types.StringNull()
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// testOrgRosterServer stands in for the organization members API, holding
+// one roster of accepted members and one of pending invites.
+type testOrgRosterServer struct {
+	mu      sync.Mutex
+	roster  organizationMembersAPIResponse
+	revoked []string
+}
+
+func (s *testOrgRosterServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/orgs/current/members":
+		var body organizationInviteCreateRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		invite := organizationMemberAPIResponse{ID: "inv1", Email: body.Email, RoleID: body.RoleID, CreatedAt: "2024-01-01T00:00:00Z"}
+		s.roster.Pending = append(s.roster.Pending, invite)
+		_ = json.NewEncoder(w).Encode(invite)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/orgs/current/members":
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		page := func(list []organizationMemberAPIResponse) []organizationMemberAPIResponse {
+			if offset >= len(list) {
+				return nil
+			}
+			return list[offset:min(offset+limit, len(list))]
+		}
+		_ = json.NewEncoder(w).Encode(organizationMembersAPIResponse{
+			Members: page(s.roster.Members),
+			Pending: page(s.roster.Pending),
+		})
+	case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/orgs/current/members/inv1/pending":
+		s.revoked = append(s.revoked, "inv1")
+		s.roster.Pending = nil
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// accept moves every pending invite onto the member roster, the way signing
+// in for the first time does.
+func (s *testOrgRosterServer) accept() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, invite := range s.roster.Pending {
+		s.roster.Members = append(s.roster.Members, organizationMemberAPIResponse{
+			ID:     "member1",
+			UserID: "user1",
+			Email:  invite.Email,
+			RoleID: invite.RoleID,
+		})
+	}
+	s.roster.Pending = nil
+}
+
+func testOrganizationInviteCreate(t *testing.T, r *OrganizationInviteResource, retain bool) tfsdk.State {
+	t.Helper()
+
+	plan := testResourceState(t, r, &OrganizationInviteResourceModel{
+		ID:             types.StringUnknown(),
+		Email:          types.StringValue("Festus@DodgeCity.example"),
+		RoleID:         types.StringValue("role1"),
+		RetainOnAccept: types.BoolValue(retain),
+		Status:         types.StringUnknown(),
+		UserID:         types.StringUnknown(),
+		CreatedAt:      types.StringUnknown(),
+	})
+
+	resp := &resource.CreateResponse{State: plan}
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+func testOrganizationInviteRead(t *testing.T, r *OrganizationInviteResource, state tfsdk.State) *resource.ReadResponse {
+	t.Helper()
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	return resp
+}
+
+// TestOrganizationInviteResource_removedOnAccept checks a pending invite is
+// tracked until it's accepted, then drops out of state.
+func TestOrganizationInviteResource_removedOnAccept(t *testing.T) {
+	srv := &testOrgRosterServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	r := &OrganizationInviteResource{client: client.NewClient(ts.URL, "key", "")}
+	state := testOrganizationInviteCreate(t, r, false)
+
+	pending := testOrganizationInviteRead(t, r, state)
+	var got OrganizationInviteResourceModel
+	pending.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "inv1" || got.Status.ValueString() != organizationInvitePending || !got.UserID.IsNull() {
+		t.Fatalf("expected a pending invite, got %+v", got)
+	}
+
+	srv.accept()
+	accepted := testOrganizationInviteRead(t, r, pending.State)
+	if !accepted.State.Raw.IsNull() {
+		t.Errorf("expected the accepted invite to be removed from state")
+	}
+}
+
+// TestOrganizationInviteResource_readPaginates checks an invite past the
+// first page of the roster stays in state.
+func TestOrganizationInviteResource_readPaginates(t *testing.T) {
+	srv := &testOrgRosterServer{}
+	for i := range client.DefaultPageSize + 50 {
+		srv.roster.Pending = append(srv.roster.Pending, organizationMemberAPIResponse{
+			ID:    fmt.Sprintf("other%d", i),
+			Email: fmt.Sprintf("deputy%d@dodgecity.example", i),
+		})
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	r := &OrganizationInviteResource{client: client.NewClient(ts.URL, "key", "")}
+	state := testOrganizationInviteCreate(t, r, false)
+
+	resp := testOrganizationInviteRead(t, r, state)
+	var got OrganizationInviteResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "inv1" || got.Status.ValueString() != organizationInvitePending {
+		t.Errorf("expected the invite on the second page to stay in state, got %+v", got)
+	}
+}
+
+// TestOrganizationInviteResource_retainOnAccept checks retain_on_accept keeps
+// an accepted invite in state with the new member's user_id, and that
+// destroying it then leaves the member alone.
+func TestOrganizationInviteResource_retainOnAccept(t *testing.T) {
+	srv := &testOrgRosterServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	r := &OrganizationInviteResource{client: client.NewClient(ts.URL, "key", "")}
+	state := testOrganizationInviteCreate(t, r, true)
+
+	srv.accept()
+	accepted := testOrganizationInviteRead(t, r, state)
+
+	var got OrganizationInviteResourceModel
+	accepted.State.Get(context.Background(), &got)
+	if got.Status.ValueString() != organizationInviteAccepted || got.UserID.ValueString() != "user1" {
+		t.Fatalf("expected an accepted invite for user1, got %+v", got)
+	}
+	if got.ID.ValueString() != "inv1" || got.Email.ValueString() != "Festus@DodgeCity.example" {
+		t.Errorf("expected the invite's id and email to be kept, got %+v", got)
+	}
+
+	deleteResp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: accepted.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("delete: %v", deleteResp.Diagnostics)
+	}
+	if len(srv.revoked) != 0 {
+		t.Errorf("expected nothing revoked for an accepted invite, got %v", srv.revoked)
+	}
+}
+
+// TestOrganizationInviteResource_deleteRevokes checks destroying a pending
+// invite revokes it.
+func TestOrganizationInviteResource_deleteRevokes(t *testing.T) {
+	srv := &testOrgRosterServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	r := &OrganizationInviteResource{client: client.NewClient(ts.URL, "key", "")}
+	state := testOrganizationInviteCreate(t, r, false)
+
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("delete: %v", resp.Diagnostics)
+	}
+	if len(srv.revoked) != 1 || len(srv.roster.Pending) != 0 {
+		t.Errorf("expected the invite to be revoked, got %v", srv.revoked)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &OrganizationRoleDataSource{}
+
+const (
+	// permissionSourceCatalog marks a catalog read from the permissions endpoint.
+	permissionSourceCatalog = "catalog"
+
+	// permissionSourceBuiltInRoles marks a catalog pieced together from the
+	// permissions held by the organization's built-in roles.
+	permissionSourceBuiltInRoles = "built_in_roles"
+)
+
+// NewOrganizationRoleDataSource returns a new OrganizationRoleDataSource, the
+// book of every badge a role can be given.
+func NewOrganizationRoleDataSource() datasource.DataSource {
+	return &OrganizationRoleDataSource{}
+}
+
+// OrganizationRoleDataSource reads the catalog of permissions that can be
+// granted to an organization role, so custom roles can be checked against
+// it before they're ever sworn in.
+type OrganizationRoleDataSource struct {
+	client *client.Client
+}
+
+// OrganizationRoleDataSourceModel holds the permission catalog and where it
+// came from.
+type OrganizationRoleDataSourceModel struct {
+	Permissions []organizationPermissionModel `tfsdk:"permissions"`
+	Source      types.String                  `tfsdk:"source"`
+}
+
+// organizationPermissionModel is one permission in the catalog.
+type organizationPermissionModel struct {
+	Permission  types.String `tfsdk:"permission"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+}
+
+// orgPermissionAPIResponse is one entry from the permissions endpoint.
+type orgPermissionAPIResponse struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	AccessScope *string `json:"access_scope"`
+}
+
+func (d *OrganizationRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_role"
+}
+
+func (d *OrganizationRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the permissions that can be granted to a `langsmith_org_role`. " +
+			"When the API doesn't publish a permission catalog, the list is derived from the permissions held by the organization's built-in roles, and descriptions are left empty.",
+		Attributes: map[string]schema.Attribute{
+			"permissions": schema.ListNestedAttribute{
+				MarkdownDescription: "The available permissions, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							MarkdownDescription: "The permission string, as used in a role's `permissions`.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "What the permission allows, when the API says.",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "The access scope the permission applies to, such as `organization` or `workspace`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Where the catalog came from: `catalog` for the API's permission list, or `built_in_roles` when it was derived from the built-in roles.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *OrganizationRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationRoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, source, err := readPermissionCatalog(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading permissions", err.Error())
+		return
+	}
+	data.Source = types.StringValue(source)
+
+	data.Permissions = make([]organizationPermissionModel, 0, len(catalog))
+	for _, p := range catalog {
+		data.Permissions = append(data.Permissions, organizationPermissionModel{
+			Permission:  types.StringValue(p.Name),
+			Description: types.StringPointerValue(p.Description),
+			Scope:       types.StringPointerValue(p.AccessScope),
+		})
+	}
+
+	tflog.Trace(ctx, "read organization role data source", map[string]interface{}{"permissions": len(data.Permissions), "source": data.Source.ValueString()})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readPermissionCatalog reads the permissions that can be granted to a role,
+// sorted by name, along with where the list came from. When the deployment
+// doesn't publish a catalog, it's pieced together from the built-in roles.
+func readPermissionCatalog(ctx context.Context, c *client.Client) ([]orgPermissionAPIResponse, string, error) {
+	var catalog []orgPermissionAPIResponse
+	source := permissionSourceCatalog
+	err := c.Get(ctx, "/api/v1/orgs/permissions", nil, &catalog)
+	if client.IsNotFound(err) {
+		// No catalog on this deployment; take a census of the built-in roles.
+		var roles orgRoleListAPIResponse
+		if err := c.Get(ctx, "/api/v1/orgs/current/roles", nil, &roles); err != nil {
+			return nil, "", fmt.Errorf("reading organization roles: %w", err)
+		}
+		catalog = permissionsFromBuiltInRoles(roles)
+		source = permissionSourceBuiltInRoles
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	sort.SliceStable(catalog, func(i, j int) bool { return catalog[i].Name < catalog[j].Name })
+	return catalog, source, nil
+}
+
+// permissionNames pulls the permission names out of a role's permissions,
+// which may be plain strings or objects with a name, depending on the API
+// version.
+func permissionNames(raw json.RawMessage) ([]string, error) {
+	var strs []string
+	if err := json.Unmarshal(raw, &strs); err == nil {
+		return strs, nil
+	}
+	var objects []orgPermissionAPIResponse
+	if err := json.Unmarshal(raw, &objects); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(objects))
+	for _, o := range objects {
+		names = append(names, o.Name)
+	}
+	return names, nil
+}
+
+// permissionsFromBuiltInRoles gathers every distinct permission held by a
+// built-in role. Built-in roles have no organization of their own; custom
+// roles are skipped, since their permissions came from this catalog in the
+// first place.
+func permissionsFromBuiltInRoles(roles []orgRoleAPIResponse) []orgPermissionAPIResponse {
+	seen := map[string]bool{}
+	var catalog []orgPermissionAPIResponse
+
+	for _, role := range roles {
+		if role.OrganizationID != "" {
+			continue
+		}
+
+		names, err := permissionNames(role.Permissions)
+		if err != nil {
+			continue
+		}
+
+		for _, name := range names {
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			entry := orgPermissionAPIResponse{Name: name}
+			if role.AccessScope != "" {
+				scope := role.AccessScope
+				entry.AccessScope = &scope
+			}
+			catalog = append(catalog, entry)
+		}
+	}
+
+	return catalog
+}
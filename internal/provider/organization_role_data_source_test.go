@@ -0,0 +1,95 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+func testOrganizationRoleRead(t *testing.T, handler http.HandlerFunc) OrganizationRoleDataSourceModel {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	d := &OrganizationRoleDataSource{client: client.NewClient(srv.URL, "key", "")}
+	resp := testDataSourceRead(t, d, &OrganizationRoleDataSourceModel{Source: types.StringNull()})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got OrganizationRoleDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	return got
+}
+
+// TestOrganizationRoleDataSource_catalog checks the permission catalog is
+// read straight from the API when it publishes one.
+func TestOrganizationRoleDataSource_catalog(t *testing.T) {
+	got := testOrganizationRoleRead(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/orgs/permissions" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"name": "workspaces:read", "description": "View workspaces", "access_scope": "organization"},
+			{"name": "datasets:create", "description": "Create datasets", "access_scope": "workspace"}
+		]`))
+	})
+
+	if got.Source.ValueString() != permissionSourceCatalog || len(got.Permissions) != 2 {
+		t.Fatalf("expected two permissions from the catalog, got %s %+v", got.Source, got.Permissions)
+	}
+	first := got.Permissions[0]
+	if first.Permission.ValueString() != "datasets:create" || first.Description.ValueString() != "Create datasets" || first.Scope.ValueString() != "workspace" {
+		t.Errorf("expected datasets:create first, got %+v", first)
+	}
+}
+
+// TestOrganizationRoleDataSource_builtInRoles checks that without a catalog
+// endpoint the permissions are gathered from the built-in roles alone.
+func TestOrganizationRoleDataSource_builtInRoles(t *testing.T) {
+	got := testOrganizationRoleRead(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/orgs/current/roles":
+			_, _ = w.Write([]byte(`[
+				{"id": "r1", "name": "WORKSPACE_ADMIN", "access_scope": "workspace", "permissions": ["runs:read", "datasets:create"]},
+				{"id": "r2", "name": "WORKSPACE_VIEWER", "access_scope": "workspace", "permissions": ["runs:read"]},
+				{"id": "r3", "name": "ORGANIZATION_ADMIN", "access_scope": "organization", "permissions": [{"name": "organization:manage"}]},
+				{"id": "r4", "name": "CUSTOM", "organization_id": "o1", "access_scope": "workspace", "permissions": ["made:up"]}
+			]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	if got.Source.ValueString() != permissionSourceBuiltInRoles {
+		t.Errorf("expected source %q, got %s", permissionSourceBuiltInRoles, got.Source)
+	}
+
+	want := map[string]string{
+		"datasets:create":     "workspace",
+		"organization:manage": "organization",
+		"runs:read":           "workspace",
+	}
+	if len(got.Permissions) != len(want) {
+		t.Fatalf("expected %d permissions, got %+v", len(want), got.Permissions)
+	}
+	for _, p := range got.Permissions {
+		if scope, ok := want[p.Permission.ValueString()]; !ok || p.Scope.ValueString() != scope {
+			t.Errorf("unexpected permission %+v", p)
+		}
+		if !p.Description.IsNull() {
+			t.Errorf("expected no description for %s, got %s", p.Permission, p.Description)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &OrganizationSettingsResource{}
+	_ resource.ResourceWithImportState = &OrganizationSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &OrganizationSettingsResource{}
+)
+
+// organizationDomainRegexp matches an email domain such as example.com.
+var organizationDomainRegexp = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`)
+
+// NewOrganizationSettingsResource returns a new OrganizationSettingsResource,
+// for deciding who gets through the front gate of the whole organization.
+func NewOrganizationSettingsResource() resource.Resource {
+	return &OrganizationSettingsResource{}
+}
+
+// OrganizationSettingsResource manages the organization's login settings:
+// whether members must sign in through SSO, and which email domains may sign
+// in at all. Like TTL settings, it's a singleton that always exists, so
+// destroying it only lets go of it.
+type OrganizationSettingsResource struct {
+	client *client.Client
+}
+
+// OrganizationSettingsResourceModel holds the Terraform state for the
+// organization's login settings.
+type OrganizationSettingsResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	EnforceSSO     types.Bool   `tfsdk:"enforce_sso"`
+	AllowedDomains types.Set    `tfsdk:"allowed_domains"`
+}
+
+// organizationSettingsAPIRequest is the wire format for changing the login
+// settings. Only what's configured rides along.
+type organizationSettingsAPIRequest struct {
+	SSOOnly        *bool     `json:"sso_only,omitempty"`
+	AllowedDomains *[]string `json:"allowed_domains,omitempty"`
+}
+
+// organizationSettingsAPIResponse is the part of the organization record
+// holding its login settings.
+type organizationSettingsAPIResponse struct {
+	ID             string   `json:"id"`
+	SSOOnly        bool     `json:"sso_only"`
+	AllowedDomains []string `json:"allowed_domains"`
+}
+
+func (r *OrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+func (r *OrganizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the LangSmith organization's login settings: whether members must sign in through SSO, and which email domains may sign in. " +
+			"This is a singleton resource that always exists per organization; destroying it removes it from Terraform state and leaves the settings as they are. " +
+			"Turning on `enforce_sso` locks out every member who signs in with a password or a social login, so configure `langsmith_sso_settings` and check it works first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enforce_sso": schema.BoolAttribute{
+				MarkdownDescription: "Whether members may only sign in through SSO. Left unset, the current setting is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_domains": schema.SetAttribute{
+				MarkdownDescription: "The email domains, such as `example.com`, whose users may sign in. An empty set allows any domain. Left unset, the current domains are kept.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(organizationDomainRegexp, "must be an email domain, such as example.com")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The settings are already there; find out what they are, so a change
+	// to enforce_sso can be called out, then lay down the law.
+	var current OrganizationSettingsResourceModel
+	r.readOrganizationSettings(ctx, &current, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrganizationSettings(ctx, &data, current.EnforceSSO, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created organization settings resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.readOrganizationSettings(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OrganizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrganizationSettings(ctx, &data, state.EnforceSSO, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated organization settings resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Flipping SSO enforcement back off on destroy would quietly loosen a
+	// security baseline, so the settings stay as they are.
+	tflog.Warn(ctx, "Organization settings are a singleton resource and cannot be deleted. Removing from Terraform state only; enforce_sso and allowed_domains are left as they are.")
+}
+
+// ModifyPlan warns before an apply turns on SSO enforcement, since it locks
+// out everyone who signs in with a password.
+func (r *OrganizationSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var enforce types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enforce_sso"), &enforce)...)
+	if resp.Diagnostics.HasError() || !enforce.ValueBool() {
+		return
+	}
+
+	var previous types.Bool
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("enforce_sso"), &previous)...)
+	}
+	if previous.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("enforce_sso"),
+		"SSO-Only Login Will Be Enforced",
+		"Once applied, organization members can only sign in through SSO; anyone who signs in with a password or a social login is locked out. "+
+			"Make sure SSO works for every member, including whoever would need to turn this back off.",
+	)
+}
+
+func (r *OrganizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyOrganizationSettings sends the configured settings and reads back the
+// result into data. A change to enforce_sso from previous is logged as a
+// warning, since it decides who can sign in at all.
+func (r *OrganizationSettingsResource) applyOrganizationSettings(ctx context.Context, data *OrganizationSettingsResourceModel, previous types.Bool, diags *diag.Diagnostics) {
+	var body organizationSettingsAPIRequest
+	if !data.EnforceSSO.IsNull() && !data.EnforceSSO.IsUnknown() {
+		v := data.EnforceSSO.ValueBool()
+		body.SSOOnly = &v
+	}
+	if !data.AllowedDomains.IsNull() && !data.AllowedDomains.IsUnknown() {
+		domains := []string{}
+		diags.Append(data.AllowedDomains.ElementsAs(ctx, &domains, false)...)
+		if diags.HasError() {
+			return
+		}
+		sort.Strings(domains)
+		body.AllowedDomains = &domains
+	}
+
+	if body.SSOOnly != nil && *body.SSOOnly != previous.ValueBool() {
+		tflog.Warn(ctx, "changing organization SSO enforcement", map[string]interface{}{
+			"enforce_sso": *body.SSOOnly,
+			"was":         previous.ValueBool(),
+		})
+	}
+
+	err := r.client.Patch(ctx, "/api/v1/orgs/current/login-methods", body, nil)
+	if err != nil {
+		diags.AddError("Error updating organization settings", err.Error())
+		return
+	}
+
+	r.readOrganizationSettings(ctx, data, diags)
+}
+
+// readOrganizationSettings fetches the organization's login settings into
+// data.
+func (r *OrganizationSettingsResource) readOrganizationSettings(ctx context.Context, data *OrganizationSettingsResourceModel, diags *diag.Diagnostics) {
+	var result organizationSettingsAPIResponse
+	err := r.client.Get(ctx, "/api/v1/orgs/current", nil, &result)
+	if err != nil {
+		diags.AddError("Error reading organization settings", err.Error())
+		return
+	}
+
+	domains := result.AllowedDomains
+	if domains == nil {
+		domains = []string{}
+	}
+	allowed, d := types.SetValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+
+	data.ID = types.StringValue(result.ID)
+	data.EnforceSSO = types.BoolValue(result.SSOOnly)
+	data.AllowedDomains = allowed
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestOrganizationSettingsResource_create checks only the configured settings
+// are sent, and the organization's answer lands in state.
+func TestOrganizationSettingsResource_create(t *testing.T) {
+	settings := organizationSettingsAPIResponse{ID: "o1", AllowedDomains: []string{"old.example.com"}}
+	var sent map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/orgs/current":
+			_ = json.NewEncoder(w).Encode(settings)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/orgs/current/login-methods":
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			if v, ok := sent["sso_only"].(bool); ok {
+				settings.SSOOnly = v
+			}
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	r := &OrganizationSettingsResource{client: client.NewClient(srv.URL, "key", "")}
+	plan := testResourceState(t, r, &OrganizationSettingsResourceModel{
+		ID:             types.StringUnknown(),
+		EnforceSSO:     types.BoolValue(true),
+		AllowedDomains: types.SetUnknown(types.StringType),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", resp.Diagnostics)
+	}
+
+	if len(sent) != 1 || sent["sso_only"] != true {
+		t.Errorf("expected only sso_only to be sent, got %v", sent)
+	}
+
+	var got OrganizationSettingsResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "o1" || !got.EnforceSSO.ValueBool() || len(got.AllowedDomains.Elements()) != 1 {
+		t.Errorf("unexpected state %+v", got)
+	}
+}
+
+// TestOrganizationSettingsResource_enforceSSOWarning checks turning on SSO
+// enforcement draws a warning at plan time, and a bad domain is turned away.
+func TestOrganizationSettingsResource_enforceSSOWarning(t *testing.T) {
+	r := &OrganizationSettingsResource{}
+	for enforce, wantWarning := range map[bool]bool{true: true, false: false} {
+		resp := testModifyPlan(t, r, &OrganizationSettingsResourceModel{
+			ID:             types.StringUnknown(),
+			EnforceSSO:     types.BoolValue(enforce),
+			AllowedDomains: types.SetNull(types.StringType),
+		})
+		if resp.Diagnostics.HasError() || (resp.Diagnostics.WarningsCount() > 0) != wantWarning {
+			t.Errorf("enforce_sso=%t: expected warning %t, got %v", enforce, wantWarning, resp.Diagnostics)
+		}
+	}
+
+	for domain, wantErr := range map[string]bool{"example.com": false, "eu.example.co.uk": false, "@example.com": true, "localhost": true} {
+		diags := testValidateResourceConfig(t, "langsmith_organization_settings", map[string]tftypes.Value{
+			"allowed_domains": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, domain)}),
+		})
+		if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "allowed_domains") != wantErr {
+			t.Errorf("%q: expected error=%t, got %v", domain, wantErr, diags)
+		}
+	}
+}
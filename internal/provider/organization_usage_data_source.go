@@ -0,0 +1,196 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &OrganizationUsageDataSource{}
+
+// NewOrganizationUsageDataSource returns a new OrganizationUsageDataSource for
+// checking how much of the ranch has been grazed this billing period.
+func NewOrganizationUsageDataSource() datasource.DataSource {
+	return &OrganizationUsageDataSource{}
+}
+
+// OrganizationUsageDataSource reads the current organization's usage and
+// quotas — traces ingested this period and seats spoken for. Read-only; the
+// tally book, not the ledger pen.
+type OrganizationUsageDataSource struct {
+	client *client.Client
+}
+
+// OrganizationUsageDataSourceModel holds the usage counters and quota limits
+// for the current billing period. Limits are null on plans that don't carry
+// them.
+type OrganizationUsageDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	PeriodStart      types.String `tfsdk:"period_start"`
+	PeriodEnd        types.String `tfsdk:"period_end"`
+	TracesThisPeriod types.Int64  `tfsdk:"traces_this_period"`
+	TraceLimit       types.Int64  `tfsdk:"trace_limit"`
+	SeatsUsed        types.Int64  `tfsdk:"seats_used"`
+	SeatsLimit       types.Int64  `tfsdk:"seats_limit"`
+	SeatsAvailable   types.Int64  `tfsdk:"seats_available"`
+}
+
+// orgUsageAPIResponse is the API response for the org usage endpoint. Every
+// field is optional; lighter plans leave the quota side blank.
+type orgUsageAPIResponse struct {
+	OrganizationID   string  `json:"organization_id"`
+	PeriodStart      *string `json:"period_start"`
+	PeriodEnd        *string `json:"period_end"`
+	TracesThisPeriod *int64  `json:"traces_this_period"`
+	TraceLimit       *int64  `json:"trace_limit"`
+	SeatsUsed        *int64  `json:"seats_used"`
+	SeatsLimit       *int64  `json:"seats_limit"`
+}
+
+func (d *OrganizationUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_usage"
+}
+
+func (d *OrganizationUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to retrieve the current LangSmith organization's usage and quotas for the billing period. Quota attributes are null on plan tiers that don't report them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID the usage belongs to.",
+				Computed:            true,
+			},
+			"period_start": schema.StringAttribute{
+				MarkdownDescription: "The start of the current billing period.",
+				Computed:            true,
+			},
+			"period_end": schema.StringAttribute{
+				MarkdownDescription: "The end of the current billing period.",
+				Computed:            true,
+			},
+			"traces_this_period": schema.Int64Attribute{
+				MarkdownDescription: "The number of traces ingested so far this billing period.",
+				Computed:            true,
+			},
+			"trace_limit": schema.Int64Attribute{
+				MarkdownDescription: "The trace quota for the billing period, if the plan has one.",
+				Computed:            true,
+			},
+			"seats_used": schema.Int64Attribute{
+				MarkdownDescription: "The number of seats currently in use.",
+				Computed:            true,
+			},
+			"seats_limit": schema.Int64Attribute{
+				MarkdownDescription: "The total number of seats on the plan, if the plan has a limit.",
+				Computed:            true,
+			},
+			"seats_available": schema.Int64Attribute{
+				MarkdownDescription: "The number of seats still available. Null when the plan has no seat limit.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *OrganizationUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result orgUsageAPIResponse
+	err := d.client.Get(ctx, "/api/v1/orgs/current/usage", nil, &result)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error reading organization usage", err.Error())
+		return
+	}
+	if err != nil {
+		// Some plan tiers don't track usage at all. Leave the tally blank
+		// rather than failing the whole plan.
+		tflog.Debug(ctx, "organization usage not available for this plan")
+	}
+
+	mapOrgUsageResponseToModel(&data, &result)
+
+	tflog.Trace(ctx, "read organization usage data source", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapOrgUsageResponseToModel tallies up the usage response into the model.
+// Anything the API kept to itself stays null.
+func mapOrgUsageResponseToModel(data *OrganizationUsageDataSourceModel, result *orgUsageAPIResponse) {
+	data.ID = types.StringValue(result.OrganizationID)
+	if result.OrganizationID == "" {
+		data.ID = types.StringValue("current")
+	}
+
+	if result.PeriodStart != nil {
+		data.PeriodStart = types.StringValue(*result.PeriodStart)
+	} else {
+		data.PeriodStart = types.StringNull()
+	}
+
+	if result.PeriodEnd != nil {
+		data.PeriodEnd = types.StringValue(*result.PeriodEnd)
+	} else {
+		data.PeriodEnd = types.StringNull()
+	}
+
+	if result.TracesThisPeriod != nil {
+		data.TracesThisPeriod = types.Int64Value(*result.TracesThisPeriod)
+	} else {
+		data.TracesThisPeriod = types.Int64Null()
+	}
+
+	if result.TraceLimit != nil {
+		data.TraceLimit = types.Int64Value(*result.TraceLimit)
+	} else {
+		data.TraceLimit = types.Int64Null()
+	}
+
+	if result.SeatsUsed != nil {
+		data.SeatsUsed = types.Int64Value(*result.SeatsUsed)
+	} else {
+		data.SeatsUsed = types.Int64Null()
+	}
+
+	if result.SeatsLimit != nil {
+		data.SeatsLimit = types.Int64Value(*result.SeatsLimit)
+	} else {
+		data.SeatsLimit = types.Int64Null()
+	}
+
+	// Only reckon the open seats when both sides of the ledger are known.
+	if result.SeatsLimit != nil && result.SeatsUsed != nil {
+		data.SeatsAvailable = types.Int64Value(max(*result.SeatsLimit-*result.SeatsUsed, 0))
+	} else {
+		data.SeatsAvailable = types.Int64Null()
+	}
+}
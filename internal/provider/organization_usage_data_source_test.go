@@ -0,0 +1,56 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccOrganizationUsageDataSource_basic checks the usage tally comes back
+// for the current org. The quota fields depend on the plan, so only the
+// identity is asserted.
+func TestAccOrganizationUsageDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "langsmith_organization_usage" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.langsmith_organization_usage.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+// TestMapOrgUsageResponseToModel covers a plan with full quota data and one
+// without any, which must come through as nulls rather than zeros.
+func TestMapOrgUsageResponseToModel(t *testing.T) {
+	used, limit, traces := int64(12), int64(10), int64(4200)
+	var data OrganizationUsageDataSourceModel
+	mapOrgUsageResponseToModel(&data, &orgUsageAPIResponse{
+		OrganizationID:   "org-1",
+		TracesThisPeriod: &traces,
+		SeatsUsed:        &used,
+		SeatsLimit:       &limit,
+	})
+	if data.SeatsAvailable.ValueInt64() != 0 {
+		t.Errorf("expected over-subscribed seats to floor at 0, got %d", data.SeatsAvailable.ValueInt64())
+	}
+	if !data.TraceLimit.IsNull() {
+		t.Errorf("expected null trace_limit, got %s", data.TraceLimit)
+	}
+
+	var empty OrganizationUsageDataSourceModel
+	mapOrgUsageResponseToModel(&empty, &orgUsageAPIResponse{})
+	if empty.ID.ValueString() != "current" {
+		t.Errorf("expected fallback id, got %s", empty.ID)
+	}
+	if !empty.SeatsAvailable.IsNull() || !empty.TracesThisPeriod.IsNull() {
+		t.Errorf("expected null usage fields for a plan without quota data")
+	}
+}
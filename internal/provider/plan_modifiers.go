@@ -0,0 +1,185 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ planmodifier.String = jsonNormalizePlanModifier{}
+	_ planmodifier.String = canonicalFilterPlanModifier{}
+	_ planmodifier.String = clearedWithoutBlocksPlanModifier{}
+)
+
+// jsonNormalize returns a plan modifier for attributes that hold raw JSON as a
+// string. The API likes to re-serialize what it's given — different key order,
+// different whitespace — and without this every plan would report the same
+// document as changed.
+func jsonNormalize() planmodifier.String {
+	return jsonNormalizePlanModifier{}
+}
+
+// jsonNormalizePlanModifier keeps the prior state value whenever the planned
+// JSON means the same thing, so only real changes ride into the plan.
+type jsonNormalizePlanModifier struct{}
+
+func (m jsonNormalizePlanModifier) Description(ctx context.Context) string {
+	return "Suppresses differences between semantically equal JSON documents."
+}
+
+func (m jsonNormalizePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m jsonNormalizePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Creates, destroys, and anything not yet known pass straight through.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+	if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.PlanValue.ValueString() == req.StateValue.ValueString() {
+		return
+	}
+
+	if jsonSemanticallyEqual(req.PlanValue.ValueString(), req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// clearedWithoutBlocks returns a plan modifier for a deprecated computed JSON
+// attribute that stands in for a list of blocks. Once neither it nor any of
+// the blocks are configured, it plans cleared, the way the API reports
+// nothing; being computed, it would otherwise hold on to the old value for
+// good.
+func clearedWithoutBlocks(block string, cleared types.String) planmodifier.String {
+	return clearedWithoutBlocksPlanModifier{block: block, cleared: cleared}
+}
+
+// clearedWithoutBlocksPlanModifier plans cleared in place of the prior state
+// when neither the attribute nor its blocks are configured.
+type clearedWithoutBlocksPlanModifier struct {
+	block   string
+	cleared types.String
+}
+
+func (m clearedWithoutBlocksPlanModifier) Description(ctx context.Context) string {
+	return "Clears the value when neither it nor the " + m.block + " blocks are configured."
+}
+
+func (m clearedWithoutBlocksPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Clears the value when neither it nor the `" + m.block + "` blocks are configured."
+}
+
+func (m clearedWithoutBlocksPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Creates learn the value from the API, and destroys need nothing.
+	if req.StateValue.IsNull() || req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var blocks types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(m.block), &blocks)...)
+	if resp.Diagnostics.HasError() || blocks.IsUnknown() || len(blocks.Elements()) > 0 {
+		return
+	}
+
+	resp.PlanValue = m.cleared
+}
+
+// jsonSemanticallyEqual reports whether two strings decode to the same JSON
+// value. Anything that doesn't parse is never equal to anything else.
+func jsonSemanticallyEqual(a, b string) bool {
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// jsonObjectSubset reports whether every key of the JSON object sub is in
+// super with the same value, comparing nested objects the same way. It's how
+// we tell keys the server added on its own from changes to the ones a user
+// set. Anything that isn't a pair of objects must be equal outright.
+func jsonObjectSubset(sub, super string) bool {
+	var sv, pv interface{}
+	if err := json.Unmarshal([]byte(sub), &sv); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(super), &pv); err != nil {
+		return false
+	}
+	return jsonValueSubset(sv, pv)
+}
+
+func jsonValueSubset(sub, super interface{}) bool {
+	subObj, ok := sub.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(sub, super)
+	}
+	superObj, ok := super.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for k, v := range subObj {
+		sv, present := superObj[k]
+		if !present || !jsonValueSubset(v, sv) {
+			return false
+		}
+	}
+	return true
+}
+
+// canonicalFilterOf returns a plan modifier for a computed attribute that
+// mirrors the filter expression at source in canonical form. The value is
+// worked out at plan time, so it's known to anyone reading the plan instead
+// of showing up as "known after apply".
+func canonicalFilterOf(source path.Path) planmodifier.String {
+	return canonicalFilterPlanModifier{source: source}
+}
+
+// canonicalFilterPlanModifier plans the canonical form of another attribute's
+// filter expression.
+type canonicalFilterPlanModifier struct {
+	source path.Path
+}
+
+func (m canonicalFilterPlanModifier) Description(ctx context.Context) string {
+	return "Plans the canonical form of the filter expression at " + m.source.String() + "."
+}
+
+func (m canonicalFilterPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m canonicalFilterPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filter types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.source, &filter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case filter.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case filter.IsNull():
+		resp.PlanValue = types.StringNull()
+	default:
+		resp.PlanValue = types.StringValue(canonicalizeFilter(filter.ValueString()))
+	}
+}
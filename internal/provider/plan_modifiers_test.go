@@ -0,0 +1,93 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestJSONNormalizePlanModifier runs the modifier through the usual suspects:
+// reordered keys, reformatted whitespace, real changes, and nulls on either side.
+func TestJSONNormalizePlanModifier(t *testing.T) {
+	cases := map[string]struct {
+		state types.String
+		plan  types.String
+		want  types.String
+	}{
+		"reordered keys": {
+			state: types.StringValue(`{"b":2,"a":{"y":[1,2],"x":true}}`),
+			plan:  types.StringValue(`{"a": {"x": true, "y": [1, 2]}, "b": 2}`),
+			want:  types.StringValue(`{"b":2,"a":{"y":[1,2],"x":true}}`),
+		},
+		"real change": {
+			state: types.StringValue(`{"a":1}`),
+			plan:  types.StringValue(`{"a":2}`),
+			want:  types.StringValue(`{"a":2}`),
+		},
+		"array order matters": {
+			state: types.StringValue(`[1,2]`),
+			plan:  types.StringValue(`[2,1]`),
+			want:  types.StringValue(`[2,1]`),
+		},
+		"null state, empty object plan": {
+			state: types.StringNull(),
+			plan:  types.StringValue(`{}`),
+			want:  types.StringValue(`{}`),
+		},
+		"empty array state, null plan": {
+			state: types.StringValue(`[]`),
+			plan:  types.StringNull(),
+			want:  types.StringNull(),
+		},
+		"unknown plan": {
+			state: types.StringValue(`{}`),
+			plan:  types.StringUnknown(),
+			want:  types.StringUnknown(),
+		},
+		"invalid JSON": {
+			state: types.StringValue(`{}`),
+			plan:  types.StringValue(`{not json`),
+			want:  types.StringValue(`{not json`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tc.state, PlanValue: tc.plan}
+			resp := &planmodifier.StringResponse{PlanValue: tc.plan}
+			jsonNormalize().PlanModifyString(context.Background(), req, resp)
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, resp.PlanValue)
+			}
+		})
+	}
+}
+
+// TestJSONObjectSubset checks which documents count as the server only
+// adding keys.
+func TestJSONObjectSubset(t *testing.T) {
+	cases := []struct {
+		sub, super string
+		want       bool
+	}{
+		{`{}`, `{"a":1}`, true},
+		{`{"a":1}`, `{"b":2,"a":1}`, true},
+		{`{"a":{"x":1}}`, `{"a":{"x":1,"y":2}}`, true},
+		{`{"a":1}`, `{"a":2}`, false},
+		{`{"a":1}`, `{"b":1}`, false},
+		{`{"a":[1,2]}`, `{"a":[1,2,3]}`, false},
+		{`[1]`, `[1]`, true},
+		{`{"a":1}`, `not json`, false},
+	}
+
+	for _, tc := range cases {
+		if got := jsonObjectSubset(tc.sub, tc.super); got != tc.want {
+			t.Errorf("jsonObjectSubset(%s, %s) = %v, want %v", tc.sub, tc.super, got, tc.want)
+		}
+	}
+}
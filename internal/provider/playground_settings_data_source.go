@@ -0,0 +1,182 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ datasource.DataSource                     = &PlaygroundSettingsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &PlaygroundSettingsDataSource{}
+)
+
+// NewPlaygroundSettingsDataSource returns a new PlaygroundSettingsDataSource,
+// for borrowing a playground setup somebody else already laid out.
+func NewPlaygroundSettingsDataSource() datasource.DataSource {
+	return &PlaygroundSettingsDataSource{}
+}
+
+// PlaygroundSettingsDataSource reads existing playground settings by ID or
+// name, so a shared configuration can be referenced without copying its JSON
+// into every module.
+type PlaygroundSettingsDataSource struct {
+	client *client.Client
+}
+
+// PlaygroundSettingsDataSourceModel holds the lookup keys and the settings
+// found.
+type PlaygroundSettingsDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Settings     types.String `tfsdk:"settings"`
+	Options      types.String `tfsdk:"options"`
+	SettingsType types.String `tfsdk:"settings_type"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	WorkspaceID  types.String `tfsdk:"workspace_id"`
+}
+
+func (d *PlaygroundSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_playground_settings"
+}
+
+func (d *PlaygroundSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to look up playground settings by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the playground settings. Exactly one of `id` or `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the playground settings. Exactly one of `id` or `name` must be specified; it's an error for the name to match more than one entry.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the playground settings.",
+				Computed:            true,
+			},
+			"settings": schema.StringAttribute{
+				MarkdownDescription: "A JSON string containing the settings object.",
+				Computed:            true,
+			},
+			"options": schema.StringAttribute{
+				MarkdownDescription: "JSON-encoded options object.",
+				Computed:            true,
+			},
+			"settings_type": schema.StringAttribute{
+				MarkdownDescription: "The settings type, `complex` or `simple`.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The creation timestamp.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The last update timestamp.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PlaygroundSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *PlaygroundSettingsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *PlaygroundSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlaygroundSettingsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	results, err := listPlaygroundSettings(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading playground settings", err.Error())
+		return
+	}
+
+	var matches []playgroundSettingsAPIResponse
+	for _, result := range results {
+		if !data.ID.IsNull() {
+			if result.ID == data.ID.ValueString() {
+				matches = append(matches, result)
+			}
+		} else if result.Name != nil && *result.Name == data.Name.ValueString() {
+			matches = append(matches, result)
+		}
+	}
+
+	var found playgroundSettingsAPIResponse
+	switch len(matches) {
+	case 1:
+		found = matches[0]
+	case 0:
+		if !data.ID.IsNull() {
+			resp.Diagnostics.AddError("Playground Settings Not Found", fmt.Sprintf("No playground settings with ID %s.", data.ID.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Playground Settings Not Found", fmt.Sprintf("No playground settings named %q.", data.Name.ValueString()))
+		}
+		return
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Playground Settings Name",
+			fmt.Sprintf("Found %d playground settings named %q; look them up by id instead.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	var mapped PlaygroundSettingsResourceModel
+	mapPlaygroundSettingsResponseToState(&mapped, &found)
+
+	data.ID = mapped.ID
+	data.Name = mapped.Name
+	data.Description = mapped.Description
+	data.Settings = mapped.Settings
+	data.Options = mapped.Options
+	data.SettingsType = mapped.SettingsType
+	data.CreatedAt = mapped.CreatedAt
+	data.UpdatedAt = mapped.UpdatedAt
+
+	tflog.Trace(ctx, "read playground settings data source", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
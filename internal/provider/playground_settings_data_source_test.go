@@ -0,0 +1,64 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestPlaygroundSettingsDataSource_read checks settings are found by ID or
+// name, and that a missing or shared name fails the read.
+func TestPlaygroundSettingsDataSource_read(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/playground-settings" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("offset") != "0" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": "p1", "name": "standard", "settings": {"model": "gpt-4o"}, "options": {"temperature": 0}, "settings_type": "complex", "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-02T00:00:00Z"},
+			{"id": "p2", "name": "twin", "settings": {}, "settings_type": "simple"},
+			{"id": "p3", "name": "twin", "settings": {}, "settings_type": "simple"}
+		]`))
+	}))
+	defer srv.Close()
+
+	d := &PlaygroundSettingsDataSource{client: client.NewClient(srv.URL, "key", "")}
+
+	for _, config := range []PlaygroundSettingsDataSourceModel{{ID: types.StringValue("p1")}, {Name: types.StringValue("standard")}} {
+		resp := testDataSourceRead(t, d, &config)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("reading: %v", resp.Diagnostics)
+		}
+
+		var got PlaygroundSettingsDataSourceModel
+		resp.State.Get(context.Background(), &got)
+		if got.ID.ValueString() != "p1" || got.Name.ValueString() != "standard" || got.Settings.ValueString() != `{"model": "gpt-4o"}` ||
+			got.Options.ValueString() != `{"temperature": 0}` || got.SettingsType.ValueString() != "complex" || !got.Description.IsNull() {
+			t.Errorf("unexpected playground settings %+v", got)
+		}
+	}
+
+	for config, want := range map[PlaygroundSettingsDataSourceModel]string{
+		{ID: types.StringValue("p9")}:     "Playground Settings Not Found",
+		{Name: types.StringValue("gone")}: "Playground Settings Not Found",
+		{Name: types.StringValue("twin")}: "Ambiguous Playground Settings Name",
+	} {
+		resp := testDataSourceRead(t, d, &config)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != want {
+			t.Errorf("%+v: expected %q, got %v", config, want, resp.Diagnostics)
+		}
+	}
+}
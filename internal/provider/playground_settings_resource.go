@@ -48,6 +48,8 @@ type PlaygroundSettingsResourceModel struct {
 	UpdatedAt    types.String `tfsdk:"updated_at"`
 	Options      types.String `tfsdk:"options"`
 	SettingsType types.String `tfsdk:"settings_type"`
+	TenantID     types.String `tfsdk:"tenant_id"`
+	WorkspaceID  types.String `tfsdk:"workspace_id"`
 }
 
 // playgroundSettingsAPICreateRequest is the request body for creating playground settings.
@@ -79,6 +81,7 @@ type playgroundSettingsAPIResponse struct {
 	SettingsType string          `json:"settings_type"`
 	CreatedAt    string          `json:"created_at"`
 	UpdatedAt    string          `json:"updated_at"`
+	TenantID     string          `json:"tenant_id"`
 }
 
 func (r *PlaygroundSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,6 +92,7 @@ func (r *PlaygroundSettingsResource) Schema(ctx context.Context, req resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages LangSmith playground settings.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the playground settings.",
 				Computed:            true,
@@ -107,6 +111,9 @@ func (r *PlaygroundSettingsResource) Schema(ctx context.Context, req resource.Sc
 			"settings": schema.StringAttribute{
 				MarkdownDescription: "A JSON string containing the settings object.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "The creation timestamp.",
@@ -116,9 +123,19 @@ func (r *PlaygroundSettingsResource) Schema(ctx context.Context, req resource.Sc
 				MarkdownDescription: "The last update timestamp.",
 				Computed:            true,
 			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the playground settings belong to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"options": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded options object.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"settings_type": schema.StringAttribute{
 				MarkdownDescription: "The settings type. Valid values: `complex`, `simple`. Defaults to `complex`.",
@@ -134,16 +151,16 @@ func (r *PlaygroundSettingsResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *PlaygroundSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -153,6 +170,8 @@ func (r *PlaygroundSettingsResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := playgroundSettingsAPICreateRequest{
 		Settings: json.RawMessage(data.Settings.ValueString()),
 	}
@@ -176,13 +195,17 @@ func (r *PlaygroundSettingsResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	var result playgroundSettingsAPIResponse
-	err := r.client.Post(ctx, "/api/v1/playground-settings", body, &result)
+	err := r.client.Create(ctx, "/api/v1/playground-settings", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating playground settings", err.Error())
 		return
 	}
 
 	mapPlaygroundSettingsResponseToState(&data, &result)
+	resolveTenantID(ctx, r.client, &data.TenantID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "created playground settings resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -195,8 +218,9 @@ func (r *PlaygroundSettingsResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	var results []playgroundSettingsAPIResponse
-	err := r.client.Get(ctx, "/api/v1/playground-settings", nil, &results)
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	results, err := listPlaygroundSettings(ctx, r.client)
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -220,6 +244,10 @@ func (r *PlaygroundSettingsResource) Read(ctx context.Context, req resource.Read
 	}
 
 	mapPlaygroundSettingsResponseToState(&data, found)
+	resolveTenantID(ctx, r.client, &data.TenantID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -231,6 +259,8 @@ func (r *PlaygroundSettingsResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := playgroundSettingsAPIUpdateRequest{
 		Settings: json.RawMessage(data.Settings.ValueString()),
 	}
@@ -256,6 +286,10 @@ func (r *PlaygroundSettingsResource) Update(ctx context.Context, req resource.Up
 	}
 
 	mapPlaygroundSettingsResponseToState(&data, &result)
+	resolveTenantID(ctx, r.client, &data.TenantID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Trace(ctx, "updated playground settings resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -268,6 +302,8 @@ func (r *PlaygroundSettingsResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/playground-settings/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting playground settings", err.Error())
@@ -278,9 +314,16 @@ func (r *PlaygroundSettingsResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *PlaygroundSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// listPlaygroundSettings fetches every playground settings entry in the
+// workspace; the API has no way to look one up on its own.
+func listPlaygroundSettings(ctx context.Context, c *client.Client) ([]playgroundSettingsAPIResponse, error) {
+	return client.GetAll[playgroundSettingsAPIResponse](ctx, c, "/api/v1/playground-settings", nil)
+}
+
 // mapPlaygroundSettingsResponseToState corrals the API response into the Terraform
 // state model, handling nullable name/description fields and raw JSON settings.
 func mapPlaygroundSettingsResponseToState(data *PlaygroundSettingsResourceModel, result *playgroundSettingsAPIResponse) {
@@ -306,6 +349,9 @@ func mapPlaygroundSettingsResponseToState(data *PlaygroundSettingsResourceModel,
 
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.UpdatedAt = types.StringValue(result.UpdatedAt)
+	if result.TenantID != "" {
+		data.TenantID = types.StringValue(result.TenantID)
+	}
 
 	// Stash the options in state -- like Miss Kitty's lockbox, it holds
 	// whatever JSON valuables the API sent back from the Long Branch.
@@ -0,0 +1,39 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// errPollTimeout is returned by poll when the deadline passes before the
+// check is satisfied.
+var errPollTimeout = errors.New("timed out")
+
+// poll runs check right away and then once every interval until it reports
+// done, returns an error, the timeout runs out, or ctx is cancelled. A check
+// error is handed back as-is; running out of time returns errPollTimeout, so
+// callers can tell the two apart and say something useful about each.
+func poll(ctx context.Context, interval, timeout time.Duration, check func(ctx context.Context) (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return errPollTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -44,6 +44,7 @@ type ProjectDataSourceModel struct {
 	TenantID           types.String `tfsdk:"tenant_id"`
 	StartTime          types.String `tfsdk:"start_time"`
 	RunCount           types.Int64  `tfsdk:"run_count"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
 }
 
 // projectDataSourceAPIResponse is the API response for a project lookup.
@@ -68,6 +69,7 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Use this data source to look up a LangSmith project by ID or name.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the project. Either `id` or `name` must be specified.",
 				Optional:            true,
@@ -119,16 +121,16 @@ func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = c
+	d.client = data.client
 }
 
 func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -138,6 +140,8 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	idSet := !data.ID.IsNull() && !data.ID.IsUnknown()
 	nameSet := !data.Name.IsNull() && !data.Name.IsUnknown()
 
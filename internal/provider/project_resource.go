@@ -8,11 +8,16 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -20,8 +25,9 @@ import (
 )
 
 var (
-	_ resource.Resource                = &ProjectResource{}
-	_ resource.ResourceWithImportState = &ProjectResource{}
+	_ resource.Resource                   = &ProjectResource{}
+	_ resource.ResourceWithImportState    = &ProjectResource{}
+	_ resource.ResourceWithValidateConfig = &ProjectResource{}
 )
 
 // NewProjectResource constructs a fresh ProjectResource, ready to wrangle
@@ -45,9 +51,11 @@ type ProjectResourceModel struct {
 	DefaultDatasetID   types.String `tfsdk:"default_dataset_id"`
 	ReferenceDatasetID types.String `tfsdk:"reference_dataset_id"`
 	Extra              types.String `tfsdk:"extra"`
+	Tags               types.List   `tfsdk:"tags"`
 	TraceTier          types.String `tfsdk:"trace_tier"`
 	TenantID           types.String `tfsdk:"tenant_id"`
 	StartTime          types.String `tfsdk:"start_time"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
 }
 
 // projectAPIRequest is the wire format for creating or updating a project via
@@ -83,6 +91,7 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith project (tracer session).",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the project.",
 				Computed:            true,
@@ -109,11 +118,28 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"extra": schema.StringAttribute{
 				MarkdownDescription: "JSON string containing extra metadata for the project.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Tags for the project, kept under the `tags` key of its `extra` metadata. Order doesn't matter; tags the API hands back in a different order aren't drift. Leave `tags` out of `extra` when this is set.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
 			},
 			"trace_tier": schema.StringAttribute{
-				MarkdownDescription: "The trace retention tier for the project. Valid values: `longlived`, `shortlived`.",
+				MarkdownDescription: "The trace retention tier for the project. Valid values: `longlived`, `shortlived`. Defaults to whatever the workspace chooses.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("longlived", "shortlived"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"tenant_id": schema.StringAttribute{
 				MarkdownDescription: "The tenant ID of the project.",
@@ -132,16 +158,16 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -151,6 +177,8 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := projectAPIRequest{
 		Name: data.Name.ValueString(),
 	}
@@ -167,9 +195,12 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		v := data.ReferenceDatasetID.ValueString()
 		body.ReferenceDatasetID = &v
 	}
-	if !data.Extra.IsNull() && !data.Extra.IsUnknown() {
-		body.Extra = json.RawMessage(data.Extra.ValueString())
+	extra, diags := projectExtra(ctx, &data, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	body.Extra = extra
 	// A trace's tier determines how long it stays on the prairie before fading away.
 	if !data.TraceTier.IsNull() && !data.TraceTier.IsUnknown() {
 		v := data.TraceTier.ValueString()
@@ -177,7 +208,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var result projectAPIResponse
-	err := r.client.Post(ctx, "/api/v1/sessions", body, &result)
+	err := r.client.Create(ctx, "/api/v1/sessions", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating project", err.Error())
 		return
@@ -196,6 +227,8 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result projectAPIResponse
 	err := r.client.Get(ctx, "/api/v1/sessions/"+data.ID.ValueString(), nil, &result)
 	if err != nil {
@@ -219,6 +252,8 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := projectAPIRequest{
 		Name: data.Name.ValueString(),
 	}
@@ -235,9 +270,16 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		v := data.ReferenceDatasetID.ValueString()
 		body.ReferenceDatasetID = &v
 	}
-	if !data.Extra.IsNull() && !data.Extra.IsUnknown() {
-		body.Extra = json.RawMessage(data.Extra.ValueString())
+	// Tags dropped from the config have to be cleared out of extra, which
+	// means sending extra even when it isn't set.
+	var priorTags types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags"), &priorTags)...)
+	extra, diags := projectExtra(ctx, &data, !priorTags.IsNull())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	body.Extra = extra
 	// Even Marshal Dillon knows you can't outrun a retention policy.
 	if !data.TraceTier.IsNull() && !data.TraceTier.IsUnknown() {
 		v := data.TraceTier.ValueString()
@@ -264,6 +306,8 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/sessions/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting project", err.Error())
@@ -273,7 +317,37 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	tflog.Trace(ctx, "deleted project resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Tags.IsNull() || data.Extra.IsNull() || data.Extra.IsUnknown() {
+		return
+	}
+
+	var extra map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data.Extra.ValueString()), &extra); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("extra"),
+			"Invalid Extra",
+			"When tags is set, extra must be a JSON object for the tags to be kept in.",
+		)
+		return
+	}
+	if _, ok := extra["tags"]; ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("extra"),
+			"Conflicting Project Tags",
+			"Set tags with the tags attribute or inside extra, not both.",
+		)
+	}
+}
+
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -301,11 +375,7 @@ func mapProjectResponseToState(data *ProjectResourceModel, result *projectAPIRes
 		data.ReferenceDatasetID = types.StringNull()
 	}
 
-	if len(result.Extra) > 0 && string(result.Extra) != "null" {
-		data.Extra = types.StringValue(string(result.Extra))
-	} else {
-		data.Extra = types.StringNull()
-	}
+	mapProjectExtra(data, result.Extra)
 
 	if result.TraceTier != nil {
 		data.TraceTier = types.StringValue(*result.TraceTier)
@@ -316,3 +386,93 @@ func mapProjectResponseToState(data *ProjectResourceModel, result *projectAPIRes
 	data.TenantID = types.StringValue(result.TenantID)
 	data.StartTime = types.StringValue(result.StartTime)
 }
+
+// projectExtra builds the extra sent to the API, folding the tags in under
+// the "tags" key. With clear set, extra is sent even when there's nothing in
+// it, so tags that were there before are wiped.
+func projectExtra(ctx context.Context, data *ProjectResourceModel, clear bool) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var extra json.RawMessage
+	if !data.Extra.IsNull() && !data.Extra.IsUnknown() {
+		extra = json.RawMessage(data.Extra.ValueString())
+	}
+	if data.Tags.IsNull() || data.Tags.IsUnknown() {
+		if extra == nil && clear {
+			extra = json.RawMessage("{}")
+		}
+		return extra, diags
+	}
+
+	fields := map[string]json.RawMessage{}
+	if extra != nil {
+		if err := json.Unmarshal(extra, &fields); err != nil {
+			diags.AddAttributeError(path.Root("extra"), "Invalid Extra", "When tags is set, extra must be a JSON object for the tags to be kept in.")
+			return nil, diags
+		}
+	}
+
+	var tags []string
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	encoded, err := json.Marshal(tags)
+	if err != nil {
+		diags.AddError("Error encoding project tags", err.Error())
+		return nil, diags
+	}
+	fields["tags"] = encoded
+
+	body, err := json.Marshal(fields)
+	if err != nil {
+		diags.AddError("Error encoding project extra", err.Error())
+		return nil, diags
+	}
+	return body, diags
+}
+
+// mapProjectExtra splits the API's extra into the tags and the rest. Tags
+// keep their prior order when the API shuffles them, and the rest keeps its
+// prior spelling when it means the same thing.
+func mapProjectExtra(data *ProjectResourceModel, raw json.RawMessage) {
+	if len(raw) == 0 || string(raw) == "null" {
+		data.Extra = types.StringNull()
+		data.Tags = types.ListNull(types.StringType)
+		return
+	}
+
+	var fields map[string]json.RawMessage
+	var tags []string
+	if json.Unmarshal(raw, &fields) != nil || fields["tags"] == nil || json.Unmarshal(fields["tags"], &tags) != nil {
+		data.Extra = types.StringValue(string(raw))
+		data.Tags = types.ListNull(types.StringType)
+		return
+	}
+
+	var prior []string
+	for _, v := range data.Tags.Elements() {
+		if s, ok := v.(types.String); ok {
+			prior = append(prior, s.ValueString())
+		}
+	}
+	if !sameStringSet(prior, tags) || data.Tags.IsNull() || data.Tags.IsUnknown() {
+		values := make([]attr.Value, 0, len(tags))
+		for _, tag := range tags {
+			values = append(values, types.StringValue(tag))
+		}
+		data.Tags = types.ListValueMust(types.StringType, values)
+	}
+
+	delete(fields, "tags")
+	if len(fields) == 0 && data.Extra.IsNull() {
+		return
+	}
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return
+	}
+	if data.Extra.IsNull() || data.Extra.IsUnknown() || !jsonSemanticallyEqual(data.Extra.ValueString(), string(rest)) {
+		data.Extra = types.StringValue(string(rest))
+	}
+}
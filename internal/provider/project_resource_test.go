@@ -4,9 +4,14 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -68,3 +73,66 @@ resource "langsmith_project" "test" {
 }
 `, name)
 }
+
+// TestProjectTags checks tags ride inside extra on the way out, come back out
+// of it on the way in without drift when the API reorders them, and can't
+// be set in both places.
+func TestProjectTags(t *testing.T) {
+	ctx := context.Background()
+	tags := func(values ...string) types.List {
+		list, _ := types.ListValueFrom(ctx, types.StringType, values)
+		return list
+	}
+
+	data := &ProjectResourceModel{
+		Extra: types.StringValue(`{"metadata": {"team": "ml"}}`),
+		Tags:  tags("prod", "chatbot"),
+	}
+	body, diags := projectExtra(ctx, data, false)
+	if diags.HasError() || !jsonSemanticallyEqual(string(body), `{"metadata": {"team": "ml"}, "tags": ["prod", "chatbot"]}`) {
+		t.Errorf("unexpected extra %s: %v", body, diags)
+	}
+
+	mapProjectExtra(data, json.RawMessage(`{"tags": ["chatbot", "prod"], "metadata": {"team": "ml"}}`))
+	if !data.Tags.Equal(tags("prod", "chatbot")) {
+		t.Errorf("expected the configured tag order to be kept, got %s", data.Tags)
+	}
+	if data.Extra.ValueString() != `{"metadata": {"team": "ml"}}` {
+		t.Errorf("expected extra without the tags, got %s", data.Extra)
+	}
+
+	imported := &ProjectResourceModel{Extra: types.StringNull(), Tags: types.ListNull(types.StringType)}
+	mapProjectExtra(imported, json.RawMessage(`{"tags": ["a", "b"]}`))
+	if !imported.Tags.Equal(tags("a", "b")) || !imported.Extra.IsNull() {
+		t.Errorf("unexpected import tags %s, extra %s", imported.Tags, imported.Extra)
+	}
+
+	// Dropping every tag still has to send an extra that clears them.
+	cleared, _ := projectExtra(ctx, &ProjectResourceModel{Extra: types.StringNull(), Tags: types.ListNull(types.StringType)}, true)
+	if string(cleared) != "{}" {
+		t.Errorf("expected an empty extra to clear the tags, got %s", cleared)
+	}
+
+	configDiags := testValidateResourceConfig(t, "langsmith_project", map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "p"),
+		"extra": tftypes.NewValue(tftypes.String, `{"tags": ["x"]}`),
+		"tags":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "y")}),
+	})
+	if !testDiagnosticsHaveSeverity(configDiags, tfprotov6.DiagnosticSeverityError, "Conflicting Project Tags") {
+		t.Errorf("expected tags in both places to be an error, got %v", configDiags)
+	}
+}
+
+// TestProjectResource_traceTier checks a mistyped trace tier is caught before
+// it reaches the API.
+func TestProjectResource_traceTier(t *testing.T) {
+	for tier, wantErr := range map[string]bool{"longlived": false, "shortlived": false, "long-lived": true, "LONGLIVED": true} {
+		diags := testValidateResourceConfig(t, "langsmith_project", map[string]tftypes.Value{
+			"name":       tftypes.NewValue(tftypes.String, "p"),
+			"trace_tier": tftypes.NewValue(tftypes.String, tier),
+		})
+		if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, ""); got != wantErr {
+			t.Errorf("%q: expected error %v, got %v", tier, wantErr, diags)
+		}
+	}
+}
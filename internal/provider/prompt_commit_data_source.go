@@ -8,8 +8,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -32,10 +34,12 @@ type PromptCommitDataSource struct {
 
 // PromptCommitDataSourceModel holds the attributes for a prompt commit lookup.
 type PromptCommitDataSourceModel struct {
-	RepoHandle types.String `tfsdk:"repo_handle"`
-	Ref        types.String `tfsdk:"ref"`
-	CommitHash types.String `tfsdk:"commit_hash"`
-	Manifest   types.String `tfsdk:"manifest"`
+	RepoHandle     types.String `tfsdk:"repo_handle"`
+	Ref            types.String `tfsdk:"ref"`
+	CommitHash     types.String `tfsdk:"commit_hash"`
+	Manifest       types.String `tfsdk:"manifest"`
+	ManifestFormat types.String `tfsdk:"manifest_format"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
 }
 
 // promptCommitDataSourceAPIResponse is the API shape for GET /commits/-/{repo}/{ref}.
@@ -52,6 +56,7 @@ func (d *PromptCommitDataSource) Schema(ctx context.Context, req datasource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Use this data source to read a specific commit from a LangSmith prompt repo by hash, tag name, or `latest`.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
 			"repo_handle": schema.StringAttribute{
 				MarkdownDescription: "The handle of the prompt repo.",
 				Required:            true,
@@ -65,9 +70,16 @@ func (d *PromptCommitDataSource) Schema(ctx context.Context, req datasource.Sche
 				Computed:            true,
 			},
 			"manifest": schema.StringAttribute{
-				MarkdownDescription: "JSON string of the prompt manifest (LangChain serialization format).",
+				MarkdownDescription: "JSON string of the prompt manifest, in the format set by `manifest_format`.",
 				Computed:            true,
 			},
+			"manifest_format": schema.StringAttribute{
+				MarkdownDescription: promptManifestFormatDescription,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(promptManifestFormatLangChain, promptManifestFormatRaw),
+				},
+			},
 		},
 	}
 }
@@ -77,16 +89,16 @@ func (d *PromptCommitDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = c
+	d.client = data.client
 }
 
 func (d *PromptCommitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -96,6 +108,8 @@ func (d *PromptCommitDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	ref := "latest"
 	if !data.Ref.IsNull() && !data.Ref.IsUnknown() && data.Ref.ValueString() != "" {
 		ref = data.Ref.ValueString()
@@ -111,7 +125,12 @@ func (d *PromptCommitDataSource) Read(ctx context.Context, req datasource.ReadRe
 	data.CommitHash = types.StringValue(result.CommitHash)
 
 	if len(result.Manifest) > 0 && string(result.Manifest) != "null" {
-		data.Manifest = types.StringValue(string(result.Manifest))
+		manifest, err := convertPromptManifest(string(result.Manifest), data.ManifestFormat.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading prompt manifest", err.Error())
+			return
+		}
+		data.Manifest = types.StringValue(manifest)
 	} else {
 		data.Manifest = types.StringNull()
 	}
@@ -0,0 +1,174 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The formats a prompt manifest can be read and written in. The API only
+// speaks LangChain serialization, so raw manifests are translated on the way
+// in and out.
+const (
+	promptManifestFormatLangChain = "langchain"
+	promptManifestFormatRaw       = "raw"
+)
+
+// promptManifestTypeKey names the field a raw manifest keeps an object's
+// LangChain class in, as a dotted path like
+// "langchain.prompts.chat.ChatPromptTemplate".
+const promptManifestTypeKey = "_type"
+
+// promptManifestFormatDescription is the manifest_format attribute's
+// description, shared by the resource and the data source.
+const promptManifestFormatDescription = "The format `manifest` is in. `langchain` (the default) is LangChain's serialization format, as stored by the API. " +
+	"`raw` drops the serialization envelope, so each object holds its own fields, with its LangChain class kept in `_type`: " +
+	"`{\"_type\": \"langchain.prompts.prompt.PromptTemplate\", \"template\": \"...\", \"input_variables\": [...]}`."
+
+// convertPromptManifest translates a manifest from LangChain serialization
+// into format. An empty format means LangChain.
+func convertPromptManifest(manifest, format string) (string, error) {
+	if format != promptManifestFormatRaw {
+		return manifest, nil
+	}
+	return transformPromptManifest(manifest, rawPromptManifestValue)
+}
+
+// langchainPromptManifest translates a manifest in format back into the
+// LangChain serialization the API expects.
+func langchainPromptManifest(manifest, format string) (string, error) {
+	if format != promptManifestFormatRaw {
+		return manifest, nil
+	}
+	return transformPromptManifest(manifest, langchainPromptManifestValue)
+}
+
+// promptManifestsEqual reports whether two manifests, each in its own
+// format, hold the same prompt.
+func promptManifestsEqual(a, aFormat, b, bFormat string) bool {
+	a, err := langchainPromptManifest(a, aFormat)
+	if err != nil {
+		return false
+	}
+	b, err = langchainPromptManifest(b, bFormat)
+	if err != nil {
+		return false
+	}
+	return jsonSemanticallyEqual(a, b)
+}
+
+// transformPromptManifest decodes a manifest, rewrites it with transform and
+// encodes it again. Numbers are kept exactly as written.
+func transformPromptManifest(manifest string, transform func(interface{}) interface{}) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(manifest))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("decoding manifest: %w", err)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(transform(v)); err != nil {
+		return "", fmt.Errorf("encoding manifest: %w", err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// rawPromptManifestValue replaces every LangChain constructor envelope,
+// {"lc": 1, "type": "constructor", "id": [...], "kwargs": {...}}, with its
+// kwargs and a _type naming the class.
+func rawPromptManifestValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if id, kwargs, ok := langchainConstructor(v); ok {
+			raw := map[string]interface{}{promptManifestTypeKey: strings.Join(id, ".")}
+			for k, field := range kwargs {
+				raw[k] = rawPromptManifestValue(field)
+			}
+			return raw
+		}
+		for k, field := range v {
+			v[k] = rawPromptManifestValue(field)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = rawPromptManifestValue(elem)
+		}
+	}
+	return v
+}
+
+// langchainPromptManifestValue undoes rawPromptManifestValue, wrapping every
+// object with a dotted _type back up in a constructor envelope.
+func langchainPromptManifestValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		class, _ := v[promptManifestTypeKey].(string)
+		if !strings.Contains(class, ".") {
+			for k, field := range v {
+				v[k] = langchainPromptManifestValue(field)
+			}
+			return v
+		}
+
+		kwargs := make(map[string]interface{}, len(v)-1)
+		for k, field := range v {
+			if k != promptManifestTypeKey {
+				kwargs[k] = langchainPromptManifestValue(field)
+			}
+		}
+		id := make([]interface{}, 0, strings.Count(class, ".")+1)
+		for _, part := range strings.Split(class, ".") {
+			id = append(id, part)
+		}
+		return map[string]interface{}{
+			"lc":     json.Number("1"),
+			"type":   "constructor",
+			"id":     id,
+			"kwargs": kwargs,
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = langchainPromptManifestValue(elem)
+		}
+	}
+	return v
+}
+
+// langchainConstructor picks apart a LangChain constructor envelope, giving
+// its class path and kwargs.
+func langchainConstructor(v map[string]interface{}) ([]string, map[string]interface{}, bool) {
+	if v["type"] != "constructor" || len(v) != 4 {
+		return nil, nil, false
+	}
+	if _, ok := v["lc"]; !ok {
+		return nil, nil, false
+	}
+	kwargs, ok := v["kwargs"].(map[string]interface{})
+	if !ok {
+		return nil, nil, false
+	}
+	if _, taken := kwargs[promptManifestTypeKey]; taken {
+		return nil, nil, false
+	}
+	ids, ok := v["id"].([]interface{})
+	if !ok || len(ids) == 0 {
+		return nil, nil, false
+	}
+
+	id := make([]string, 0, len(ids))
+	for _, part := range ids {
+		s, ok := part.(string)
+		if !ok || s == "" || strings.Contains(s, ".") {
+			return nil, nil, false
+		}
+		id = append(id, s)
+	}
+	return id, kwargs, true
+}
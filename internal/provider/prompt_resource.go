@@ -7,13 +7,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -23,6 +30,7 @@ import (
 var (
 	_ resource.Resource                = &PromptResource{}
 	_ resource.ResourceWithImportState = &PromptResource{}
+	_ resource.ResourceWithModifyPlan  = &PromptResource{}
 )
 
 // NewPromptResource saddles up a fresh PromptResource, ready to ride.
@@ -41,6 +49,7 @@ type PromptResourceModel struct {
 	ID             types.String `tfsdk:"id"`
 	RepoHandle     types.String `tfsdk:"repo_handle"`
 	Manifest       types.String `tfsdk:"manifest"`
+	ManifestFormat types.String `tfsdk:"manifest_format"`
 	IsPublic       types.Bool   `tfsdk:"is_public"`
 	Description    types.String `tfsdk:"description"`
 	Readme         types.String `tfsdk:"readme"`
@@ -57,6 +66,9 @@ type PromptResourceModel struct {
 	LastCommitHash types.String `tfsdk:"last_commit_hash"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ForceDestroy   types.Bool   `tfsdk:"force_destroy"`
+	AllowPublish   types.Bool   `tfsdk:"allow_publish"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
 }
 
 // promptCreateRequest is the payload for staking a new claim in the Hub.
@@ -91,7 +103,8 @@ type promptCommitResponse struct {
 	} `json:"commit"`
 }
 
-// promptLatestCommitResponse is the shape of GET /commits/-/{repo}/latest.
+// promptLatestCommitResponse is the shape of GET /commits/-/{repo}/latest, and
+// of GET /commits/-/{repo}/{commit_hash} for a particular commit.
 type promptLatestCommitResponse struct {
 	CommitHash string          `json:"commit_hash"`
 	Manifest   json.RawMessage `json:"manifest"`
@@ -129,25 +142,39 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a prompt (repo) in the LangSmith Hub.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the prompt repo.",
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"repo_handle": schema.StringAttribute{
-				MarkdownDescription: "The name/handle of the prompt repo.",
-				Required:            true,
+				MarkdownDescription: "The name/handle of the prompt repo. LangSmith can't rename a prompt repo, so changing this destroys the prompt, " +
+					"along with every commit in its history and its tags, and creates a new one holding only the current `manifest`.",
+				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"manifest": schema.StringAttribute{
-				MarkdownDescription: "JSON string of the prompt manifest (LangChain serialization format). This is the actual prompt content — the template, messages, and variables. Setting this creates a new commit in the prompt repo.",
+				MarkdownDescription: "JSON string of the prompt manifest, in the format set by `manifest_format`. This is the actual prompt content — the template, messages, and variables. Setting this creates a new commit in the prompt repo.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
+			},
+			"manifest_format": schema.StringAttribute{
+				MarkdownDescription: promptManifestFormatDescription + " Switching formats doesn't change the prompt's content or create a commit.",
 				Optional:            true,
 				Computed:            true,
+				Default:             stringdefault.StaticString(promptManifestFormatLangChain),
+				Validators: []validator.String{
+					stringvalidator.OneOf(promptManifestFormatLangChain, promptManifestFormatRaw),
+				},
 			},
 			"is_public": schema.BoolAttribute{
-				MarkdownDescription: "Whether the prompt is publicly accessible.",
+				MarkdownDescription: "Whether the prompt is publicly accessible. Making an existing private prompt public also needs `allow_publish = true`.",
 				Required:            true,
 			},
 			"description": schema.StringAttribute{
@@ -179,8 +206,12 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"commit_hash": schema.StringAttribute{
-				MarkdownDescription: "The hash of the current commit — the latest brand on the cattle.",
+				MarkdownDescription: "The hash of the commit whose content `manifest` holds. Leave unset to follow the latest commit, in which case this is computed. Set it to pin the prompt at a known commit: `manifest` is then read from that commit, and `description`, `readme`, `tags`, and the rest can still be managed. Conflicts with `manifest`. Unlike `last_commit_hash`, which always reports the repo's newest commit, this stays where you pin it.",
+				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("manifest")),
+				},
 			},
 			"tenant_id": schema.StringAttribute{
 				MarkdownDescription: "The tenant ID that owns this prompt.",
@@ -214,6 +245,18 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "When the prompt was last updated.",
 				Computed:            true,
 			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "When `false` (the default), destroying the prompt fails if any tags point at its commits or any run rule evaluator references it, and the error lists those dependents. Set to `true` and apply before destroying to delete the prompt regardless.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_publish": schema.BoolAttribute{
+				MarkdownDescription: "When `false` (the default), a plan that turns `is_public` from `false` to `true` on an existing prompt fails, so private prompt content isn't published by accident. Set to `true` alongside `is_public = true` to publish the prompt. Prompts created public aren't affected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -222,13 +265,13 @@ func (r *PromptResource) Configure(ctx context.Context, req resource.ConfigureRe
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
 		return
 	}
-	r.client = c
+	r.client = data.client
 }
 
 func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -238,6 +281,8 @@ func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := promptCreateRequest{
 		RepoHandle: data.RepoHandle.ValueString(),
 		IsPublic:   data.IsPublic.ValueBool(),
@@ -258,7 +303,7 @@ func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	var result promptAPIResponse
-	err := r.client.Post(ctx, "/api/v1/repos", body, &result)
+	err := r.client.Create(ctx, "/api/v1/repos", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating prompt", err.Error())
 		return
@@ -272,11 +317,16 @@ func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// If the trail boss brought a manifest, commit it to the repo right away.
 	if !data.Manifest.IsNull() && !data.Manifest.IsUnknown() {
+		manifest, err := langchainPromptManifest(data.Manifest.ValueString(), data.ManifestFormat.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid Prompt Manifest", err.Error())
+			return
+		}
 		commitBody := promptCommitRequest{
-			Manifest: json.RawMessage(data.Manifest.ValueString()),
+			Manifest: json.RawMessage(manifest),
 		}
 		var commitResult promptCommitResponse
-		err := r.client.Post(ctx, fmt.Sprintf("/commits/-/%s", data.RepoHandle.ValueString()), commitBody, &commitResult)
+		err = r.client.Create(ctx, fmt.Sprintf("/commits/-/%s", data.RepoHandle.ValueString()), commitBody, &commitResult)
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating prompt commit", err.Error())
 			return
@@ -291,6 +341,16 @@ func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.NumCommits = types.Int64Value(0)
 	}
 
+	pinned := r.pinnedCommit(ctx, req.Config, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, promptPinnedCommitKey, pinnedCommitValue(pinned))...)
+	if !pinned.IsNull() {
+		data.CommitHash = pinned
+		if err := r.readPromptManifest(ctx, &data, data.RepoHandle.ValueString(), true); err != nil {
+			resp.Diagnostics.AddError("Error reading pinned prompt commit", err.Error())
+			return
+		}
+	}
+
 	// Set remaining computed fields that the create response may not populate.
 	data.IsArchived = types.BoolValue(result.Repo.IsArchived)
 	data.TenantID = types.StringValue(result.Repo.TenantID)
@@ -309,6 +369,8 @@ func (r *PromptResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	owner := data.Owner.ValueString()
 	repoHandle := data.RepoHandle.ValueString()
 	if data.FullName.ValueString() != "" {
@@ -333,6 +395,15 @@ func (r *PromptResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.ID = types.StringValue(result.Repo.ID)
 	data.RepoHandle = types.StringValue(result.Repo.RepoHandle)
 	data.IsPublic = types.BoolValue(result.Repo.IsPublic)
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+	if data.AllowPublish.IsNull() {
+		data.AllowPublish = types.BoolValue(false)
+	}
+	if data.ManifestFormat.IsNull() {
+		data.ManifestFormat = types.StringValue(promptManifestFormatLangChain)
+	}
 	data.IsArchived = types.BoolValue(result.Repo.IsArchived)
 	data.Owner = types.StringValue(result.Owner)
 	data.FullName = types.StringValue(result.FullName)
@@ -369,26 +440,16 @@ func (r *PromptResource) Read(ctx context.Context, req resource.ReadRequest, res
 		data.Tags = types.ListNull(types.StringType)
 	}
 
-	// Ride over to the commits corral and fetch the latest manifest.
+	// Ride over to the commits corral and fetch the manifest -- from the
+	// pinned commit if there is one, otherwise the latest.
+	pinned, diags := req.Private.GetKey(ctx, promptPinnedCommitKey)
+	resp.Diagnostics.Append(diags...)
 	if result.Repo.NumCommits > 0 {
-		var latestCommit promptLatestCommitResponse
-		commitErr := r.client.Get(ctx, fmt.Sprintf("/commits/-/%s/latest", repoHandle), nil, &latestCommit)
-		if commitErr != nil {
+		if commitErr := r.readPromptManifest(ctx, &data, repoHandle, len(pinned) > 0); commitErr != nil {
 			resp.Diagnostics.AddWarning("Error reading prompt manifest", commitErr.Error())
-		} else {
-			data.CommitHash = types.StringValue(latestCommit.CommitHash)
-			if len(latestCommit.Manifest) > 0 && string(latestCommit.Manifest) != "null" {
-				data.Manifest = types.StringValue(string(latestCommit.Manifest))
-			} else {
-				data.Manifest = types.StringNull()
-			}
 		}
 	} else {
-		data.CommitHash = types.StringNull()
-		// Only null out manifest if user hasn't set it in config.
-		if data.Manifest.IsUnknown() {
-			data.Manifest = types.StringNull()
-		}
+		settleUncommittedPrompt(&data)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -401,12 +462,21 @@ func (r *PromptResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var state PromptResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Checked at plan time too; this catches an allow_publish that wasn't
+	// known until apply.
+	if promptPublishBlocked(state, data) {
+		resp.Diagnostics.AddAttributeError(path.Root("is_public"), "Publishing Prompt Not Allowed", promptPublishDetail(data))
+		return
+	}
+
 	owner := state.Owner.ValueString()
 	repoHandle := state.RepoHandle.ValueString()
 
@@ -441,11 +511,17 @@ func (r *PromptResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// If the manifest has changed, commit the new version.
+	// If the manifest has changed, commit the new version. The same prompt
+	// in another format is no change at all.
 	if !data.Manifest.IsNull() && !data.Manifest.IsUnknown() &&
-		data.Manifest.ValueString() != state.Manifest.ValueString() {
+		!promptManifestsEqual(data.Manifest.ValueString(), data.ManifestFormat.ValueString(), state.Manifest.ValueString(), state.ManifestFormat.ValueString()) {
+		manifest, err := langchainPromptManifest(data.Manifest.ValueString(), data.ManifestFormat.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid Prompt Manifest", err.Error())
+			return
+		}
 		commitBody := promptCommitRequest{
-			Manifest: json.RawMessage(data.Manifest.ValueString()),
+			Manifest: json.RawMessage(manifest),
 		}
 		var commitResult promptCommitResponse
 		commitErr := r.client.Post(ctx, fmt.Sprintf("/commits/-/%s", repoHandle), commitBody, &commitResult)
@@ -484,25 +560,175 @@ func (r *PromptResource) Update(ctx context.Context, req resource.UpdateRequest,
 		data.LastCommitHash = types.StringNull()
 	}
 
-	// Fetch the latest manifest if we haven't just committed one.
-	if data.CommitHash.IsNull() || data.CommitHash.IsUnknown() {
+	pinned := r.pinnedCommit(ctx, req.Config, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, promptPinnedCommitKey, pinnedCommitValue(pinned))...)
+
+	// Fetch the pinned manifest, or the latest if we haven't just committed one.
+	if !pinned.IsNull() {
+		data.CommitHash = pinned
+		if err := r.readPromptManifest(ctx, &data, repoHandle, true); err != nil {
+			resp.Diagnostics.AddError("Error reading pinned prompt commit", err.Error())
+			return
+		}
+	} else if data.CommitHash.IsNull() || data.CommitHash.IsUnknown() {
 		if result.Repo.NumCommits > 0 {
 			var latestCommit promptLatestCommitResponse
 			commitErr := r.client.Get(ctx, fmt.Sprintf("/commits/-/%s/latest", repoHandle), nil, &latestCommit)
 			if commitErr == nil {
 				data.CommitHash = types.StringValue(latestCommit.CommitHash)
 				if len(latestCommit.Manifest) > 0 && string(latestCommit.Manifest) != "null" {
-					data.Manifest = types.StringValue(string(latestCommit.Manifest))
+					if err := setPromptManifest(&data, latestCommit.Manifest); err != nil {
+						resp.Diagnostics.AddError("Error reading prompt manifest", err.Error())
+						return
+					}
 				}
 			}
 		} else {
-			data.CommitHash = types.StringNull()
+			settleUncommittedPrompt(&data)
 		}
 	}
 
+	// Whatever the latest commit couldn't tell us, there's nothing more to
+	// know; don't leave it hanging unknown.
+	if data.CommitHash.IsUnknown() {
+		data.CommitHash = types.StringNull()
+	}
+	if data.Manifest.IsUnknown() {
+		data.Manifest = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// settleUncommittedPrompt squares data with a repo that has no commits. There's
+// no commit hash to hold, but a manifest already in state or config is kept:
+// it's waiting on its first commit, not gone, and reading it away would only
+// plan to put it right back.
+func settleUncommittedPrompt(data *PromptResourceModel) {
+	data.CommitHash = types.StringNull()
+	if data.Manifest.IsUnknown() {
+		data.Manifest = types.StringNull()
+	}
+}
+
+// promptPinnedCommitKey is the private state key recording that commit_hash
+// was set in config, as opposed to computed from the latest commit. State
+// alone can't tell the two apart, and Read needs to know which to fetch.
+const promptPinnedCommitKey = "pinned_commit"
+
+// ModifyPlan turns away a plan that would make a private prompt public
+// without allow_publish. One stray `true` shouldn't be all it takes to put
+// internal prompts out on the street. It also warns when a new repo_handle
+// is about to take the prompt's commit history out back.
+func (r *PromptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan PromptResourceModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_public"), &state.IsPublic)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_public"), &plan.IsPublic)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_publish"), &plan.AllowPublish)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("repo_handle"), &state.RepoHandle)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("repo_handle"), &plan.RepoHandle)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RepoHandle.IsUnknown() && !plan.RepoHandle.Equal(state.RepoHandle) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("repo_handle"),
+			"Renaming Prompt Replaces It",
+			fmt.Sprintf("LangSmith can't rename prompt repos, so changing repo_handle from %q to %q destroys the prompt and creates a new one. "+
+				"Every commit in its history is lost, along with its tags, and only the current manifest is committed to the new repo. "+
+				"To keep the history, leave repo_handle as it is.", state.RepoHandle.ValueString(), plan.RepoHandle.ValueString()),
+		)
+	}
+
+	if plan.AllowPublish.IsUnknown() {
+		return
+	}
+
+	if promptPublishBlocked(state, plan) {
+		resp.Diagnostics.AddAttributeError(path.Root("is_public"), "Publishing Prompt Not Allowed", promptPublishDetail(plan))
+	}
+}
+
+// promptPublishBlocked reports whether going from state to plan would make a
+// private prompt public without allow_publish.
+func promptPublishBlocked(state, plan PromptResourceModel) bool {
+	return !state.IsPublic.IsNull() && !state.IsPublic.ValueBool() &&
+		plan.IsPublic.ValueBool() && !plan.AllowPublish.ValueBool()
+}
+
+// promptPublishDetail explains how to publish a prompt on purpose.
+func promptPublishDetail(plan PromptResourceModel) string {
+	return fmt.Sprintf("This change makes prompt %q public, exposing its content to anyone. "+
+		"If that's intended, set allow_publish = true alongside is_public = true; otherwise keep is_public = false.", plan.RepoHandle.ValueString())
+}
+
+// pinnedCommit returns the commit hash pinned in config, or null if the
+// prompt follows the latest commit.
+func (r *PromptResource) pinnedCommit(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) types.String {
+	var pinned types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("commit_hash"), &pinned)...)
+	if pinned.IsUnknown() {
+		return types.StringNull()
+	}
+	return pinned
+}
+
+// pinnedCommitValue is the private state value for a pin: present when
+// pinned, and empty (which clears the key) when not.
+func pinnedCommitValue(pinned types.String) []byte {
+	if pinned.IsNull() {
+		return nil
+	}
+	return []byte("true")
+}
+
+// readPromptManifest fetches a commit and lays its manifest into data. A
+// pinned prompt reads the commit named by data.CommitHash and keeps that hash
+// as written; otherwise the latest commit is read and its hash recorded.
+func (r *PromptResource) readPromptManifest(ctx context.Context, data *PromptResourceModel, repoHandle string, pinned bool) error {
+	ref := "latest"
+	if pinned {
+		ref = data.CommitHash.ValueString()
+	}
+
+	var commit promptLatestCommitResponse
+	if err := r.client.Get(ctx, fmt.Sprintf("/commits/-/%s/%s", repoHandle, url.PathEscape(ref)), nil, &commit); err != nil {
+		return err
+	}
+
+	if !pinned {
+		data.CommitHash = types.StringValue(commit.CommitHash)
+	}
+	if len(commit.Manifest) > 0 && string(commit.Manifest) != "null" {
+		return setPromptManifest(data, commit.Manifest)
+	}
+	data.Manifest = types.StringNull()
+	return nil
+}
+
+// setPromptManifest lays a manifest from the API into data in its
+// manifest_format. A manifest in state holding the same prompt keeps its
+// spelling, so a switch of format doesn't show up as drift.
+func setPromptManifest(data *PromptResourceModel, manifest json.RawMessage) error {
+	format := data.ManifestFormat.ValueString()
+	converted, err := convertPromptManifest(string(manifest), format)
+	if err != nil {
+		return err
+	}
+
+	if !data.Manifest.IsNull() && !data.Manifest.IsUnknown() &&
+		promptManifestsEqual(data.Manifest.ValueString(), format, converted, format) {
+		return nil
+	}
+	data.Manifest = types.StringValue(converted)
+	return nil
+}
+
 func (r *PromptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data PromptResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -510,9 +736,30 @@ func (r *PromptResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	owner := data.Owner.ValueString()
 	repoHandle := data.RepoHandle.ValueString()
 
+	// Look before you leap: tags and run rules that lean on this prompt would
+	// be left hanging. Refuse unless the user has said to ride on through.
+	if !data.ForceDestroy.ValueBool() {
+		dependents, err := findPromptDependents(ctx, r.client, owner, repoHandle)
+		if err != nil {
+			resp.Diagnostics.AddError("Error checking prompt dependents", err.Error())
+			return
+		}
+		if len(dependents) > 0 {
+			resp.Diagnostics.AddError(
+				"Prompt Has Dependents",
+				fmt.Sprintf("The prompt %s/%s is still referenced by:\n\n  - %s\n\n"+
+					"Remove these references first, or set force_destroy = true and apply before destroying.",
+					owner, repoHandle, strings.Join(dependents, "\n  - ")),
+			)
+			return
+		}
+	}
+
 	err := r.client.Delete(ctx, fmt.Sprintf("/api/v1/repos/%s/%s", owner, repoHandle))
 	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error deleting prompt", err.Error())
@@ -520,6 +767,7 @@ func (r *PromptResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *PromptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: owner/repo_handle")
@@ -529,3 +777,75 @@ func (r *PromptResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_handle"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("full_name"), req.ID)...)
 }
+
+// findPromptDependents rounds up everything that still points at a prompt:
+// its version tags and any run rule whose evaluators reference it. Each
+// dependent comes back as a short human-readable description.
+func findPromptDependents(ctx context.Context, c *client.Client, owner, repoHandle string) ([]string, error) {
+	var dependents []string
+
+	var tags []promptTagAPIResponse
+	err := c.Get(ctx, fmt.Sprintf("/api/v1/repos/-/%s/tags", repoHandle), nil, &tags)
+	if err != nil && !client.IsNotFound(err) {
+		return nil, fmt.Errorf("listing prompt tags: %w", err)
+	}
+	for _, tag := range tags {
+		dependents = append(dependents, fmt.Sprintf("tag %q (commit %s)", tag.TagName, tag.CommitHash))
+	}
+
+	rules, err := client.GetAll[runRuleAPIResponse](ctx, c, "/api/v1/runs/rules", nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing run rules: %w", err)
+	}
+	for _, rule := range rules {
+		if jsonReferencesPrompt(rule.Evaluators, owner, repoHandle) {
+			dependents = append(dependents, fmt.Sprintf("run rule %q (%s)", rule.DisplayName, rule.ID))
+		}
+	}
+
+	return dependents, nil
+}
+
+// jsonReferencesPrompt walks a JSON document looking for a hub reference to
+// the prompt — "owner/repo" or "-/repo", optionally pinned with ":commit", or
+// a bare repo handle under a hub_ref key.
+func jsonReferencesPrompt(raw json.RawMessage, owner, repoHandle string) bool {
+	if len(raw) == 0 {
+		return false
+	}
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return false
+	}
+
+	matches := func(key, ref string) bool {
+		ref, _, _ = strings.Cut(ref, ":")
+		if ref == owner+"/"+repoHandle || ref == "-/"+repoHandle {
+			return true
+		}
+		return key == "hub_ref" && ref == repoHandle
+	}
+
+	var walk func(key string, v interface{}) bool
+	walk = func(key string, v interface{}) bool {
+		switch val := v.(type) {
+		case string:
+			return matches(key, val)
+		case []interface{}:
+			for _, item := range val {
+				if walk(key, item) {
+					return true
+				}
+			}
+		case map[string]interface{}:
+			for k, item := range val {
+				if walk(k, item) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return walk("", doc)
+}
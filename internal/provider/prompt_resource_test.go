@@ -0,0 +1,364 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// testPromptServer stands in for the Hub: one repo with a production tag and
+// a run rule whose evaluator leans on it. It records whether the repo was
+// actually deleted.
+func testPromptServer(t *testing.T, deleted *bool) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/-/greeter/tags":
+			_, _ = w.Write([]byte(`[{"id":"t1","tag_name":"production","commit_hash":"abc123"}]`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/runs/rules":
+			_, _ = w.Write([]byte(`[
+				{"id":"r1","display_name":"judge","evaluators":[{"structured":{"hub_ref":"dillon/greeter:abc123"}}]},
+				{"id":"r2","display_name":"unrelated","evaluators":[{"structured":{"hub_ref":"dillon/greeter-v2"}}]}
+			]`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/repos/dillon/greeter":
+			*deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func testPromptModel(force bool) *PromptResourceModel {
+	return &PromptResourceModel{
+		ID:           types.StringValue("p1"),
+		RepoHandle:   types.StringValue("greeter"),
+		Owner:        types.StringValue("dillon"),
+		FullName:     types.StringValue("dillon/greeter"),
+		IsPublic:     types.BoolValue(false),
+		Tags:         types.ListNull(types.StringType),
+		ForceDestroy: types.BoolValue(force),
+	}
+}
+
+// TestPromptResource_deleteGuarded checks a prompt with dependents refuses to
+// go quietly, and names every dependent in the error.
+func TestPromptResource_deleteGuarded(t *testing.T) {
+	var deleted bool
+	srv := testPromptServer(t, &deleted)
+	defer srv.Close()
+
+	r := &PromptResource{client: client.NewClient(srv.URL, "key", "")}
+	req := resource.DeleteRequest{State: testResourceState(t, r, testPromptModel(false))}
+	resp := &resource.DeleteResponse{State: req.State}
+	r.Delete(context.Background(), req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected delete to be refused")
+	}
+	if deleted {
+		t.Error("prompt was deleted despite dependents")
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	for _, want := range []string{`tag "production"`, `run rule "judge"`} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("expected error to mention %s, got: %s", want, detail)
+		}
+	}
+	if strings.Contains(detail, "unrelated") {
+		t.Errorf("error names a rule that references a different prompt: %s", detail)
+	}
+}
+
+// TestPromptResource_deleteForced checks force_destroy rides right past the
+// dependency check.
+func TestPromptResource_deleteForced(t *testing.T) {
+	var deleted bool
+	srv := testPromptServer(t, &deleted)
+	defer srv.Close()
+
+	r := &PromptResource{client: client.NewClient(srv.URL, "key", "")}
+	req := resource.DeleteRequest{State: testResourceState(t, r, testPromptModel(true))}
+	resp := &resource.DeleteResponse{State: req.State}
+	r.Delete(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !deleted {
+		t.Error("expected prompt to be deleted")
+	}
+}
+
+// TestPromptResource_readPinnedCommit checks a pinned prompt reads its
+// manifest from the pinned commit, while an unpinned one follows latest.
+func TestPromptResource_readPinnedCommit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/commits/-/greeter/latest":
+			_, _ = w.Write([]byte(`{"commit_hash":"fff999","manifest":{"template":"Howdy, {name}"}}`))
+		case "/commits/-/greeter/abc123":
+			_, _ = w.Write([]byte(`{"commit_hash":"abc123def456","manifest":{"template":"Evening, {name}"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	r := &PromptResource{client: client.NewClient(srv.URL, "key", "")}
+	ctx := context.Background()
+
+	pinned := testPromptModel(false)
+	pinned.CommitHash = types.StringValue("abc123")
+	if err := r.readPromptManifest(ctx, pinned, "greeter", true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if pinned.CommitHash.ValueString() != "abc123" {
+		t.Errorf("expected the pinned hash to stay as written, got %s", pinned.CommitHash)
+	}
+	if !strings.Contains(pinned.Manifest.ValueString(), "Evening") {
+		t.Errorf("expected the pinned commit's manifest, got %s", pinned.Manifest)
+	}
+
+	latest := testPromptModel(false)
+	latest.CommitHash = types.StringValue("abc123")
+	if err := r.readPromptManifest(ctx, latest, "greeter", false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if latest.CommitHash.ValueString() != "fff999" || !strings.Contains(latest.Manifest.ValueString(), "Howdy") {
+		t.Errorf("expected the latest commit, got %s with %s", latest.CommitHash, latest.Manifest)
+	}
+}
+
+// TestPromptResource_commitHashConflictsWithManifest checks a prompt can't
+// both pin a commit and push a new manifest.
+func TestPromptResource_commitHashConflictsWithManifest(t *testing.T) {
+	diags := testValidateResourceConfig(t, "langsmith_prompt", map[string]tftypes.Value{
+		"repo_handle": tftypes.NewValue(tftypes.String, "greeter"),
+		"is_public":   tftypes.NewValue(tftypes.Bool, false),
+		"commit_hash": tftypes.NewValue(tftypes.String, "abc123"),
+		"manifest":    tftypes.NewValue(tftypes.String, `{"template":"Howdy"}`),
+	})
+	if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "commit_hash") {
+		t.Errorf("expected a conflict error, got %v", diags)
+	}
+}
+
+// TestPromptResource_publishGuard checks a private prompt can't be made public
+// without allow_publish, and that prompts already public, or staying private,
+// plan as before.
+func TestPromptResource_publishGuard(t *testing.T) {
+	cases := map[string]struct {
+		wasPublic, isPublic, allow bool
+		wantErr                    bool
+	}{
+		"publish without allow": {isPublic: true, wantErr: true},
+		"publish with allow":    {isPublic: true, allow: true},
+		"stay private":          {},
+		"stay public":           {wasPublic: true, isPublic: true},
+		"go private":            {wasPublic: true},
+	}
+
+	r := &PromptResource{}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			prior := testPromptModel(false)
+			prior.IsPublic = types.BoolValue(tc.wasPublic)
+			planned := testPromptModel(false)
+			planned.IsPublic = types.BoolValue(tc.isPublic)
+			planned.AllowPublish = types.BoolValue(tc.allow)
+
+			state := testResourceState(t, r, prior)
+			plan := tfsdk.Plan(testResourceState(t, r, planned))
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Config: tfsdk.Config(plan), Plan: plan, State: state}, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+			if tc.wantErr && resp.Diagnostics[0].Summary() != "Publishing Prompt Not Allowed" {
+				t.Errorf("unexpected diagnostic %v", resp.Diagnostics)
+			}
+		})
+	}
+
+	// A brand-new prompt can start out public, same as always.
+	created := testPromptModel(false)
+	created.IsPublic = types.BoolValue(true)
+	if resp := testModifyPlan(t, r, created); resp.Diagnostics.HasError() {
+		t.Errorf("expected a new public prompt to plan, got %v", resp.Diagnostics)
+	}
+}
+
+// TestPromptResource_renameWarning checks a new repo_handle warns that the
+// prompt's history goes with the replacement, and an unchanged one doesn't.
+func TestPromptResource_renameWarning(t *testing.T) {
+	r := &PromptResource{}
+	for handle, wantWarning := range map[string]bool{"greeter": false, "howdy": true} {
+		prior := testPromptModel(false)
+		planned := testPromptModel(false)
+		planned.RepoHandle = types.StringValue(handle)
+
+		state := testResourceState(t, r, prior)
+		plan := tfsdk.Plan(testResourceState(t, r, planned))
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Config: tfsdk.Config(plan), Plan: plan, State: state}, resp)
+
+		if resp.Diagnostics.HasError() || (resp.Diagnostics.WarningsCount() > 0) != wantWarning {
+			t.Errorf("%s: expected warning %t, got %v", handle, wantWarning, resp.Diagnostics)
+		}
+	}
+}
+
+// testPromptManifestLangChain is a chat prompt in LangChain serialization,
+// as the API stores it.
+const testPromptManifestLangChain = `{"lc":1,"type":"constructor","id":["langchain","prompts","chat","ChatPromptTemplate"],"kwargs":{"input_variables":["name"],"messages":[` +
+	`{"lc":1,"type":"constructor","id":["langchain","prompts","chat","HumanMessagePromptTemplate"],"kwargs":{"prompt":` +
+	`{"lc":1,"type":"constructor","id":["langchain","prompts","prompt","PromptTemplate"],"kwargs":{"input_variables":["name"],"template":"Howdy, {name}","template_format":"f-string"}}}}]}}`
+
+// TestPromptResource_manifestFormat checks a raw manifest is read without the
+// serialization envelope, translates back exactly, and counts as the same
+// prompt as its LangChain form, so switching formats commits nothing.
+func TestPromptResource_manifestFormat(t *testing.T) {
+	raw, err := convertPromptManifest(testPromptManifestLangChain, promptManifestFormatRaw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"_type":"langchain.prompts.chat.ChatPromptTemplate","input_variables":["name"],"messages":[` +
+		`{"_type":"langchain.prompts.chat.HumanMessagePromptTemplate","prompt":` +
+		`{"_type":"langchain.prompts.prompt.PromptTemplate","input_variables":["name"],"template":"Howdy, {name}","template_format":"f-string"}}]}`
+	if !jsonSemanticallyEqual(raw, want) {
+		t.Errorf("unexpected raw manifest %s", raw)
+	}
+	back, err := langchainPromptManifest(raw, promptManifestFormatRaw)
+	if err != nil || !jsonSemanticallyEqual(back, testPromptManifestLangChain) {
+		t.Errorf("expected the raw manifest to translate back, got %s (%v)", back, err)
+	}
+
+	if !promptManifestsEqual(raw, promptManifestFormatRaw, testPromptManifestLangChain, promptManifestFormatLangChain) {
+		t.Error("expected the same prompt in both formats to be equal")
+	}
+	edited := strings.Replace(raw, "Howdy", "Evening", 1)
+	if promptManifestsEqual(edited, promptManifestFormatRaw, testPromptManifestLangChain, promptManifestFormatLangChain) {
+		t.Error("expected an edited template to count as a change")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/commits/-/greeter/latest" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"commit_hash":"abc123","manifest":` + testPromptManifestLangChain + `}`))
+	}))
+	defer srv.Close()
+
+	r := &PromptResource{client: client.NewClient(srv.URL, "key", "")}
+	data := testPromptModel(false)
+	data.ManifestFormat = types.StringValue(promptManifestFormatRaw)
+	if err := r.readPromptManifest(context.Background(), data, "greeter", false); err != nil {
+		t.Fatal(err)
+	}
+	if !jsonSemanticallyEqual(data.Manifest.ValueString(), want) {
+		t.Errorf("expected the manifest read in raw format, got %s", data.Manifest)
+	}
+
+	// A manifest already in state keeps its spelling when it hasn't changed.
+	spelled := `{ "_type": "langchain.prompts.chat.ChatPromptTemplate", "messages": [{"_type": "langchain.prompts.chat.HumanMessagePromptTemplate", "prompt": {"_type": "langchain.prompts.prompt.PromptTemplate", "template": "Howdy, {name}", "template_format": "f-string", "input_variables": ["name"]}}], "input_variables": ["name"] }`
+	data.Manifest = types.StringValue(spelled)
+	if err := r.readPromptManifest(context.Background(), data, "greeter", false); err != nil {
+		t.Fatal(err)
+	}
+	if data.Manifest.ValueString() != spelled {
+		t.Errorf("expected the manifest's spelling to be kept, got %s", data.Manifest)
+	}
+}
+
+// TestPromptResource_firstCommitLater checks a prompt created without a
+// manifest plans and applies cleanly while it has no commits, and that a
+// manifest added later is committed and then holds steady across refreshes.
+func TestPromptResource_firstCommitLater(t *testing.T) {
+	var repo promptAPIResponse
+	repo.Owner, repo.FullName = "dillon", "dillon/greeter"
+	var commits []json.RawMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos":
+			var body promptCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			repo.Repo.ID, repo.Repo.RepoHandle, repo.Repo.Description = "p1", body.RepoHandle, body.Description
+			_ = json.NewEncoder(w).Encode(repo)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/dillon/greeter":
+			_ = json.NewEncoder(w).Encode(repo)
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/repos/dillon/greeter":
+			var body promptUpdateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.Description != nil {
+				repo.Repo.Description = *body.Description
+			}
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/commits/-/greeter":
+			var body promptCommitRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			commits = append(commits, body.Manifest)
+			hash := fmt.Sprintf("hash%d", len(commits))
+			repo.Repo.NumCommits, repo.Repo.LastCommitHash = int64(len(commits)), &hash
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"commit": map[string]string{"commit_hash": hash}})
+		case r.Method == http.MethodGet && r.URL.Path == "/commits/-/greeter/latest" && len(commits) > 0:
+			_ = json.NewEncoder(w).Encode(promptLatestCommitResponse{CommitHash: *repo.Repo.LastCommitHash, Manifest: commits[len(commits)-1]})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	config := map[string]tftypes.Value{
+		"repo_handle": tftypes.NewValue(tftypes.String, "greeter"),
+		"is_public":   tftypes.NewValue(tftypes.Bool, false),
+	}
+	s := newTestResourceServer(t, srv.URL, "langsmith_prompt")
+	s.apply(config)
+	s.refresh()
+	if !s.attribute("manifest").IsNull() || !s.planIsEmpty(config) {
+		t.Fatalf("expected an empty prompt to hold steady, got manifest %s", s.attribute("manifest"))
+	}
+
+	// Changing something else before the first commit lands.
+	config["description"] = tftypes.NewValue(tftypes.String, "Says howdy")
+	s.apply(config)
+	s.refresh()
+	if !s.attribute("manifest").IsNull() || !s.planIsEmpty(config) {
+		t.Fatalf("expected the empty prompt to stay empty, got manifest %s", s.attribute("manifest"))
+	}
+
+	config["manifest"] = tftypes.NewValue(tftypes.String, testPromptManifestLangChain)
+	s.apply(config)
+	if len(commits) != 1 {
+		t.Fatalf("expected the manifest to be committed once, got %d commits", len(commits))
+	}
+	s.refresh()
+	if want := tftypes.NewValue(tftypes.String, testPromptManifestLangChain); !s.attribute("manifest").Equal(want) || !s.attribute("commit_hash").Equal(tftypes.NewValue(tftypes.String, "hash1")) {
+		t.Errorf("expected the committed manifest at hash1, got %s at %s", s.attribute("manifest"), s.attribute("commit_hash"))
+	}
+	if !s.planIsEmpty(config) {
+		t.Error("expected no changes once the manifest is committed")
+	}
+}
@@ -5,7 +5,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,8 +24,13 @@ import (
 var (
 	_ resource.Resource                = &PromptTagResource{}
 	_ resource.ResourceWithImportState = &PromptTagResource{}
+	_ planmodifier.String              = resolvedCommitHashPlanModifier{}
 )
 
+// promptTagLatest is the commit_hash that keeps a tag riding with the repo's
+// newest commit instead of a fixed one.
+const promptTagLatest = "latest"
+
 // NewPromptTagResource returns a resource for managing named tags on prompt
 // commits -- like branding cattle for the production herd or the staging pen.
 func NewPromptTagResource() resource.Resource {
@@ -37,12 +44,14 @@ type PromptTagResource struct {
 
 // PromptTagResourceModel maps the Terraform schema for a prompt tag.
 type PromptTagResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	RepoHandle types.String `tfsdk:"repo_handle"`
-	TagName    types.String `tfsdk:"tag_name"`
-	CommitHash types.String `tfsdk:"commit_hash"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
+	ID          types.String `tfsdk:"id"`
+	RepoHandle  types.String `tfsdk:"repo_handle"`
+	TagName     types.String `tfsdk:"tag_name"`
+	CommitHash  types.String `tfsdk:"commit_hash"`
+	Resolved    types.String `tfsdk:"resolved_commit_hash"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
 }
 
 // promptTagCreateRequest is sent to POST /api/v1/repos/-/{repo}/tags.
@@ -87,6 +96,7 @@ func (r *PromptTagResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a named version tag on a LangSmith prompt repo. Tags like `production` or `staging` point to specific commits, letting you promote prompt versions through environments.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the tag.",
 				Computed:            true,
@@ -107,8 +117,14 @@ func (r *PromptTagResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"commit_hash": schema.StringAttribute{
-				MarkdownDescription: "The commit hash that this tag points to. Update this to promote a different version.",
-				Required:            true,
+				MarkdownDescription: "The commit hash that this tag points to. Update this to promote a different version. " +
+					"Set it to `latest` to point the tag at the repo's newest commit at apply time; once a newer commit lands, the next plan moves the tag up to it.",
+				Required: true,
+			},
+			"resolved_commit_hash": schema.StringAttribute{
+				MarkdownDescription: "The hash of the commit the tag actually points to. The same as `commit_hash`, unless that's `latest`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{resolvedCommitHashPlanModifier{}},
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "When the tag was created.",
@@ -126,30 +142,74 @@ func (r *PromptTagResource) Configure(ctx context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
 		return
 	}
-	r.client = c
+	r.client = data.client
 }
 
-// resolveCommitID looks up the commit UUID from a commit hash.
-func (r *PromptTagResource) resolveCommitID(ctx context.Context, repoHandle, commitHash string) (string, error) {
+// latestCommit returns the repo's newest commit, the first the API lists.
+func (r *PromptTagResource) latestCommit(ctx context.Context, repoHandle string) (promptCommitListItem, error) {
+	query := url.Values{}
+	query.Set("limit", "1")
+	query.Set("offset", "0")
+
 	var listResp promptCommitListResponse
-	err := r.client.Get(ctx, fmt.Sprintf("/commits/-/%s", repoHandle), nil, &listResp)
-	if err != nil {
-		return "", fmt.Errorf("listing commits: %w", err)
+	if err := r.client.Get(ctx, fmt.Sprintf("/commits/-/%s", repoHandle), query, &listResp); err != nil {
+		return promptCommitListItem{}, fmt.Errorf("listing commits: %w", err)
+	}
+	if len(listResp.Commits) == 0 {
+		return promptCommitListItem{}, fmt.Errorf("repo %q has no commits", repoHandle)
 	}
+	return listResp.Commits[0], nil
+}
 
-	for _, c := range listResp.Commits {
-		if c.CommitHash == commitHash {
-			return c.ID, nil
+// resolveCommitID looks up the commit UUID from a commit hash, paging through
+// the repo's commits until the hash turns up or the total runs out. The hash
+// latest stands for the repo's newest commit.
+func (r *PromptTagResource) resolveCommitID(ctx context.Context, repoHandle, commitHash string) (string, error) {
+	if commitHash == promptTagLatest {
+		latest, err := r.latestCommit(ctx, repoHandle)
+		if err != nil {
+			return "", err
 		}
+		return latest.ID, nil
 	}
 
-	return "", fmt.Errorf("commit hash %q not found in repo %q", commitHash, repoHandle)
+	var commitID string
+	seen := 0
+	err := r.client.GetAllPages(ctx, fmt.Sprintf("/commits/-/%s", repoHandle), nil, func(page json.RawMessage) (int, error) {
+		var listResp promptCommitListResponse
+		if err := json.Unmarshal(page, &listResp); err != nil {
+			return 0, err
+		}
+
+		for _, c := range listResp.Commits {
+			if c.CommitHash == commitHash {
+				commitID = c.ID
+				return 0, client.ErrStopPaging
+			}
+		}
+
+		// Some servers leave total out or send 0; the empty page past the
+		// end stops the search for them instead.
+		seen += len(listResp.Commits)
+		if listResp.Total > 0 && seen >= listResp.Total {
+			return 0, client.ErrStopPaging
+		}
+		return len(listResp.Commits), nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("listing commits: %w", err)
+	}
+	if commitID == "" {
+		return "", fmt.Errorf("commit hash %q not found in repo %q", commitHash, repoHandle)
+	}
+
+	return commitID, nil
 }
 
 func (r *PromptTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -159,6 +219,8 @@ func (r *PromptTagResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	commitID, err := r.resolveCommitID(ctx, data.RepoHandle.ValueString(), data.CommitHash.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error resolving commit hash", err.Error())
@@ -171,14 +233,17 @@ func (r *PromptTagResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	var result promptTagAPIResponse
-	err = r.client.Post(ctx, fmt.Sprintf("/api/v1/repos/-/%s/tags", data.RepoHandle.ValueString()), body, &result)
+	err = r.client.Create(ctx, fmt.Sprintf("/api/v1/repos/-/%s/tags", data.RepoHandle.ValueString()), body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating prompt tag", err.Error())
 		return
 	}
 
 	data.ID = types.StringValue(result.ID)
-	data.CommitHash = types.StringValue(result.CommitHash)
+	data.Resolved = types.StringValue(result.CommitHash)
+	if data.CommitHash.ValueString() != promptTagLatest {
+		data.CommitHash = types.StringValue(result.CommitHash)
+	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.UpdatedAt = types.StringValue(result.UpdatedAt)
 
@@ -193,6 +258,8 @@ func (r *PromptTagResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result promptTagAPIResponse
 	err := r.client.Get(ctx, fmt.Sprintf("/api/v1/repos/-/%s/tags/%s", data.RepoHandle.ValueString(), data.TagName.ValueString()), nil, &result)
 	if err != nil {
@@ -205,7 +272,21 @@ func (r *PromptTagResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	data.ID = types.StringValue(result.ID)
-	data.CommitHash = types.StringValue(result.CommitHash)
+	data.Resolved = types.StringValue(result.CommitHash)
+	if data.CommitHash.ValueString() == promptTagLatest {
+		// A tag riding latest that's fallen behind the newest commit shows
+		// the hash it's stuck on, so the next plan moves it back up.
+		latest, err := r.latestCommit(ctx, data.RepoHandle.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading prompt tag", err.Error())
+			return
+		}
+		if latest.CommitHash != result.CommitHash {
+			data.CommitHash = types.StringValue(result.CommitHash)
+		}
+	} else {
+		data.CommitHash = types.StringValue(result.CommitHash)
+	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.UpdatedAt = types.StringValue(result.UpdatedAt)
 
@@ -219,6 +300,8 @@ func (r *PromptTagResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	commitID, err := r.resolveCommitID(ctx, data.RepoHandle.ValueString(), data.CommitHash.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error resolving commit hash", err.Error())
@@ -237,7 +320,10 @@ func (r *PromptTagResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	data.ID = types.StringValue(result.ID)
-	data.CommitHash = types.StringValue(result.CommitHash)
+	data.Resolved = types.StringValue(result.CommitHash)
+	if data.CommitHash.ValueString() != promptTagLatest {
+		data.CommitHash = types.StringValue(result.CommitHash)
+	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.UpdatedAt = types.StringValue(result.UpdatedAt)
 
@@ -251,6 +337,8 @@ func (r *PromptTagResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, fmt.Sprintf("/api/v1/repos/-/%s/tags/%s", data.RepoHandle.ValueString(), data.TagName.ValueString()))
 	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error deleting prompt tag", err.Error())
@@ -258,6 +346,7 @@ func (r *PromptTagResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *PromptTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	// Import format: repo_handle/tag_name
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 {
@@ -267,3 +356,42 @@ func (r *PromptTagResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repo_handle"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag_name"), parts[1])...)
 }
+
+// resolvedCommitHashPlanModifier plans resolved_commit_hash from commit_hash.
+// A pinned hash is known at plan time; latest keeps what's in state while the
+// tag stays on it, and is only known after apply when the tag moves.
+type resolvedCommitHashPlanModifier struct{}
+
+func (m resolvedCommitHashPlanModifier) Description(ctx context.Context) string {
+	return "Plans the commit hash the tag will point to."
+}
+
+func (m resolvedCommitHashPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m resolvedCommitHashPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("commit_hash"), &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planned.IsUnknown() && planned.ValueString() != promptTagLatest {
+		resp.PlanValue = planned
+		return
+	}
+
+	var prior types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("commit_hash"), &prior)...)
+	}
+	if planned.ValueString() == promptTagLatest && prior.ValueString() == promptTagLatest && !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	resp.PlanValue = types.StringUnknown()
+}
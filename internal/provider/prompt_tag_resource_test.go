@@ -0,0 +1,176 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestPromptTagResource_resolveCommitIDPaginates checks an old commit, buried
+// a few pages deep in a long repo history, can still be found by hash.
+func TestPromptTagResource_resolveCommitIDPaginates(t *testing.T) {
+	const total = client.DefaultPageSize*2 + 50
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		// Newest first, the way the API lists them.
+		resp := promptCommitListResponse{Commits: []promptCommitListItem{}, Total: total}
+		for i := offset; i < offset+limit && i < total; i++ {
+			n := total - 1 - i
+			resp.Commits = append(resp.Commits, promptCommitListItem{
+				ID:         fmt.Sprintf("id-%d", n),
+				CommitHash: fmt.Sprintf("hash%d", n),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer ts.Close()
+
+	r := &PromptTagResource{client: client.NewClient(ts.URL, "key", "")}
+
+	id, err := r.resolveCommitID(context.Background(), "my-prompt", "hash0")
+	if err != nil {
+		t.Fatalf("resolving the oldest commit: %v", err)
+	}
+	if id != "id-0" {
+		t.Errorf("expected id-0, got %q", id)
+	}
+	if requests != 3 {
+		t.Errorf("expected three pages to be read, got %d", requests)
+	}
+
+	requests = 0
+	if _, err := r.resolveCommitID(context.Background(), "my-prompt", "nope"); err == nil {
+		t.Error("expected an error for an unknown hash")
+	}
+	if requests != 3 {
+		t.Errorf("expected the search to stop at the total, got %d requests", requests)
+	}
+
+	requests = 0
+	if id, err := r.resolveCommitID(context.Background(), "my-prompt", fmt.Sprintf("hash%d", total-1)); err != nil || id != fmt.Sprintf("id-%d", total-1) {
+		t.Errorf("expected the newest commit, got %q, %v", id, err)
+	}
+	if requests != 1 {
+		t.Errorf("expected the search to stop once found, got %d requests", requests)
+	}
+}
+
+// TestPromptTagResource_resolveCommitIDWithoutTotal checks a commit on the
+// second page is still found when the server leaves total out of its pages.
+func TestPromptTagResource_resolveCommitIDWithoutTotal(t *testing.T) {
+	const total = client.DefaultPageSize + 50
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		commits := []promptCommitListItem{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			n := total - 1 - i
+			commits = append(commits, promptCommitListItem{
+				ID:         fmt.Sprintf("id-%d", n),
+				CommitHash: fmt.Sprintf("hash%d", n),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"commits": commits})
+	}))
+	defer ts.Close()
+
+	r := &PromptTagResource{client: client.NewClient(ts.URL, "key", "")}
+
+	id, err := r.resolveCommitID(context.Background(), "my-prompt", "hash0")
+	if err != nil {
+		t.Fatalf("resolving a commit on the second page: %v", err)
+	}
+	if id != "id-0" {
+		t.Errorf("expected id-0, got %q", id)
+	}
+
+	if _, err := r.resolveCommitID(context.Background(), "my-prompt", "nope"); err == nil {
+		t.Error("expected an error for an unknown hash")
+	}
+}
+
+// TestPromptTagResource_latest checks a tag set to latest lands on the newest
+// commit with the hash recorded, and that Read shows drift once a newer
+// commit comes along.
+func TestPromptTagResource_latest(t *testing.T) {
+	commits := []promptCommitListItem{{ID: "id-1", CommitHash: "hash1"}, {ID: "id-0", CommitHash: "hash0"}}
+	tagged := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/commits/-/my-prompt":
+			_ = json.NewEncoder(w).Encode(promptCommitListResponse{Commits: commits[:1], Total: len(commits)})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/-/my-prompt/tags":
+			var body promptTagCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			for _, c := range commits {
+				if c.ID == body.CommitID {
+					tagged = c.CommitHash
+				}
+			}
+			_ = json.NewEncoder(w).Encode(promptTagAPIResponse{ID: "t1", TagName: body.TagName, CommitID: body.CommitID, CommitHash: tagged})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/-/my-prompt/tags/staging":
+			_ = json.NewEncoder(w).Encode(promptTagAPIResponse{ID: "t1", TagName: "staging", CommitHash: tagged})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &PromptTagResource{client: client.NewClient(srv.URL, "key", "")}
+	plan := testResourceState(t, r, &PromptTagResourceModel{
+		ID:          types.StringUnknown(),
+		RepoHandle:  types.StringValue("my-prompt"),
+		TagName:     types.StringValue("staging"),
+		CommitHash:  types.StringValue(promptTagLatest),
+		Resolved:    types.StringUnknown(),
+		CreatedAt:   types.StringUnknown(),
+		UpdatedAt:   types.StringUnknown(),
+		WorkspaceID: types.StringNull(),
+	})
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+	var got PromptTagResourceModel
+	createResp.State.Get(ctx, &got)
+	if got.CommitHash.ValueString() != promptTagLatest || got.Resolved.ValueString() != "hash1" {
+		t.Errorf("expected latest resolved to hash1, got %s -> %s", got.CommitHash, got.Resolved)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	readResp.State.Get(ctx, &got)
+	if readResp.Diagnostics.HasError() || got.CommitHash.ValueString() != promptTagLatest {
+		t.Errorf("expected a tag on the newest commit to stay latest, got %s, %v", got.CommitHash, readResp.Diagnostics)
+	}
+
+	commits = append([]promptCommitListItem{{ID: "id-2", CommitHash: "hash2"}}, commits...)
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, readResp)
+	readResp.State.Get(ctx, &got)
+	if got.CommitHash.ValueString() != "hash1" || got.Resolved.ValueString() != "hash1" {
+		t.Errorf("expected the tag left behind on hash1 to show as drift, got %s -> %s", got.CommitHash, got.Resolved)
+	}
+}
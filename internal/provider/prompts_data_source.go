@@ -0,0 +1,195 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &PromptsDataSource{}
+
+// NewPromptsDataSource returns a new PromptsDataSource, for rounding up every
+// prompt in the Hub that answers to a description.
+func NewPromptsDataSource() datasource.DataSource {
+	return &PromptsDataSource{}
+}
+
+// PromptsDataSource searches the prompt repos, so prompts following a naming
+// convention or carrying a tag can be found without knowing their names.
+type PromptsDataSource struct {
+	client *client.Client
+}
+
+// PromptsDataSourceModel holds the search filters and the prompts found.
+type PromptsDataSourceModel struct {
+	Query       types.String        `tfsdk:"query"`
+	Tags        types.List          `tfsdk:"tags"`
+	Owner       types.String        `tfsdk:"owner"`
+	IsPublic    types.Bool          `tfsdk:"is_public"`
+	Prompts     []promptsEntryModel `tfsdk:"prompts"`
+	WorkspaceID types.String        `tfsdk:"workspace_id"`
+}
+
+// promptsEntryModel is one prompt found by the search.
+type promptsEntryModel struct {
+	ID             types.String   `tfsdk:"id"`
+	FullName       types.String   `tfsdk:"full_name"`
+	NumCommits     types.Int64    `tfsdk:"num_commits"`
+	LastCommitHash types.String   `tfsdk:"last_commit_hash"`
+	Tags           []types.String `tfsdk:"tags"`
+}
+
+// promptsAPIPage is one page of repo search results.
+type promptsAPIPage struct {
+	Repos []promptsAPIRepo `json:"repos"`
+}
+
+// promptsAPIRepo is a single repo in the search results.
+type promptsAPIRepo struct {
+	ID             string   `json:"id"`
+	FullName       string   `json:"full_name"`
+	NumCommits     int64    `json:"num_commits"`
+	LastCommitHash *string  `json:"last_commit_hash"`
+	Tags           []string `json:"tags"`
+}
+
+func (d *PromptsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompts"
+}
+
+func (d *PromptsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to search the LangSmith prompt repos by name, tag, owner or visibility. Every filter is optional; with none set, every prompt the workspace can see is returned.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only return prompts whose name or description matches this search text.",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Only return prompts carrying all of these tags.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Only return prompts owned by this handle.",
+				Optional:            true,
+			},
+			"is_public": schema.BoolAttribute{
+				MarkdownDescription: "Only return public prompts when `true`, or private ones when `false`.",
+				Optional:            true,
+			},
+			"prompts": schema.ListNestedAttribute{
+				MarkdownDescription: "The prompts found, in the order the API lists them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the prompt.",
+							Computed:            true,
+						},
+						"full_name": schema.StringAttribute{
+							MarkdownDescription: "The prompt's full name, `owner/repo_handle`.",
+							Computed:            true,
+						},
+						"num_commits": schema.Int64Attribute{
+							MarkdownDescription: "The number of commits to the prompt.",
+							Computed:            true,
+						},
+						"last_commit_hash": schema.StringAttribute{
+							MarkdownDescription: "The hash of the prompt's latest commit, if it has one.",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "The prompt's tags.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PromptsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *PromptsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PromptsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	query := url.Values{}
+	if !data.Query.IsNull() {
+		query.Set("query", data.Query.ValueString())
+	}
+	if !data.Owner.IsNull() {
+		query.Set("tenant_handle", data.Owner.ValueString())
+	}
+	if !data.IsPublic.IsNull() {
+		query.Set("is_public", strconv.FormatBool(data.IsPublic.ValueBool()))
+	}
+	if !data.Tags.IsNull() {
+		var tags []string
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		query["tags"] = tags
+	}
+
+	repos, err := client.GetAllIn(ctx, d.client, "/api/v1/repos", query, func(page *promptsAPIPage) []promptsAPIRepo { return page.Repos })
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching prompts", err.Error())
+		return
+	}
+
+	data.Prompts = make([]promptsEntryModel, 0, len(repos))
+	for _, repo := range repos {
+		tags := make([]types.String, 0, len(repo.Tags))
+		for _, tag := range repo.Tags {
+			tags = append(tags, types.StringValue(tag))
+		}
+		data.Prompts = append(data.Prompts, promptsEntryModel{
+			ID:             types.StringValue(repo.ID),
+			FullName:       types.StringValue(repo.FullName),
+			NumCommits:     types.Int64Value(repo.NumCommits),
+			LastCommitHash: types.StringPointerValue(repo.LastCommitHash),
+			Tags:           tags,
+		})
+	}
+
+	tflog.Trace(ctx, "read prompts data source", map[string]interface{}{"count": len(data.Prompts)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestPromptsDataSource_read checks the filters reach the search endpoint and
+// that every page of results is gathered up.
+func TestPromptsDataSource_read(t *testing.T) {
+	total := client.DefaultPageSize + 2
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/api/v1/repos" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if q.Get("query") != "support-bot-" || q.Get("tenant_handle") != "dodge" || q.Get("is_public") != "false" || strings.Join(q["tags"], ",") != "production,v2" {
+			t.Errorf("unexpected search %s", r.URL.RawQuery)
+		}
+
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		repos := make([]string, 0, limit)
+		for i := offset; i < total && i < offset+limit; i++ {
+			hash := `null`
+			if i > 0 {
+				hash = fmt.Sprintf(`"c%d"`, i)
+			}
+			repos = append(repos, fmt.Sprintf(`{"id": "p%d", "full_name": "dodge/support-bot-%d", "num_commits": %d, "last_commit_hash": %s, "tags": ["production", "v2"]}`, i, i, i, hash))
+		}
+		_, _ = fmt.Fprintf(w, `{"repos": [%s], "total": %d}`, strings.Join(repos, ","), total)
+	}))
+	defer srv.Close()
+
+	d := &PromptsDataSource{client: client.NewClient(srv.URL, "key", "")}
+	tags, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"production", "v2"})
+	resp := testDataSourceRead(t, d, &PromptsDataSourceModel{
+		Query:    types.StringValue("support-bot-"),
+		Tags:     tags,
+		Owner:    types.StringValue("dodge"),
+		IsPublic: types.BoolValue(false),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", resp.Diagnostics)
+	}
+
+	var got PromptsDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if len(got.Prompts) != total {
+		t.Fatalf("expected all %d prompts across the pages, got %d", total, len(got.Prompts))
+	}
+	if p := got.Prompts[0]; p.FullName.ValueString() != "dodge/support-bot-0" || !p.LastCommitHash.IsNull() || len(p.Tags) != 2 {
+		t.Errorf("unexpected first prompt %+v", p)
+	}
+	if p := got.Prompts[total-1]; p.ID.ValueString() != fmt.Sprintf("p%d", total-1) || p.NumCommits.ValueInt64() != int64(total-1) {
+		t.Errorf("unexpected last prompt %+v", p)
+	}
+}
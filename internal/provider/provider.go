@@ -5,12 +5,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/bogware/terraform-provider-langsmith/internal/client"
@@ -18,18 +28,57 @@ import (
 
 var _ provider.Provider = &LangSmithProvider{}
 
+// defaultEndpoint is LangSmith's hosted API, where the provider rides unless
+// told otherwise.
+const defaultEndpoint = "https://api.smith.langchain.com"
+
+// endpointEnvVars are checked in order for the API base URL when the provider
+// configuration doesn't set one. The first two match the LangSmith SDKs.
+var endpointEnvVars = []string{"LANGSMITH_ENDPOINT", "LANGCHAIN_ENDPOINT", "LANGSMITH_API_URL"}
+
+// apiKeyEnvVars are checked in order for the API key when the provider
+// configuration doesn't set one, the same ones the LangSmith SDKs read.
+var apiKeyEnvVars = []string{"LANGSMITH_API_KEY", "LANGCHAIN_API_KEY"}
+
 // LangSmithProvider defines the provider implementation. This is the marshal's
 // office — where all resources and data sources report for duty.
 type LangSmithProvider struct {
 	version string
 }
 
+// providerData is what the provider hands its resources and data sources
+// when it's configured: the API client, and the provider-wide settings the
+// client has no business carrying.
+type providerData struct {
+	client *client.Client
+
+	// defaultRoleID is the role handed to workspace members and service keys
+	// that don't name their own.
+	defaultRoleID string
+}
+
 // LangSmithProviderModel describes the provider configuration: API key, base
-// URL, and tenant ID. The credentials every lawman carries on the frontier.
+// URL, tenant and organization IDs, how doggedly to retry and how long to wait, how fast to
+// send, how to sign its requests, what extra headers to carry, the role
+// handed out by default, which certificates to trust, and whether creates
+// carry idempotency keys. The credentials every lawman carries on the
+// frontier.
 type LangSmithProviderModel struct {
-	APIKey   types.String `tfsdk:"api_key"`
-	APIURL   types.String `tfsdk:"api_url"`
-	TenantID types.String `tfsdk:"tenant_id"`
+	APIKey             types.String  `tfsdk:"api_key"`
+	APIURL             types.String  `tfsdk:"api_url"`
+	Endpoint           types.String  `tfsdk:"endpoint"`
+	TenantID           types.String  `tfsdk:"tenant_id"`
+	OrganizationID     types.String  `tfsdk:"organization_id"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	MaxRetryBackoff    types.Int64   `tfsdk:"max_retry_backoff"`
+	RequestTimeout     types.Int64   `tfsdk:"request_timeout"`
+	RequestsPerSecond  types.Float64 `tfsdk:"requests_per_second"`
+	UserAgentSuffix    types.String  `tfsdk:"user_agent_suffix"`
+	DefaultHeaders     types.Map     `tfsdk:"default_headers"`
+	DefaultRoleID      types.String  `tfsdk:"default_role_id"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	IdempotencyKeys    types.Bool    `tfsdk:"idempotency_keys"`
 }
 
 func (p *LangSmithProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -42,18 +91,94 @@ func (p *LangSmithProvider) Schema(ctx context.Context, req provider.SchemaReque
 		MarkdownDescription: "The LangSmith provider allows you to manage LangSmith resources such as projects, datasets, annotation queues, prompts, and more.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "The LangSmith API key. Can also be set with the `LANGSMITH_API_KEY` environment variable.",
+				MarkdownDescription: "The LangSmith API key. When unset, the `LANGSMITH_API_KEY` and `LANGCHAIN_API_KEY` environment variables are checked in that order.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"api_url": schema.StringAttribute{
-				MarkdownDescription: "The LangSmith API base URL. Defaults to `https://api.smith.langchain.com`. Can also be set with the `LANGSMITH_API_URL` environment variable.",
+				MarkdownDescription: "The LangSmith API base URL. An alias for `endpoint`; set one or the other. Can also be set with the `LANGSMITH_API_URL` environment variable.",
 				Optional:            true,
+				Validators: []validator.String{
+					validEndpoint(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The LangSmith API base URL, for self-hosted and regional deployments. Defaults to `https://api.smith.langchain.com`. " +
+					"When unset, the `LANGSMITH_ENDPOINT`, `LANGCHAIN_ENDPOINT`, and `LANGSMITH_API_URL` environment variables are checked in that order. " +
+					"An SDK-style URL ending in `/api/v1` is accepted as-is.",
+				Optional: true,
+				Validators: []validator.String{
+					validEndpoint(),
+					stringvalidator.ConflictsWith(path.MatchRoot("api_url")),
+				},
 			},
 			"tenant_id": schema.StringAttribute{
 				MarkdownDescription: "The LangSmith workspace/tenant ID. Required for org-scoped API keys. Can also be set with the `LANGSMITH_TENANT_ID` environment variable.",
 				Optional:            true,
 			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The LangSmith organization ID that organization-level resources, such as `langsmith_sso_settings`, `langsmith_org_role`, and `langsmith_organization_settings`, are managed in. " +
+					"Set it when the API key spans several organizations; when unset, the API key's current organization is used. Can also be set with the `LANGSMITH_ORGANIZATION_ID` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					validUUID(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request is retried after a `429`, `502`, `503`, or `504` response or a network error, using exponential backoff with jitter. A `Retry-After` header from the API is honored. Set to `0` to disable retries. Defaults to `4`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_backoff": schema.Int64Attribute{
+				MarkdownDescription: "Upper bound, in seconds, on the wait between retries, including waits requested via `Retry-After`. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "How long, in seconds, a single API request may take before it's abandoned and, if retries remain, tried again. Raise it for large bulk exports or prompt commits. Defaults to `120`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Caps how many API requests per second the provider sends, retries included, across every resource and data source. Short bursts of up to one second's worth go out at once; beyond that, requests wait their turn. Set it under your LangSmith rate limit to keep large applies from tripping it. Fractions such as `0.5` are allowed. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+					float64validator.NoneOf(0),
+				},
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the `User-Agent` header the provider sends, e.g. a team or pipeline name, to pick its requests out of LangSmith's access logs. The header always starts with `terraform-provider-langsmith/<version>` and the Terraform version.",
+				Optional:            true,
+			},
+			"default_headers": schema.MapAttribute{
+				MarkdownDescription: "Extra headers sent with every API request, e.g. for a proxy or gateway in front of a self-hosted deployment. Headers the provider sets itself (`Authorization`, `Cookie`, `X-API-Key`, `X-Tenant-Id`, `X-Organization-Id`, `Content-Type`, `Accept`, `User-Agent`, and `Idempotency-Key`) can't be set here. Values are treated as sensitive, since they often carry gateway credentials.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"default_role_id": schema.StringAttribute{
+				MarkdownDescription: "The role ID assigned to `langsmith_workspace_member` and `langsmith_service_key` resources that don't set `role_id` themselves. A `role_id` set on the resource always takes precedence.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM bundle of CA certificates to trust in addition to the system's, for self-hosted deployments signed by an internal CA.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the API's TLS certificate. Leaves the connection open to interception; prefer `ca_cert_file`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"idempotency_keys": schema.BoolAttribute{
+				MarkdownDescription: "Send an `Idempotency-Key` header with each create request, so a create that succeeded before its response was lost isn't carried out twice when the provider retries it or the apply is run again. The key is worked out from the workspace, the kind of object, and the planned request body, so the same planned create always carries the same key. Servers that don't recognize the header ignore it; set to `false` for a deployment that rejects it. Defaults to `true`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -66,7 +191,13 @@ func (p *LangSmithProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	apiKey := os.Getenv("LANGSMITH_API_KEY")
+	var apiKey string
+	for _, name := range apiKeyEnvVars {
+		if v := os.Getenv(name); v != "" {
+			apiKey = v
+			break
+		}
+	}
 	if !data.APIKey.IsNull() {
 		apiKey = data.APIKey.ValueString()
 	}
@@ -74,17 +205,33 @@ func (p *LangSmithProvider) Configure(ctx context.Context, req provider.Configur
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Key",
-			"The LangSmith API key must be set in the provider configuration or via the LANGSMITH_API_KEY environment variable.",
+			"The LangSmith API key must be set in the provider configuration or via the LANGSMITH_API_KEY or LANGCHAIN_API_KEY environment variable.",
 		)
 		return
 	}
 
-	apiURL := "https://api.smith.langchain.com"
-	if envURL := os.Getenv("LANGSMITH_API_URL"); envURL != "" {
-		apiURL = envURL
+	endpoint, source := defaultEndpoint, ""
+	for _, name := range endpointEnvVars {
+		if v := os.Getenv(name); v != "" {
+			endpoint, source = v, name
+			break
+		}
 	}
 	if !data.APIURL.IsNull() {
-		apiURL = data.APIURL.ValueString()
+		endpoint, source = data.APIURL.ValueString(), "api_url"
+	}
+	if !data.Endpoint.IsNull() {
+		endpoint, source = data.Endpoint.ValueString(), "endpoint"
+	}
+
+	apiURL, err := normalizeEndpoint(endpoint)
+	if err != nil {
+		if source == "api_url" || source == "endpoint" {
+			resp.Diagnostics.AddAttributeError(path.Root(source), "Invalid Endpoint", err.Error())
+		} else {
+			resp.Diagnostics.AddError("Invalid Endpoint", fmt.Sprintf("The %s environment variable: %s", source, err))
+		}
+		return
 	}
 
 	tenantID := os.Getenv("LANGSMITH_TENANT_ID")
@@ -93,36 +240,183 @@ func (p *LangSmithProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	c := client.NewClient(apiURL, apiKey, tenantID)
-	resp.DataSourceData = c
-	resp.ResourceData = c
+	c.OrganizationID = os.Getenv("LANGSMITH_ORGANIZATION_ID")
+	if !data.OrganizationID.IsNull() {
+		c.OrganizationID = data.OrganizationID.ValueString()
+	}
+	c.UserAgent = userAgent(p.version, req.TerraformVersion, data.UserAgentSuffix.ValueString())
+
+	if !data.DefaultHeaders.IsNull() {
+		headers := make(map[string]string, len(data.DefaultHeaders.Elements()))
+		resp.Diagnostics.Append(data.DefaultHeaders.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		c.DefaultHeaders = make(http.Header, len(headers))
+		for name, value := range headers {
+			if client.IsReservedHeader(name) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("default_headers").AtMapKey(name),
+					"Reserved Header",
+					fmt.Sprintf("The provider sets the %s header itself; it can't be overridden through default_headers.", name),
+				)
+				continue
+			}
+			c.DefaultHeaders.Set(name, value)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Schema validators skip values that aren't known yet, so an unknown
+	// here would otherwise read as 0 and quietly turn retries off.
+	if data.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Max Retries",
+			"max_retries depends on a value that isn't known until apply. Set it to a value known at plan time.",
+		)
+		return
+	}
+	if data.MaxRetryBackoff.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retry_backoff"),
+			"Unknown Max Retry Backoff",
+			"max_retry_backoff depends on a value that isn't known until apply. Set it to a value known at plan time.",
+		)
+		return
+	}
+
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"max_retries must be zero or greater.",
+			)
+			return
+		}
+		c.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.MaxRetryBackoff.IsNull() {
+		if data.MaxRetryBackoff.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retry_backoff"),
+				"Invalid Max Retry Backoff",
+				"max_retry_backoff must be a positive number of seconds.",
+			)
+			return
+		}
+		c.RetryMaxBackoff = time.Duration(data.MaxRetryBackoff.ValueInt64()) * time.Second
+	}
+
+	if data.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown Request Timeout",
+			"request_timeout depends on a value that isn't known until apply. Set it to a value known at plan time.",
+		)
+		return
+	}
+	if !data.RequestTimeout.IsNull() {
+		if data.RequestTimeout.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				"request_timeout must be a positive number of seconds.",
+			)
+			return
+		}
+		c.HTTPClient.Timeout = time.Duration(data.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	if data.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Requests Per Second",
+			"requests_per_second depends on a value that isn't known until apply. Set it to a value known at plan time.",
+		)
+		return
+	}
+	if !data.RequestsPerSecond.IsNull() {
+		if data.RequestsPerSecond.ValueFloat64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid Requests Per Second",
+				"requests_per_second must be a positive number; leave it unset for no limit.",
+			)
+			return
+		}
+		c.SetRateLimit(data.RequestsPerSecond.ValueFloat64())
+	}
+
+	if !data.IdempotencyKeys.IsNull() {
+		c.IdempotencyKeys = data.IdempotencyKeys.ValueBool()
+	}
+
+	insecure := data.InsecureSkipVerify.ValueBool()
+	if !data.CACertFile.IsNull() || insecure {
+		if err := c.ConfigureTLS(data.CACertFile.ValueString(), insecure); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Certificate File", err.Error())
+			return
+		}
+	}
+	if insecure {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"The provider won't verify the LangSmith API's certificate, so traffic, including the API key, could be intercepted. Set ca_cert_file to trust an internal CA instead.",
+		)
+	}
+
+	pd := &providerData{client: c, defaultRoleID: data.DefaultRoleID.ValueString()}
+	resp.DataSourceData = pd
+	resp.ResourceData = pd
 }
 
 func (p *LangSmithProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewProjectResource,
 		NewDatasetResource,
+		NewDatasetExamplesResource,
+		NewDatasetTagResource,
+		NewDatasetShareResource,
+		NewComparisonResource,
+		NewDashboardResource,
+		NewChartResource,
 		NewExampleResource,
 		NewAnnotationQueueResource,
+		NewAnnotationQueueRunResource,
 		NewServiceAccountResource,
 		NewServiceKeyResource,
 		NewPromptResource,
 		NewRunRuleResource,
 		NewWebhookResource,
 		NewFeedbackConfigResource,
+		NewFeedbackResource,
+		NewFilterViewResource,
 		NewWorkspaceResource,
 		NewTagKeyResource,
 		NewTagValueResource,
 		NewBulkExportDestinationResource,
 		NewBulkExportResource,
 		NewModelPriceMapResource,
+		NewModelPriceMapHistoryResource,
 		NewUsageLimitResource,
 		NewPlaygroundSettingsResource,
 		NewSecretResource,
+		NewWorkspaceSecretResource,
 		NewTTLSettingsResource,
 		NewAlertRuleResource,
 		NewOrgRoleResource,
+		NewWorkspaceRoleResource,
 		NewSSOSettingsResource,
 		NewWorkspaceMemberResource,
+		NewWorkspaceGroupResource,
+		NewOrganizationSettingsResource,
+		NewDatasetSplitsResource,
+		NewOrganizationInviteResource,
 		NewPromptTagResource,
 	}
 }
@@ -131,10 +425,23 @@ func (p *LangSmithProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		NewProjectDataSource,
 		NewDatasetDataSource,
+		NewDatasetReadyDataSource,
 		NewWorkspaceDataSource,
 		NewInfoDataSource,
 		NewOrganizationDataSource,
+		NewOrganizationRoleDataSource,
+		NewOrganizationUsageDataSource,
 		NewPromptCommitDataSource,
+		NewServiceKeysDataSource,
+		NewAlertRuleDataSource,
+		NewWorkspaceCurrentDataSource,
+		NewRunRuleDataSource,
+		NewSSOSettingsDataSource,
+		NewPlaygroundSettingsDataSource,
+		NewPromptsDataSource,
+		NewExampleDataSource,
+		NewOrgMembersDataSource,
+		NewRunRulesDataSource,
 	}
 }
 
@@ -147,3 +454,37 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// normalizeEndpoint checks that endpoint is an http or https URL with a host,
+// and trims it to the base the client's paths hang off of: no trailing slash,
+// and no `/api/v1` suffix, which the SDKs include but the client adds itself.
+func normalizeEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil {
+		return "", fmt.Errorf("%q isn't a valid URL: %s", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("%q must start with http:// or https://", endpoint)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%q has no host", endpoint)
+	}
+
+	u.Path = strings.TrimSuffix(strings.TrimRight(u.Path, "/"), "/api/v1")
+	u.RawPath = ""
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// userAgent builds the User-Agent header: the provider and its version, the
+// Terraform version when Terraform shares it, and any suffix the
+// configuration adds.
+func userAgent(providerVersion, terraformVersion, suffix string) string {
+	ua := client.DefaultUserAgent + "/" + providerVersion
+	if terraformVersion != "" {
+		ua += " Terraform/" + terraformVersion
+	}
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		ua += " " + suffix
+	}
+	return ua
+}
@@ -4,11 +4,26 @@
 package provider
 
 import (
+	"context"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // testAccProtoV6ProviderFactories is the law of the land for acceptance tests —
@@ -24,3 +39,841 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("LANGSMITH_API_KEY must be set for acceptance tests")
 	}
 }
+
+// testValidateResourceConfig runs a resource configuration through the
+// provider's full validation — attribute validators, config validators, and
+// ValidateConfig — without calling the API or needing Terraform on the path.
+// Attributes left out of attrs are treated as unset.
+func testValidateResourceConfig(t *testing.T, resourceType string, attrs map[string]tftypes.Value) []*tfprotov6.Diagnostic {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+	s, ok := schemaResp.ResourceSchemas[resourceType]
+	if !ok {
+		t.Fatalf("no schema for resource type %q", resourceType)
+	}
+
+	config, err := tfprotov6.NewDynamicValue(s.ValueType(), testConfigValue(s.Block, attrs))
+	if err != nil {
+		t.Fatalf("building config: %s", err)
+	}
+
+	resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: resourceType,
+		Config:   &config,
+	})
+	if err != nil {
+		t.Fatalf("validating config: %s", err)
+	}
+
+	return resp.Diagnostics
+}
+
+// testValidateProviderConfig runs the provider configuration built from attrs
+// through the provider server's validation, schema validators included, and
+// returns the diagnostics.
+func testValidateProviderConfig(t *testing.T, attrs map[string]tftypes.Value) []*tfprotov6.Diagnostic {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+
+	config, err := tfprotov6.NewDynamicValue(schemaResp.Provider.ValueType(), testConfigValue(schemaResp.Provider.Block, attrs))
+	if err != nil {
+		t.Fatalf("building config: %s", err)
+	}
+
+	resp, err := server.ValidateProviderConfig(ctx, &tfprotov6.ValidateProviderConfigRequest{Config: &config})
+	if err != nil {
+		t.Fatalf("validating config: %s", err)
+	}
+	return resp.Diagnostics
+}
+
+// testResourceServer drives one resource through the provider's protocol
+// server the way Terraform does, plan then apply, and refresh, so private
+// state and plan modifiers ride along as they would for real.
+type testResourceServer struct {
+	t        *testing.T
+	server   tfprotov6.ProviderServer
+	typeName string
+	schema   *tfprotov6.Schema
+	state    tftypes.Value
+	private  []byte
+}
+
+// newTestResourceServer configures the provider against endpoint and readies
+// a resource of the given type that doesn't exist yet.
+func newTestResourceServer(t *testing.T, endpoint, resourceType string) *testResourceServer {
+	t.Helper()
+	for _, v := range []string{"LANGSMITH_TENANT_ID", "LANGSMITH_ORGANIZATION_ID"} {
+		t.Setenv(v, "")
+	}
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+	s, ok := schemaResp.ResourceSchemas[resourceType]
+	if !ok {
+		t.Fatalf("no schema for resource type %q", resourceType)
+	}
+
+	providerConfig := testDynamicValue(t, schemaResp.Provider.ValueType(), testConfigValue(schemaResp.Provider.Block, map[string]tftypes.Value{
+		"api_key":  tftypes.NewValue(tftypes.String, "key"),
+		"endpoint": tftypes.NewValue(tftypes.String, endpoint),
+	}))
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: providerConfig})
+	if err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+	testFatalOnErrors(t, "configuring provider", configureResp.Diagnostics)
+
+	return &testResourceServer{
+		t:        t,
+		server:   server,
+		typeName: resourceType,
+		schema:   s,
+		state:    tftypes.NewValue(s.ValueType(), nil),
+	}
+}
+
+// plan plans the configuration against the current state and hands back the
+// planned state and private data.
+func (s *testResourceServer) plan(attrs map[string]tftypes.Value) (*tfprotov6.DynamicValue, []byte) {
+	s.t.Helper()
+
+	config := testConfigValue(s.schema.Block, attrs)
+	resp, err := s.server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         s.typeName,
+		PriorState:       testDynamicValue(s.t, s.schema.ValueType(), s.state),
+		ProposedNewState: testDynamicValue(s.t, s.schema.ValueType(), s.proposedState(config)),
+		Config:           testDynamicValue(s.t, s.schema.ValueType(), config),
+		PriorPrivate:     s.private,
+	})
+	if err != nil {
+		s.t.Fatalf("planning: %s", err)
+	}
+	testFatalOnErrors(s.t, "planning", resp.Diagnostics)
+	return resp.PlannedState, resp.PlannedPrivate
+}
+
+// planIsEmpty reports whether the configuration plans no change at all.
+func (s *testResourceServer) planIsEmpty(attrs map[string]tftypes.Value) bool {
+	s.t.Helper()
+
+	planned, _ := s.plan(attrs)
+	value, err := planned.Unmarshal(s.schema.ValueType())
+	if err != nil {
+		s.t.Fatalf("decoding plan: %s", err)
+	}
+	return value.Equal(s.state)
+}
+
+// apply plans and applies the configuration, keeping the new state. Like
+// Terraform, it won't take a new state that leaves anything unknown or
+// contradicts what was planned.
+func (s *testResourceServer) apply(attrs map[string]tftypes.Value) {
+	s.t.Helper()
+
+	planned, plannedPrivate := s.plan(attrs)
+	resp, err := s.server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       s.typeName,
+		PriorState:     testDynamicValue(s.t, s.schema.ValueType(), s.state),
+		PlannedState:   planned,
+		Config:         testDynamicValue(s.t, s.schema.ValueType(), testConfigValue(s.schema.Block, attrs)),
+		PlannedPrivate: plannedPrivate,
+	})
+	if err != nil {
+		s.t.Fatalf("applying: %s", err)
+	}
+	testFatalOnErrors(s.t, "applying", resp.Diagnostics)
+	s.setState(resp.NewState, resp.Private)
+
+	if !s.state.IsFullyKnown() {
+		s.t.Fatalf("applying: new state has unknown values: %s", s.state)
+	}
+	plannedValue, err := planned.Unmarshal(s.schema.ValueType())
+	if err != nil {
+		s.t.Fatalf("decoding plan: %s", err)
+	}
+	var plannedAttrs map[string]tftypes.Value
+	if err := plannedValue.As(&plannedAttrs); err != nil {
+		s.t.Fatalf("reading plan: %s", err)
+	}
+	for name, want := range plannedAttrs {
+		if got := s.attribute(name); want.IsFullyKnown() && !got.Equal(want) {
+			s.t.Fatalf("applying: %s was planned as %s but applied as %s", name, want, got)
+		}
+	}
+}
+
+// refresh reads the resource back into state.
+func (s *testResourceServer) refresh() {
+	s.t.Helper()
+
+	resp, err := s.server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     s.typeName,
+		CurrentState: testDynamicValue(s.t, s.schema.ValueType(), s.state),
+		Private:      s.private,
+	})
+	if err != nil {
+		s.t.Fatalf("refreshing: %s", err)
+	}
+	testFatalOnErrors(s.t, "refreshing", resp.Diagnostics)
+	s.setState(resp.NewState, resp.Private)
+}
+
+// attribute returns a top-level attribute of the current state.
+func (s *testResourceServer) attribute(name string) tftypes.Value {
+	s.t.Helper()
+
+	var attrs map[string]tftypes.Value
+	if err := s.state.As(&attrs); err != nil {
+		s.t.Fatalf("reading state: %s", err)
+	}
+	return attrs[name]
+}
+
+func (s *testResourceServer) setState(state *tfprotov6.DynamicValue, private []byte) {
+	s.t.Helper()
+
+	value, err := state.Unmarshal(s.schema.ValueType())
+	if err != nil {
+		s.t.Fatalf("decoding state: %s", err)
+	}
+	s.state, s.private = value, private
+}
+
+// proposedState merges config with the current state the way Terraform does
+// before planning: computed attributes left out of the configuration keep
+// their prior values.
+func (s *testResourceServer) proposedState(config tftypes.Value) tftypes.Value {
+	if s.state.IsNull() {
+		return config
+	}
+
+	// As hands back the value's own map, so build a fresh one rather than
+	// writing the prior values into config.
+	var configured, prior map[string]tftypes.Value
+	if err := config.As(&configured); err != nil {
+		s.t.Fatalf("reading config: %s", err)
+	}
+	if err := s.state.As(&prior); err != nil {
+		s.t.Fatalf("reading state: %s", err)
+	}
+	proposed := make(map[string]tftypes.Value, len(configured))
+	for name, v := range configured {
+		proposed[name] = v
+	}
+	for _, a := range s.schema.Block.Attributes {
+		if a.Computed && proposed[a.Name].IsNull() {
+			proposed[a.Name] = prior[a.Name]
+		}
+	}
+	return tftypes.NewValue(config.Type(), proposed)
+}
+
+// testDynamicValue wraps a value for the wire.
+func testDynamicValue(t *testing.T, typ tftypes.Type, value tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dv, err := tfprotov6.NewDynamicValue(typ, value)
+	if err != nil {
+		t.Fatalf("encoding value: %s", err)
+	}
+	return &dv
+}
+
+// testFatalOnErrors stops the test at the first error diagnostic.
+func testFatalOnErrors(t *testing.T, step string, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s: %s", step, d.Summary, d.Detail)
+		}
+	}
+}
+
+// testConfigValue fills in a config object for the block, using the given
+// values and leaving everything else null (or empty, for nested blocks).
+func testConfigValue(block *tfprotov6.SchemaBlock, attrs map[string]tftypes.Value) tftypes.Value {
+	objType := block.ValueType().(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+			continue
+		}
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	for _, nb := range block.BlockTypes {
+		if _, ok := attrs[nb.TypeName]; ok {
+			continue
+		}
+		switch nb.Nesting {
+		case tfprotov6.SchemaNestedBlockNestingModeList, tfprotov6.SchemaNestedBlockNestingModeSet:
+			vals[nb.TypeName] = tftypes.NewValue(objType.AttributeTypes[nb.TypeName], []tftypes.Value{})
+		}
+	}
+	return tftypes.NewValue(objType, vals)
+}
+
+// testDiagnosticsHaveSeverity reports whether any diagnostic at the given
+// severity mentions the attribute or summary text we're tracking.
+func testDiagnosticsHaveSeverity(diags []*tfprotov6.Diagnostic, severity tfprotov6.DiagnosticSeverity, contains string) bool {
+	for _, d := range diags {
+		if d.Severity != severity {
+			continue
+		}
+		if strings.Contains(d.Summary, contains) || strings.Contains(d.Detail, contains) {
+			return true
+		}
+		if d.Attribute != nil && strings.Contains(d.Attribute.String(), contains) {
+			return true
+		}
+	}
+	return false
+}
+
+// testResourceState builds a state for the resource holding the given model,
+// so CRUD methods can be exercised directly against a test server.
+func testResourceState(t *testing.T, r resource.Resource, model interface{}) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("getting schema: %v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+	return state
+}
+
+// testImportState runs a resource's ImportState with the given ID against an
+// empty state, the way Terraform starts an import.
+func testImportState(t *testing.T, r resource.ResourceWithImportState, id string) *resource.ImportStateResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+	return resp
+}
+
+// testModifyPlan runs a resource's ModifyPlan for a create, with config as
+// both the configuration and the proposed plan, and hands back the response.
+func testModifyPlan(t *testing.T, r resource.ResourceWithModifyPlan, config interface{}) *resource.ModifyPlanResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	built := testResourceState(t, r, config)
+	plan := tfsdk.Plan(built)
+	state := tfsdk.State{
+		Schema: built.Schema,
+		Raw:    tftypes.NewValue(built.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Config: tfsdk.Config(built),
+		Plan:   plan,
+		State:  state,
+	}, resp)
+	return resp
+}
+
+// testDataSourceRead runs a data source's Read against the given config model
+// and hands back the response.
+func testDataSourceRead(t *testing.T, d datasource.DataSource, config interface{}) *datasource.ReadResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("getting schema: %v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, config); diags.HasError() {
+		t.Fatalf("setting config: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: state}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config(state)}, resp)
+	return resp
+}
+
+// TestNormalizeEndpoint checks endpoints are trimmed to a base URL and that
+// anything without a scheme or host is turned away.
+func TestNormalizeEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		"saas":           {endpoint: "https://api.smith.langchain.com", want: "https://api.smith.langchain.com"},
+		"trailing slash": {endpoint: "https://langsmith.example.com/", want: "https://langsmith.example.com"},
+		"sdk style":      {endpoint: "https://langsmith.example.com/api/v1/", want: "https://langsmith.example.com"},
+		"path prefix":    {endpoint: "http://proxy.internal:8080/langsmith", want: "http://proxy.internal:8080/langsmith"},
+		"no scheme":      {endpoint: "langsmith.example.com", wantErr: true},
+		"wrong scheme":   {endpoint: "ftp://langsmith.example.com", wantErr: true},
+		"no host":        {endpoint: "https://", wantErr: true},
+		"does not parse": {endpoint: "https://exa mple.com:port", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := normalizeEndpoint(tc.endpoint)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Errorf("expected %q, got %q (%v)", tc.want, got, err)
+			}
+		})
+	}
+}
+
+// TestProviderConfigure_endpoint checks where the provider finds its API base
+// URL: the configuration first, then each environment variable in turn.
+func TestProviderConfigure_endpoint(t *testing.T) {
+	cases := map[string]struct {
+		endpoint types.String
+		apiURL   types.String
+		env      map[string]string
+		want     string
+		wantErr  bool
+	}{
+		"default":           {want: defaultEndpoint},
+		"endpoint":          {endpoint: types.StringValue("https://ls.example.com/api/v1"), env: map[string]string{"LANGSMITH_ENDPOINT": "https://env.example.com"}, want: "https://ls.example.com"},
+		"api_url":           {apiURL: types.StringValue("https://ls.example.com"), want: "https://ls.example.com"},
+		"langsmith env":     {env: map[string]string{"LANGSMITH_ENDPOINT": "https://a.example.com", "LANGCHAIN_ENDPOINT": "https://b.example.com"}, want: "https://a.example.com"},
+		"langchain env":     {env: map[string]string{"LANGCHAIN_ENDPOINT": "https://b.example.com", "LANGSMITH_API_URL": "https://c.example.com"}, want: "https://b.example.com"},
+		"legacy env":        {env: map[string]string{"LANGSMITH_API_URL": "https://c.example.com"}, want: "https://c.example.com"},
+		"invalid env":       {env: map[string]string{"LANGSMITH_ENDPOINT": "ls.example.com"}, wantErr: true},
+		"invalid in config": {endpoint: types.StringValue("ls.example.com"), wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, v := range append(endpointEnvVars, apiKeyEnvVars...) {
+				t.Setenv(v, tc.env[v])
+			}
+
+			resp := testProviderConfigure(t, &LangSmithProviderModel{
+				APIKey:   types.StringValue("key"),
+				APIURL:   tc.apiURL,
+				Endpoint: tc.endpoint,
+			})
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			c, ok := testConfiguredClient(resp)
+			if !ok {
+				t.Fatalf("expected provider data, got %T", resp.ResourceData)
+			}
+			if c.BaseURL != tc.want {
+				t.Errorf("expected base URL %q, got %q", tc.want, c.BaseURL)
+			}
+		})
+	}
+}
+
+// TestProviderConfigure_apiKey checks the order the API key is taken from:
+// the configuration, then LANGSMITH_API_KEY, then LANGCHAIN_API_KEY.
+func TestProviderConfigure_apiKey(t *testing.T) {
+	cases := map[string]struct {
+		apiKey  types.String
+		env     map[string]string
+		want    string
+		wantErr bool
+	}{
+		"config":        {apiKey: types.StringValue("config-key"), env: map[string]string{"LANGSMITH_API_KEY": "ls-key", "LANGCHAIN_API_KEY": "lc-key"}, want: "config-key"},
+		"langsmith env": {env: map[string]string{"LANGSMITH_API_KEY": "ls-key", "LANGCHAIN_API_KEY": "lc-key"}, want: "ls-key"},
+		"langchain env": {env: map[string]string{"LANGCHAIN_API_KEY": "lc-key"}, want: "lc-key"},
+		"none":          {wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, v := range append(endpointEnvVars, apiKeyEnvVars...) {
+				t.Setenv(v, tc.env[v])
+			}
+
+			resp := testProviderConfigure(t, &LangSmithProviderModel{APIKey: tc.apiKey})
+			if tc.wantErr {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Missing API Key" {
+					t.Errorf("expected a missing API key error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			c, ok := testConfiguredClient(resp)
+			if resp.Diagnostics.HasError() || !ok {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if c.APIKey != tc.want {
+				t.Errorf("expected API key %q, got %q", tc.want, c.APIKey)
+			}
+		})
+	}
+}
+
+// TestProviderConfigure_tls checks the TLS settings reach the client, with a
+// warning when certificate checks are switched off.
+func TestProviderConfigure_tls(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	resp := testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:             types.StringValue("key"),
+		InsecureSkipVerify: types.BoolValue(true),
+	})
+	if resp.Diagnostics.HasError() || !resp.Diagnostics.Contains(diag.NewAttributeWarningDiagnostic(
+		path.Root("insecure_skip_verify"),
+		"TLS Certificate Verification Disabled",
+		"The provider won't verify the LangSmith API's certificate, so traffic, including the API key, could be intercepted. Set ca_cert_file to trust an internal CA instead.",
+	)) {
+		t.Errorf("expected only a warning, got %v", resp.Diagnostics)
+	}
+
+	resp = testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:     types.StringValue("key"),
+		CACertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem")),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected a missing CA bundle to be an error")
+	}
+}
+
+// testProviderConfigure runs the provider's Configure with the given model as
+// its configuration. Attributes left as zero values are treated as unset.
+func testProviderConfigure(t *testing.T, model *LangSmithProviderModel) *provider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	if model.DefaultHeaders.ElementType(ctx) == nil {
+		model.DefaultHeaders = types.MapNull(types.StringType)
+	}
+	p := &LangSmithProvider{version: "test"}
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := config.Set(ctx, model); diags.HasError() {
+		t.Fatalf("building config: %v", diags)
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config(config)}, resp)
+	return resp
+}
+
+// testConfiguredClient hands back the client a configured provider passes to
+// its resources.
+func testConfiguredClient(resp *provider.ConfigureResponse) (*client.Client, bool) {
+	data, ok := resp.ResourceData.(*providerData)
+	if !ok {
+		return nil, false
+	}
+	return data.client, true
+}
+
+// TestProviderConfigure_retries checks the schema turns away out-of-range
+// retry settings, and that Configure refuses retry settings that aren't known
+// yet rather than reading them as zero.
+func TestProviderConfigure_retries(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	for _, tc := range []struct {
+		name    string
+		value   int64
+		wantErr bool
+	}{
+		{"max_retries", 0, false},
+		{"max_retries", -1, true},
+		{"max_retry_backoff", 1, false},
+		{"max_retry_backoff", 0, true},
+	} {
+		diags := testValidateProviderConfig(t, map[string]tftypes.Value{
+			tc.name: tftypes.NewValue(tftypes.Number, tc.value),
+		})
+		if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.name); got != tc.wantErr {
+			t.Errorf("%s = %d: expected error=%t, got %v", tc.name, tc.value, tc.wantErr, diags)
+		}
+	}
+
+	resp := testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:     types.StringValue("key"),
+		MaxRetries: types.Int64Unknown(),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an unknown max_retries to be an error")
+	}
+
+	resp = testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:          types.StringValue("key"),
+		MaxRetryBackoff: types.Int64Unknown(),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an unknown max_retry_backoff to be an error")
+	}
+}
+
+// TestProviderConfigure_requestTimeout checks request_timeout sets the client's
+// timeout, and that a timeout of zero or one not known yet is turned away.
+func TestProviderConfigure_requestTimeout(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	resp := testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:         types.StringValue("key"),
+		RequestTimeout: types.Int64Value(300),
+	})
+	c, ok := testConfiguredClient(resp)
+	if resp.Diagnostics.HasError() || !ok {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if c.HTTPClient.Timeout != 300*time.Second {
+		t.Errorf("expected a 300s timeout, got %s", c.HTTPClient.Timeout)
+	}
+
+	diags := testValidateProviderConfig(t, map[string]tftypes.Value{
+		"request_timeout": tftypes.NewValue(tftypes.Number, 0),
+	})
+	if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "request_timeout") {
+		t.Errorf("expected a zero timeout to be an error, got %v", diags)
+	}
+
+	resp = testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:         types.StringValue("key"),
+		RequestTimeout: types.Int64Unknown(),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an unknown timeout to be an error rather than no timeout")
+	}
+}
+
+// TestProviderConfigure_defaultHeaders checks default_headers reach the
+// client, and that a header the provider sets itself is turned away.
+func TestProviderConfigure_defaultHeaders(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	resp := testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey: types.StringValue("key"),
+		DefaultHeaders: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"x-gateway-team": types.StringValue("platform"),
+		}),
+	})
+	c, ok := testConfiguredClient(resp)
+	if resp.Diagnostics.HasError() || !ok {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if got := c.DefaultHeaders.Get("X-Gateway-Team"); got != "platform" {
+		t.Errorf("expected the header to reach the client, got %q", got)
+	}
+
+	for _, name := range []string{"Authorization", "x-api-key", "X-Tenant-Id", "x-organization-id"} {
+		resp = testProviderConfigure(t, &LangSmithProviderModel{
+			APIKey: types.StringValue("key"),
+			DefaultHeaders: types.MapValueMust(types.StringType, map[string]attr.Value{
+				name: types.StringValue("stolen"),
+			}),
+		})
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected a reserved header to be an error", name)
+		}
+	}
+}
+
+// TestProviderConfigure_organizationID checks organization_id reaches the
+// client, falling back to LANGSMITH_ORGANIZATION_ID, and that the API key's
+// current organization is used when neither is set.
+func TestProviderConfigure_organizationID(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	for _, tc := range []struct {
+		name, env string
+		config    types.String
+		want      string
+	}{
+		{name: "unset", config: types.StringNull(), want: ""},
+		{name: "env", env: "8d4f8a4e-7c8b-4b1e-9f3a-2a1b0c9d8e7f", config: types.StringNull(), want: "8d4f8a4e-7c8b-4b1e-9f3a-2a1b0c9d8e7f"},
+		{name: "config", env: "8d4f8a4e-7c8b-4b1e-9f3a-2a1b0c9d8e7f", config: types.StringValue("5b0e2c7a-3d1f-4e6a-8b9c-0d1e2f3a4b5c"), want: "5b0e2c7a-3d1f-4e6a-8b9c-0d1e2f3a4b5c"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("LANGSMITH_ORGANIZATION_ID", tc.env)
+			resp := testProviderConfigure(t, &LangSmithProviderModel{
+				APIKey:         types.StringValue("key"),
+				OrganizationID: tc.config,
+			})
+			c, ok := testConfiguredClient(resp)
+			if resp.Diagnostics.HasError() || !ok {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if c.OrganizationID != tc.want {
+				t.Errorf("expected organization %q, got %q", tc.want, c.OrganizationID)
+			}
+		})
+	}
+}
+
+// TestProviderConfigure_requestsPerSecond checks the schema turns away a
+// requests_per_second that isn't positive, and that Configure refuses one
+// that isn't known yet.
+func TestProviderConfigure_requestsPerSecond(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	for rps, wantErr := range map[float64]bool{0.5: false, 10: false, 0: true, -1: true} {
+		diags := testValidateProviderConfig(t, map[string]tftypes.Value{
+			"requests_per_second": tftypes.NewValue(tftypes.Number, rps),
+		})
+		if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "requests_per_second"); got != wantErr {
+			t.Errorf("%v: expected error=%t, got %v", rps, wantErr, diags)
+		}
+	}
+
+	resp := testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:            types.StringValue("key"),
+		RequestsPerSecond: types.Float64Unknown(),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an unknown requests_per_second to be an error")
+	}
+}
+
+// TestProviderConfigure_idempotencyKeys checks creates carry idempotency keys
+// unless idempotency_keys turns them off.
+func TestProviderConfigure_idempotencyKeys(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	for setting, want := range map[types.Bool]bool{types.BoolNull(): true, types.BoolValue(true): true, types.BoolValue(false): false} {
+		resp := testProviderConfigure(t, &LangSmithProviderModel{
+			APIKey:          types.StringValue("key"),
+			IdempotencyKeys: setting,
+		})
+		c, ok := testConfiguredClient(resp)
+		if resp.Diagnostics.HasError() || !ok {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		if c.IdempotencyKeys != want {
+			t.Errorf("%s: expected idempotency keys=%t, got %t", setting, want, c.IdempotencyKeys)
+		}
+	}
+}
+
+// TestProviderConfigure_defaultRoleID checks default_role_id reaches the
+// resources with the provider data rather than riding on the client.
+func TestProviderConfigure_defaultRoleID(t *testing.T) {
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+
+	resp := testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:        types.StringValue("key"),
+		DefaultRoleID: types.StringValue("viewer"),
+	})
+	data, ok := resp.ResourceData.(*providerData)
+	if resp.Diagnostics.HasError() || !ok {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if data.defaultRoleID != "viewer" || resp.DataSourceData != resp.ResourceData {
+		t.Errorf("expected the default role in the provider data, got %+v", data)
+	}
+}
+
+// TestUserAgent checks the User-Agent names the provider version, then the
+// Terraform version and any configured suffix when there are ones.
+func TestUserAgent(t *testing.T) {
+	cases := []struct {
+		terraformVersion, suffix, want string
+	}{
+		{"1.9.0", "", "terraform-provider-langsmith/1.2.3 Terraform/1.9.0"},
+		{"1.9.0", " team-ml ", "terraform-provider-langsmith/1.2.3 Terraform/1.9.0 team-ml"},
+		{"", "ci", "terraform-provider-langsmith/1.2.3 ci"},
+	}
+	for _, tc := range cases {
+		if got := userAgent("1.2.3", tc.terraformVersion, tc.suffix); got != tc.want {
+			t.Errorf("userAgent(%q, %q): expected %q, got %q", tc.terraformVersion, tc.suffix, tc.want, got)
+		}
+	}
+
+	for _, v := range endpointEnvVars {
+		t.Setenv(v, "")
+	}
+	resp := testProviderConfigure(t, &LangSmithProviderModel{
+		APIKey:          types.StringValue("key"),
+		UserAgentSuffix: types.StringValue("team-ml"),
+	})
+	c, ok := testConfiguredClient(resp)
+	if resp.Diagnostics.HasError() || !ok {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if c.UserAgent != "terraform-provider-langsmith/test team-ml" {
+		t.Errorf("unexpected User-Agent %q", c.UserAgent)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ datasource.DataSource                     = &RunRuleDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &RunRuleDataSource{}
+)
+
+// NewRunRuleDataSource returns a new RunRuleDataSource, for reading the law
+// another outfit laid down.
+func NewRunRuleDataSource() datasource.DataSource {
+	return &RunRuleDataSource{}
+}
+
+// RunRuleDataSource reads an existing automation rule by ID, or by display
+// name within a project, so rules made by other teams can be referenced
+// without being managed here.
+type RunRuleDataSource struct {
+	client *client.Client
+}
+
+// RunRuleDataSourceModel holds the lookup keys and what the rule does with
+// the runs it catches.
+type RunRuleDataSourceModel struct {
+	ID                     types.String  `tfsdk:"id"`
+	DisplayName            types.String  `tfsdk:"display_name"`
+	SessionID              types.String  `tfsdk:"session_id"`
+	SamplingRate           types.Float64 `tfsdk:"sampling_rate"`
+	IsEnabled              types.Bool    `tfsdk:"is_enabled"`
+	Filter                 types.String  `tfsdk:"filter"`
+	AddToDatasetID         types.String  `tfsdk:"add_to_dataset_id"`
+	AddToAnnotationQueueID types.String  `tfsdk:"add_to_annotation_queue_id"`
+	Evaluators             types.String  `tfsdk:"evaluators"`
+	CodeEvaluators         types.String  `tfsdk:"code_evaluators"`
+	Webhooks               types.String  `tfsdk:"webhooks"`
+	WorkspaceID            types.String  `tfsdk:"workspace_id"`
+}
+
+func (d *RunRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_rule"
+}
+
+func (d *RunRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to look up a LangSmith automation rule by ID, or by display name within a project.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the rule. Exactly one of `id` or `display_name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the rule. Exactly one of `id` or `display_name` must be specified; it's an error for the name to match more than one rule, so narrow it with `session_id` when names repeat across projects.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"session_id": schema.StringAttribute{
+				MarkdownDescription: "The project the rule applies to. When looking up by `display_name`, only rules on this project are considered.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"sampling_rate": schema.Float64Attribute{
+				MarkdownDescription: "The fraction of matching runs the rule acts on.",
+				Computed:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule is enabled.",
+				Computed:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "The run filter expression.",
+				Computed:            true,
+			},
+			"add_to_dataset_id": schema.StringAttribute{
+				MarkdownDescription: "The dataset matching runs are added to.",
+				Computed:            true,
+			},
+			"add_to_annotation_queue_id": schema.StringAttribute{
+				MarkdownDescription: "The annotation queue matching runs are sent to.",
+				Computed:            true,
+			},
+			"evaluators": schema.StringAttribute{
+				MarkdownDescription: "The rule's LLM evaluators, as a JSON array.",
+				Computed:            true,
+			},
+			"code_evaluators": schema.StringAttribute{
+				MarkdownDescription: "The rule's code evaluators, as a JSON array.",
+				Computed:            true,
+			},
+			"webhooks": schema.StringAttribute{
+				MarkdownDescription: "The rule's webhooks, as a JSON array.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RunRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *RunRuleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("display_name")),
+	}
+}
+
+func (d *RunRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RunRuleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	rules, err := listRunRules(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading run rules", err.Error())
+		return
+	}
+
+	var matches []runRuleAPIResponse
+	for _, rule := range rules {
+		switch {
+		case !data.ID.IsNull():
+			if rule.ID != data.ID.ValueString() {
+				continue
+			}
+		case rule.DisplayName != data.DisplayName.ValueString():
+			continue
+		case !data.SessionID.IsNull() && rule.SessionID != data.SessionID.ValueString():
+			continue
+		}
+		matches = append(matches, rule)
+	}
+
+	lookup := fmt.Sprintf("ID %q", data.ID.ValueString())
+	if data.ID.IsNull() {
+		lookup = fmt.Sprintf("display name %q", data.DisplayName.ValueString())
+		if !data.SessionID.IsNull() {
+			lookup += fmt.Sprintf(" on project %s", data.SessionID.ValueString())
+		}
+	}
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Run Rule Not Found", fmt.Sprintf("No run rule found with %s.", lookup))
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Ambiguous Run Rule Name",
+			fmt.Sprintf("Found %d run rules with %s; narrow the lookup with session_id, or use id.", len(matches), lookup),
+		)
+		return
+	}
+
+	rule := matches[0]
+	data.ID = types.StringValue(rule.ID)
+	data.DisplayName = types.StringValue(rule.DisplayName)
+	data.SamplingRate = types.Float64Value(rule.SamplingRate)
+	data.IsEnabled = types.BoolValue(rule.IsEnabled)
+	data.SessionID = optionalString(rule.SessionID)
+	data.Filter = optionalString(rule.Filter)
+	data.AddToDatasetID = optionalString(rule.AddToDatasetID)
+	data.AddToAnnotationQueueID = optionalString(rule.AddToAnnotationQueueID)
+	data.Evaluators = runRuleJSON(rule.Evaluators)
+	data.CodeEvaluators = runRuleJSON(rule.CodeEvaluators)
+	data.Webhooks = runRuleJSON(rule.Webhooks)
+
+	tflog.Trace(ctx, "read run rule data source", map[string]interface{}{"id": rule.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// optionalString maps a string the API leaves empty when unset.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// runRuleJSON maps one of a rule's JSON lists, null when the rule has none.
+func runRuleJSON(raw json.RawMessage) types.String {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+	return types.StringValue(string(raw))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestRunRuleDataSource_read checks a rule can be found by ID, or by display
+// name narrowed to a project, and that a name shared across projects needs
+// narrowing.
+func TestRunRuleDataSource_read(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/runs/rules" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("offset") != "0" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": "r1", "display_name": "errors to review", "session_id": "s1", "sampling_rate": 0.5, "is_enabled": true, "filter": "eq(error, true)", "add_to_annotation_queue_id": "q1", "webhooks": [{"url": "https://example.com"}]},
+			{"id": "r2", "display_name": "errors to review", "session_id": "s2", "sampling_rate": 1, "is_enabled": false, "add_to_dataset_id": "d1", "evaluators": null}
+		]`))
+	}))
+	defer srv.Close()
+
+	d := &RunRuleDataSource{client: client.NewClient(srv.URL, "key", "")}
+	read := func(config RunRuleDataSourceModel) (RunRuleDataSourceModel, bool) {
+		resp := testDataSourceRead(t, d, &config)
+		var got RunRuleDataSourceModel
+		resp.State.Get(context.Background(), &got)
+		return got, resp.Diagnostics.HasError()
+	}
+
+	byID, failed := read(RunRuleDataSourceModel{ID: types.StringValue("r2")})
+	if failed || byID.SessionID.ValueString() != "s2" || byID.AddToDatasetID.ValueString() != "d1" || byID.IsEnabled.ValueBool() {
+		t.Errorf("unexpected rule by id %+v", byID)
+	}
+	if !byID.Evaluators.IsNull() || !byID.Filter.IsNull() {
+		t.Errorf("expected unset fields to be null, got %+v", byID)
+	}
+
+	byName, failed := read(RunRuleDataSourceModel{DisplayName: types.StringValue("errors to review"), SessionID: types.StringValue("s1")})
+	if failed || byName.ID.ValueString() != "r1" || byName.SamplingRate.ValueFloat64() != 0.5 ||
+		byName.AddToAnnotationQueueID.ValueString() != "q1" || byName.Webhooks.ValueString() != `[{"url": "https://example.com"}]` {
+		t.Errorf("unexpected rule by name %+v", byName)
+	}
+
+	if _, failed := read(RunRuleDataSourceModel{DisplayName: types.StringValue("errors to review")}); !failed {
+		t.Error("expected an error for a name shared across projects")
+	}
+	if _, failed := read(RunRuleDataSourceModel{ID: types.StringValue("r9")}); !failed {
+		t.Error("expected an error for an unknown ID")
+	}
+}
@@ -6,8 +6,17 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -22,10 +32,19 @@ import (
 )
 
 var (
-	_ resource.Resource                = &RunRuleResource{}
-	_ resource.ResourceWithImportState = &RunRuleResource{}
+	_ resource.Resource                     = &RunRuleResource{}
+	_ resource.ResourceWithImportState      = &RunRuleResource{}
+	_ resource.ResourceWithConfigValidators = &RunRuleResource{}
 )
 
+// defaultRunRuleBackfillTimeout is how long a backfill is waited on when
+// wait_for_backfill is set without a backfill_timeout.
+const defaultRunRuleBackfillTimeout = 30 * time.Minute
+
+// runRuleBackfillPollInterval is how often a backfill's status is checked
+// while waiting. A variable so tests don't have to wait around.
+var runRuleBackfillPollInterval = 10 * time.Second
+
 // NewRunRuleResource returns a new RunRuleResource, badge and all.
 func NewRunRuleResource() resource.Resource {
 	return &RunRuleResource{}
@@ -40,37 +59,74 @@ type RunRuleResource struct {
 // RunRuleResourceModel is the Terraform state for an automation rule,
 // tracking everything from sampling rates to which corral the runs land in.
 type RunRuleResourceModel struct {
-	ID                           types.String  `tfsdk:"id"`
-	DisplayName                  types.String  `tfsdk:"display_name"`
-	SamplingRate                 types.Float64 `tfsdk:"sampling_rate"`
-	SessionID                    types.String  `tfsdk:"session_id"`
-	IsEnabled                    types.Bool    `tfsdk:"is_enabled"`
-	Filter                       types.String  `tfsdk:"filter"`
-	TraceFilter                  types.String  `tfsdk:"trace_filter"`
-	TreeFilter                   types.String  `tfsdk:"tree_filter"`
-	AddToAnnotationQueueID       types.String  `tfsdk:"add_to_annotation_queue_id"`
-	AddToDatasetID               types.String  `tfsdk:"add_to_dataset_id"`
-	AddToDatasetPreferCorrection types.Bool    `tfsdk:"add_to_dataset_prefer_correction"`
-	NumFewShotExamples           types.Int64   `tfsdk:"num_few_shot_examples"`
-	DatasetID                    types.String  `tfsdk:"dataset_id"`
-	BackfillFrom                 types.String  `tfsdk:"backfill_from"`
-	UseCorrectionsDataset        types.Bool    `tfsdk:"use_corrections_dataset"`
-	ExtendOnly                   types.Bool    `tfsdk:"extend_only"`
-	Transient                    types.Bool    `tfsdk:"transient"`
-	IncludeExtendedStats         types.Bool    `tfsdk:"include_extended_stats"`
-	GroupBy                      types.String  `tfsdk:"group_by"`
-	Evaluators                   types.String  `tfsdk:"evaluators"`
-	CodeEvaluators               types.String  `tfsdk:"code_evaluators"`
-	Alerts                       types.String  `tfsdk:"alerts"`
-	Webhooks                     types.String  `tfsdk:"webhooks"`
-	SessionName                  types.String  `tfsdk:"session_name"`
-	DatasetName                  types.String  `tfsdk:"dataset_name"`
-	CorrectionsDatasetID         types.String  `tfsdk:"corrections_dataset_id"`
-	EvaluatorID                  types.String  `tfsdk:"evaluator_id"`
-	AlignmentAnnotationQueueID   types.String  `tfsdk:"alignment_annotation_queue_id"`
-	TenantID                     types.String  `tfsdk:"tenant_id"`
-	CreatedAt                    types.String  `tfsdk:"created_at"`
-	UpdatedAt                    types.String  `tfsdk:"updated_at"`
+	ID                           types.String            `tfsdk:"id"`
+	DisplayName                  types.String            `tfsdk:"display_name"`
+	SamplingRate                 types.Float64           `tfsdk:"sampling_rate"`
+	SessionID                    types.String            `tfsdk:"session_id"`
+	IsEnabled                    types.Bool              `tfsdk:"is_enabled"`
+	Filter                       types.String            `tfsdk:"filter"`
+	NormalizedFilter             types.String            `tfsdk:"normalized_filter"`
+	TraceFilter                  types.String            `tfsdk:"trace_filter"`
+	TreeFilter                   types.String            `tfsdk:"tree_filter"`
+	AddToAnnotationQueueID       types.String            `tfsdk:"add_to_annotation_queue_id"`
+	AddToDatasetID               types.String            `tfsdk:"add_to_dataset_id"`
+	AddToDatasetPreferCorrection types.Bool              `tfsdk:"add_to_dataset_prefer_correction"`
+	NumFewShotExamples           types.Int64             `tfsdk:"num_few_shot_examples"`
+	DatasetID                    types.String            `tfsdk:"dataset_id"`
+	BackfillFrom                 types.String            `tfsdk:"backfill_from"`
+	TriggerBackfill              types.Bool              `tfsdk:"trigger_backfill"`
+	WaitForBackfill              types.Bool              `tfsdk:"wait_for_backfill"`
+	BackfillTimeout              types.String            `tfsdk:"backfill_timeout"`
+	BackfillStatus               types.String            `tfsdk:"backfill_status"`
+	UseCorrectionsDataset        types.Bool              `tfsdk:"use_corrections_dataset"`
+	ExtendOnly                   types.Bool              `tfsdk:"extend_only"`
+	Transient                    types.Bool              `tfsdk:"transient"`
+	IncludeExtendedStats         types.Bool              `tfsdk:"include_extended_stats"`
+	GroupBy                      types.String            `tfsdk:"group_by"`
+	Evaluators                   types.String            `tfsdk:"evaluators"`
+	CodeEvaluators               types.String            `tfsdk:"code_evaluators"`
+	Alerts                       types.String            `tfsdk:"alerts"`
+	Webhooks                     types.String            `tfsdk:"webhooks"`
+	Evaluator                    []runRuleEvaluatorModel `tfsdk:"evaluator"`
+	Webhook                      []runRuleWebhookModel   `tfsdk:"webhook"`
+	SessionName                  types.String            `tfsdk:"session_name"`
+	DatasetName                  types.String            `tfsdk:"dataset_name"`
+	CorrectionsDatasetID         types.String            `tfsdk:"corrections_dataset_id"`
+	EvaluatorID                  types.String            `tfsdk:"evaluator_id"`
+	AlignmentAnnotationQueueID   types.String            `tfsdk:"alignment_annotation_queue_id"`
+	TenantID                     types.String            `tfsdk:"tenant_id"`
+	CreatedAt                    types.String            `tfsdk:"created_at"`
+	UpdatedAt                    types.String            `tfsdk:"updated_at"`
+	WorkspaceID                  types.String            `tfsdk:"workspace_id"`
+}
+
+// runRuleEvaluatorModel is one typed evaluator block: the kind of evaluator,
+// and the model, prompt and variable mapping it judges runs with.
+type runRuleEvaluatorModel struct {
+	EvaluatorType   types.String `tfsdk:"evaluator_type"`
+	Model           types.String `tfsdk:"model"`
+	Prompt          types.String `tfsdk:"prompt"`
+	VariableMapping types.Map    `tfsdk:"variable_mapping"`
+}
+
+// runRuleWebhookModel is one typed webhook block.
+type runRuleWebhookModel struct {
+	URL     types.String `tfsdk:"url"`
+	Headers types.Map    `tfsdk:"headers"`
+}
+
+// runRuleEvaluatorSettings is the body of an evaluator as the API knows it,
+// keyed under its evaluator type.
+type runRuleEvaluatorSettings struct {
+	Model           json.RawMessage   `json:"model,omitempty"`
+	Prompt          json.RawMessage   `json:"prompt,omitempty"`
+	VariableMapping map[string]string `json:"variable_mapping,omitempty"`
+}
+
+// runRuleWebhook is a webhook as the API knows it.
+type runRuleWebhook struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
 }
 
 // runRuleCreateRequest is the warrant for establishing a new automation rule.
@@ -100,6 +156,17 @@ type runRuleCreateRequest struct {
 	Webhooks                     json.RawMessage `json:"webhooks,omitempty"`
 }
 
+// runRuleBackfillRequest asks for a rule to be run over past runs.
+type runRuleBackfillRequest struct {
+	BackfillFrom string `json:"backfill_from"`
+}
+
+// runRuleBackfillResponse is where a rule's backfill stands.
+type runRuleBackfillResponse struct {
+	Status string  `json:"status"`
+	Error  *string `json:"error"`
+}
+
 // runRuleAPIResponse is the full dossier the API returns on a run rule --
 // every last detail, like a wanted poster nailed to the Long Branch wall.
 type runRuleAPIResponse struct {
@@ -144,6 +211,7 @@ func (r *RunRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an automation rule for runs in LangSmith.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the run rule.",
 				Computed:            true,
@@ -154,8 +222,11 @@ func (r *RunRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:            true,
 			},
 			"sampling_rate": schema.Float64Attribute{
-				MarkdownDescription: "The sampling rate (0.0 to 1.0).",
+				MarkdownDescription: "The fraction of matching runs the rule samples, from `0.0` to `1.0` (e.g. `0.5` for 50%).",
 				Required:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0.0, 1.0),
+				},
 			},
 			"session_id": schema.StringAttribute{
 				MarkdownDescription: "The project/session UUID to scope this rule to.",
@@ -168,16 +239,26 @@ func (r *RunRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             booldefault.StaticBool(true),
 			},
 			"filter": schema.StringAttribute{
-				MarkdownDescription: "Run filter expression.",
+				MarkdownDescription: "Run filter expression. Checked at plan time for syntax errors, such as unbalanced parentheses or an unterminated string.",
 				Optional:            true,
+				Validators:          []validator.String{validFilter()},
+			},
+			"normalized_filter": schema.StringAttribute{
+				MarkdownDescription: "The `filter` expression in canonical form: whitespace collapsed, operator names lowercased, strings double-quoted, and the arguments of `and`/`or` sorted. Filters that mean the same thing have the same `normalized_filter`, so modules can compare them reliably.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					canonicalFilterOf(path.Root("filter")),
+				},
 			},
 			"trace_filter": schema.StringAttribute{
-				MarkdownDescription: "Trace filter expression.",
+				MarkdownDescription: "Trace filter expression. Checked at plan time for syntax errors, such as unbalanced parentheses or an unterminated string.",
 				Optional:            true,
+				Validators:          []validator.String{validFilter()},
 			},
 			"tree_filter": schema.StringAttribute{
-				MarkdownDescription: "Tree filter expression.",
+				MarkdownDescription: "Tree filter expression. Checked at plan time for syntax errors, such as unbalanced parentheses or an unterminated string.",
 				Optional:            true,
+				Validators:          []validator.String{validFilter()},
 			},
 			"add_to_annotation_queue_id": schema.StringAttribute{
 				MarkdownDescription: "UUID of the annotation queue to add matching runs to.",
@@ -205,8 +286,28 @@ func (r *RunRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"backfill_from": schema.StringAttribute{
-				MarkdownDescription: "ISO timestamp to backfill rules from.",
+				MarkdownDescription: "ISO timestamp to backfill rules from. On its own this is only recorded on the rule; set `trigger_backfill` to run the backfill.",
+				Optional:            true,
+			},
+			"trigger_backfill": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the rule is run over past runs from `backfill_from` once it's created, and again whenever `backfill_from` changes or this is switched on. Requires `backfill_from`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_backfill": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, apply waits for a triggered backfill to reach `completed` or `failed` instead of returning once it has started. A failed backfill is reported as an error. Requires `trigger_backfill`. Defaults to `false`.",
 				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"backfill_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for a backfill when `wait_for_backfill` is `true`, as a duration such as `30m` or `2h`. Defaults to `30m`.",
+				Optional:            true,
+			},
+			"backfill_status": schema.StringAttribute{
+				MarkdownDescription: "The status of the last backfill this resource triggered, such as `pending`, `running`, `completed`, or `failed`. Null until a backfill is triggered; an unfinished backfill's status is refreshed on each read.",
+				Computed:            true,
 			},
 			"use_corrections_dataset": schema.BoolAttribute{
 				MarkdownDescription: "Whether to use a corrections dataset.",
@@ -233,20 +334,38 @@ func (r *RunRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"evaluators": schema.StringAttribute{
-				MarkdownDescription: "JSON-encoded array of evaluator configurations.",
-				Optional:            true,
+				MarkdownDescription: "JSON-encoded array of evaluator configurations. " +
+					"Deprecated: use `evaluator` blocks instead. Always reflects the evaluators the API holds, however they were configured.",
+				DeprecationMessage: "Use evaluator blocks instead. The evaluators attribute will be removed in a future major version.",
+				Optional:           true,
+				Computed:           true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"code_evaluators": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded array of code evaluator configurations.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"alerts": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded array of alert configurations.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			"webhooks": schema.StringAttribute{
-				MarkdownDescription: "JSON-encoded array of webhook configurations.",
-				Optional:            true,
+				MarkdownDescription: "JSON-encoded array of webhook configurations. " +
+					"Deprecated: use `webhook` blocks instead. Always reflects the webhooks the API holds, however they were configured.",
+				DeprecationMessage: "Use webhook blocks instead. The webhooks attribute will be removed in a future major version.",
+				Optional:           true,
+				Computed:           true,
+				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
+				},
 			},
 			// Computed fields the API sends back -- read-only dispatches from the marshal's office.
 			"session_name": schema.StringAttribute{
@@ -282,6 +401,55 @@ func (r *RunRuleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"evaluator": schema.ListNestedBlock{
+				MarkdownDescription: "An evaluator run against matching runs. Repeat the block for several evaluators; leave it out, along with `evaluators`, for a rule with none.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"evaluator_type": schema.StringAttribute{
+							MarkdownDescription: "The kind of evaluator, such as `structured`. The settings below are sent under this key.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "The model the evaluator runs with. A JSON object, e.g. from `jsonencode`, is sent as JSON; anything else as a string.",
+							Optional:            true,
+						},
+						"prompt": schema.StringAttribute{
+							MarkdownDescription: "The evaluator's prompt. A JSON array of messages, e.g. `jsonencode([[\"system\", \"...\"]])`, is sent as JSON; anything else as a string.",
+							Optional:            true,
+						},
+						"variable_mapping": schema.MapAttribute{
+							MarkdownDescription: "Maps prompt variables to fields of the run, such as `input` to `input.question`.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"webhook": schema.ListNestedBlock{
+				MarkdownDescription: "A webhook called with matching runs. Repeat the block for several webhooks; leave it out, along with `webhooks`, for a rule with none.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL to send runs to.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"headers": schema.MapAttribute{
+							MarkdownDescription: "Headers sent with each request.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -289,13 +457,13 @@ func (r *RunRuleResource) Configure(ctx context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
 		return
 	}
-	r.client = c
+	r.client = data.client
 }
 
 func (r *RunRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -305,6 +473,8 @@ func (r *RunRuleResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := runRuleCreateRequest{
 		DisplayName:  data.DisplayName.ValueString(),
 		SamplingRate: data.SamplingRate.ValueFloat64(),
@@ -363,7 +533,15 @@ func (r *RunRuleResource) Create(ctx context.Context, req resource.CreateRequest
 		v := data.GroupBy.ValueString()
 		body.GroupBy = &v
 	}
-	if !data.Evaluators.IsNull() && !data.Evaluators.IsUnknown() {
+	switch {
+	case len(data.Evaluator) > 0:
+		evaluators, err := buildRunRuleEvaluators(data.Evaluator)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Run Rule Evaluator", err.Error())
+			return
+		}
+		body.Evaluators = evaluators
+	case !data.Evaluators.IsNull() && !data.Evaluators.IsUnknown():
 		body.Evaluators = json.RawMessage(data.Evaluators.ValueString())
 	}
 	if !data.CodeEvaluators.IsNull() && !data.CodeEvaluators.IsUnknown() {
@@ -372,20 +550,36 @@ func (r *RunRuleResource) Create(ctx context.Context, req resource.CreateRequest
 	if !data.Alerts.IsNull() && !data.Alerts.IsUnknown() {
 		body.Alerts = json.RawMessage(data.Alerts.ValueString())
 	}
-	if !data.Webhooks.IsNull() && !data.Webhooks.IsUnknown() {
+	switch {
+	case len(data.Webhook) > 0:
+		webhooks, err := buildRunRuleWebhooks(data.Webhook)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Run Rule Webhook", err.Error())
+			return
+		}
+		body.Webhooks = webhooks
+	case !data.Webhooks.IsNull() && !data.Webhooks.IsUnknown():
 		body.Webhooks = json.RawMessage(data.Webhooks.ValueString())
 	}
 
 	var result runRuleAPIResponse
-	err := r.client.Post(ctx, "/api/v1/runs/rules", body, &result)
+	err := r.client.Create(ctx, "/api/v1/runs/rules", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating run rule", err.Error())
 		return
 	}
 
+	// Blocks can't be computed; keep them as planned so apply stays consistent.
+	evaluatorBlocks, webhookBlocks := data.Evaluator, data.Webhook
 	r.mapResponseToModel(&result, &data)
-
+	data.Evaluator, data.Webhook = evaluatorBlocks, webhookBlocks
 	tflog.Trace(ctx, "created run rule resource", map[string]interface{}{"id": result.ID})
+
+	data.BackfillStatus = types.StringNull()
+	if data.TriggerBackfill.ValueBool() {
+		r.backfill(ctx, &data, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -396,29 +590,92 @@ func (r *RunRuleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	var rules []runRuleAPIResponse
-	err := r.client.Get(ctx, "/api/v1/runs/rules", nil, &rules)
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	found, err := findRunRule(ctx, r.client, data.ID.ValueString(), data.SessionID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading run rules", err.Error())
 		return
 	}
-
-	var found *runRuleAPIResponse
-	for i := range rules {
-		if rules[i].ID == data.ID.ValueString() {
-			found = &rules[i]
-			break
-		}
-	}
 	if found == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	r.mapResponseToModel(found, &data)
+
+	// A backfill left running at apply is checked on, so its status doesn't
+	// stay stuck at whatever it was when apply moved on. Trouble reading the
+	// status isn't worth failing the refresh over.
+	if !data.BackfillStatus.IsNull() && !runRuleBackfillDone(data.BackfillStatus.ValueString()) {
+		var status runRuleBackfillResponse
+		if err := r.client.Get(ctx, fmt.Sprintf("/api/v1/runs/rules/%s/backfill", data.ID.ValueString()), nil, &status); err == nil {
+			data.BackfillStatus = types.StringValue(status.Status)
+		} else {
+			tflog.Debug(ctx, "couldn't refresh run rule backfill status", map[string]interface{}{"id": data.ID.ValueString(), "error": err.Error()})
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// listRunRules rounds up every automation rule in the workspace. The API has
+// no endpoint for fetching a single rule.
+func listRunRules(ctx context.Context, c *client.Client) ([]runRuleAPIResponse, error) {
+	var rules []runRuleAPIResponse
+	err := pageRunRules(ctx, c, nil, func(batch []runRuleAPIResponse) bool {
+		rules = append(rules, batch...)
+		return false
+	})
+	return rules, err
+}
+
+// findRunRule tracks down the rule with the ID, returning nil only when it's
+// genuinely gone. A rule on a project is looked for among that project's
+// rules first; failing that, every rule in the workspace is searched, in
+// case it's been moved or the project filter missed it.
+func findRunRule(ctx context.Context, c *client.Client, id, sessionID string) (*runRuleAPIResponse, error) {
+	var found *runRuleAPIResponse
+	byID := func(batch []runRuleAPIResponse) bool {
+		for i := range batch {
+			if batch[i].ID == id {
+				found = &batch[i]
+				return true
+			}
+		}
+		return false
+	}
+
+	if sessionID != "" {
+		if err := pageRunRules(ctx, c, url.Values{"session": {sessionID}}, byID); err != nil {
+			return nil, err
+		}
+		if found != nil {
+			return found, nil
+		}
+	}
+
+	if err := pageRunRules(ctx, c, nil, byID); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// pageRunRules walks the rules list a page at a time, handing each page to
+// visit until it reports it's found what it came for.
+func pageRunRules(ctx context.Context, c *client.Client, query url.Values, visit func([]runRuleAPIResponse) bool) error {
+	return c.GetAllPages(ctx, "/api/v1/runs/rules", query, func(page json.RawMessage) (int, error) {
+		var batch []runRuleAPIResponse
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return 0, err
+		}
+		if visit(batch) {
+			return 0, client.ErrStopPaging
+		}
+		return len(batch), nil
+	})
+}
+
 func (r *RunRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data RunRuleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -426,6 +683,8 @@ func (r *RunRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := runRuleCreateRequest{
 		DisplayName:  data.DisplayName.ValueString(),
 		SamplingRate: data.SamplingRate.ValueFloat64(),
@@ -484,7 +743,15 @@ func (r *RunRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 		v := data.GroupBy.ValueString()
 		body.GroupBy = &v
 	}
-	if !data.Evaluators.IsNull() && !data.Evaluators.IsUnknown() {
+	switch {
+	case len(data.Evaluator) > 0:
+		evaluators, err := buildRunRuleEvaluators(data.Evaluator)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Run Rule Evaluator", err.Error())
+			return
+		}
+		body.Evaluators = evaluators
+	case !data.Evaluators.IsNull() && !data.Evaluators.IsUnknown():
 		body.Evaluators = json.RawMessage(data.Evaluators.ValueString())
 	}
 	if !data.CodeEvaluators.IsNull() && !data.CodeEvaluators.IsUnknown() {
@@ -493,7 +760,15 @@ func (r *RunRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !data.Alerts.IsNull() && !data.Alerts.IsUnknown() {
 		body.Alerts = json.RawMessage(data.Alerts.ValueString())
 	}
-	if !data.Webhooks.IsNull() && !data.Webhooks.IsUnknown() {
+	switch {
+	case len(data.Webhook) > 0:
+		webhooks, err := buildRunRuleWebhooks(data.Webhook)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Run Rule Webhook", err.Error())
+			return
+		}
+		body.Webhooks = webhooks
+	case !data.Webhooks.IsNull() && !data.Webhooks.IsUnknown():
 		body.Webhooks = json.RawMessage(data.Webhooks.ValueString())
 	}
 
@@ -504,7 +779,22 @@ func (r *RunRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	evaluatorBlocks, webhookBlocks := data.Evaluator, data.Webhook
 	r.mapResponseToModel(&result, &data)
+	data.Evaluator, data.Webhook = evaluatorBlocks, webhookBlocks
+
+	// A backfill runs again only when it's newly switched on or its starting
+	// point moves; any other change to the rule leaves the last one be.
+	var prior RunRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.BackfillStatus = prior.BackfillStatus
+	if data.TriggerBackfill.ValueBool() && (!prior.TriggerBackfill.ValueBool() || !prior.BackfillFrom.Equal(data.BackfillFrom)) {
+		r.backfill(ctx, &data, &resp.Diagnostics)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -515,6 +805,8 @@ func (r *RunRuleResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, fmt.Sprintf("/api/v1/runs/rules/%s", data.ID.ValueString()))
 	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error deleting run rule", err.Error())
@@ -522,9 +814,318 @@ func (r *RunRuleResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *RunRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *RunRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		runRuleBlocksValidator{attribute: "evaluators", block: "evaluator", summary: "Conflicting Run Rule Evaluators"},
+		runRuleBlocksValidator{attribute: "webhooks", block: "webhook", summary: "Conflicting Run Rule Webhooks"},
+		runRuleBackfillValidator{},
+	}
+}
+
+// runRuleBackfillValidator makes sure a backfill has what it needs: a
+// starting point to trigger from, a trigger to wait on, and a timeout we can
+// read.
+type runRuleBackfillValidator struct{}
+
+func (v runRuleBackfillValidator) Description(ctx context.Context) string {
+	return "trigger_backfill requires backfill_from, and wait_for_backfill requires trigger_backfill"
+}
+
+func (v runRuleBackfillValidator) MarkdownDescription(ctx context.Context) string {
+	return "`trigger_backfill` requires `backfill_from`, and `wait_for_backfill` requires `trigger_backfill`"
+}
+
+func (v runRuleBackfillValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Attributes are read one by one; the whole model can't take evaluator or
+	// webhook blocks that aren't known yet.
+	var data RunRuleResourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("backfill_from"), &data.BackfillFrom)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trigger_backfill"), &data.TriggerBackfill)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_for_backfill"), &data.WaitForBackfill)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("backfill_timeout"), &data.BackfillTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TriggerBackfill.ValueBool() && data.BackfillFrom.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("trigger_backfill"),
+			"Missing Backfill Start",
+			"trigger_backfill needs backfill_from to say how far back the backfill goes.",
+		)
+	}
+	if data.WaitForBackfill.ValueBool() && !data.TriggerBackfill.IsUnknown() && !data.TriggerBackfill.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_backfill"),
+			"Nothing To Wait For",
+			"wait_for_backfill only applies when trigger_backfill is true.",
+		)
+	}
+	if !data.BackfillTimeout.IsNull() && !data.BackfillTimeout.IsUnknown() {
+		if d, err := time.ParseDuration(data.BackfillTimeout.ValueString()); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backfill_timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("backfill_timeout must be a positive duration such as \"30m\" or \"2h\", got %q.", data.BackfillTimeout.ValueString()),
+			)
+		}
+	}
+}
+
+// backfill sets the rule loose on past runs from backfill_from and, with
+// wait_for_backfill, keeps watch until it's done or the timeout runs out.
+// data always ends up holding the last status seen.
+func (r *RunRuleResource) backfill(ctx context.Context, data *RunRuleResourceModel, diags *diag.Diagnostics) {
+	id := data.ID.ValueString()
+	apiPath := fmt.Sprintf("/api/v1/runs/rules/%s/backfill", id)
+
+	var result runRuleBackfillResponse
+	err := r.client.Post(ctx, apiPath, runRuleBackfillRequest{BackfillFrom: data.BackfillFrom.ValueString()}, &result)
+	if err != nil {
+		diags.AddError("Error triggering run rule backfill", err.Error())
+		return
+	}
+	data.BackfillStatus = types.StringValue(result.Status)
+	tflog.Info(ctx, "triggered run rule backfill", map[string]interface{}{"id": id, "backfill_from": data.BackfillFrom.ValueString(), "status": result.Status})
+
+	if !data.WaitForBackfill.ValueBool() {
+		return
+	}
+
+	timeout := defaultRunRuleBackfillTimeout
+	if !data.BackfillTimeout.IsNull() && !data.BackfillTimeout.IsUnknown() {
+		if d, err := time.ParseDuration(data.BackfillTimeout.ValueString()); err == nil && d > 0 {
+			timeout = d
+		}
+	}
+
+	// The trigger's answer is the first look; no trip to the API needed.
+	refresh := false
+	err = poll(ctx, runRuleBackfillPollInterval, timeout, func(ctx context.Context) (bool, error) {
+		if refresh {
+			if err := r.client.Get(ctx, apiPath, nil, &result); err != nil {
+				return false, fmt.Errorf("reading run rule backfill: %w", err)
+			}
+			data.BackfillStatus = types.StringValue(result.Status)
+		}
+		refresh = true
+
+		if runRuleBackfillDone(result.Status) {
+			return true, nil
+		}
+		tflog.Info(ctx, "waiting for run rule backfill", map[string]interface{}{"id": id, "status": result.Status})
+		return false, nil
+	})
+
+	switch {
+	case errors.Is(err, errPollTimeout):
+		diags.AddError(
+			"Timed Out Waiting For Run Rule Backfill",
+			fmt.Sprintf("The backfill of run rule %s was still %q after %s. Raise backfill_timeout, or set wait_for_backfill = false to stop waiting.", id, result.Status, timeout),
+		)
+	case err != nil:
+		diags.AddError("Error waiting for run rule backfill", err.Error())
+	case strings.EqualFold(result.Status, "failed"):
+		detail := fmt.Sprintf("The backfill of run rule %s failed.", id)
+		if result.Error != nil && *result.Error != "" {
+			detail += " " + *result.Error
+		}
+		diags.AddError("Run Rule Backfill Failed", detail)
+	default:
+		tflog.Info(ctx, "run rule backfill finished", map[string]interface{}{"id": id, "status": result.Status})
+	}
+}
+
+// runRuleBackfillDone reports whether a backfill status is final.
+func runRuleBackfillDone(status string) bool {
+	return strings.EqualFold(status, "completed") || strings.EqualFold(status, "failed")
+}
+
+// runRuleBlocksValidator makes sure a rule's evaluators or webhooks are given
+// one way, not both: typed blocks or the deprecated JSON attribute.
+type runRuleBlocksValidator struct {
+	attribute string
+	block     string
+	summary   string
+}
+
+func (v runRuleBlocksValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s blocks and %s can't both be set", v.block, v.attribute)
+}
+
+func (v runRuleBlocksValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("`%s` blocks and `%s` can't both be set", v.block, v.attribute)
+}
+
+func (v runRuleBlocksValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var legacy types.String
+	var blocks types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.attribute), &legacy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.block), &blocks)...)
+	if resp.Diagnostics.HasError() || legacy.IsNull() || blocks.IsNull() {
+		return
+	}
+
+	if blocks.IsUnknown() || len(blocks.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(v.attribute),
+			v.summary,
+			fmt.Sprintf("Set either %s blocks or the deprecated %s attribute, not both.", v.block, v.attribute),
+		)
+	}
+}
+
+// buildRunRuleEvaluators assembles the evaluators JSON from typed evaluator
+// blocks, each one's settings keyed under its evaluator type.
+func buildRunRuleEvaluators(blocks []runRuleEvaluatorModel) (json.RawMessage, error) {
+	evaluators := make([]map[string]runRuleEvaluatorSettings, 0, len(blocks))
+	for i, block := range blocks {
+		var settings runRuleEvaluatorSettings
+
+		if !block.Model.IsNull() && !block.Model.IsUnknown() {
+			model, err := blockValueJSON(block.Model.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("evaluator %d, model: %w", i, err)
+			}
+			settings.Model = model
+		}
+		if !block.Prompt.IsNull() && !block.Prompt.IsUnknown() {
+			prompt, err := blockValueJSON(block.Prompt.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("evaluator %d, prompt: %w", i, err)
+			}
+			settings.Prompt = prompt
+		}
+		settings.VariableMapping = stringMapElements(block.VariableMapping)
+
+		evaluators = append(evaluators, map[string]runRuleEvaluatorSettings{
+			block.EvaluatorType.ValueString(): settings,
+		})
+	}
+
+	return json.Marshal(evaluators)
+}
+
+// buildRunRuleWebhooks assembles the webhooks JSON from typed webhook blocks.
+func buildRunRuleWebhooks(blocks []runRuleWebhookModel) (json.RawMessage, error) {
+	webhooks := make([]runRuleWebhook, 0, len(blocks))
+	for _, block := range blocks {
+		webhooks = append(webhooks, runRuleWebhook{
+			URL:     block.URL.ValueString(),
+			Headers: stringMapElements(block.Headers),
+		})
+	}
+
+	return json.Marshal(webhooks)
+}
+
+// decomposeRunRuleEvaluators breaks the API's evaluators back down into typed
+// blocks, the reverse of buildRunRuleEvaluators. Settings the blocks have no
+// room for stay in the evaluators attribute.
+func decomposeRunRuleEvaluators(raw json.RawMessage) ([]runRuleEvaluatorModel, error) {
+	var evaluators []map[string]runRuleEvaluatorSettings
+	if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &evaluators); err != nil {
+			return nil, err
+		}
+	}
+
+	blocks := make([]runRuleEvaluatorModel, 0, len(evaluators))
+	for _, evaluator := range evaluators {
+		evaluatorTypes := make([]string, 0, len(evaluator))
+		for evaluatorType := range evaluator {
+			evaluatorTypes = append(evaluatorTypes, evaluatorType)
+		}
+		sort.Strings(evaluatorTypes)
+
+		for _, evaluatorType := range evaluatorTypes {
+			settings := evaluator[evaluatorType]
+			block := runRuleEvaluatorModel{
+				EvaluatorType:   types.StringValue(evaluatorType),
+				Model:           types.StringNull(),
+				Prompt:          types.StringNull(),
+				VariableMapping: stringMapValue(settings.VariableMapping),
+			}
+
+			if len(settings.Model) > 0 && string(settings.Model) != "null" {
+				model, err := blockValueString(settings.Model)
+				if err != nil {
+					return nil, err
+				}
+				block.Model = types.StringValue(model)
+			}
+			if len(settings.Prompt) > 0 && string(settings.Prompt) != "null" {
+				prompt, err := blockValueString(settings.Prompt)
+				if err != nil {
+					return nil, err
+				}
+				block.Prompt = types.StringValue(prompt)
+			}
+
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks, nil
+}
+
+// decomposeRunRuleWebhooks breaks the API's webhooks back down into typed
+// blocks, the reverse of buildRunRuleWebhooks.
+func decomposeRunRuleWebhooks(raw json.RawMessage) ([]runRuleWebhookModel, error) {
+	var webhooks []runRuleWebhook
+	if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &webhooks); err != nil {
+			return nil, err
+		}
+	}
+
+	blocks := make([]runRuleWebhookModel, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		blocks = append(blocks, runRuleWebhookModel{
+			URL:     types.StringValue(webhook.URL),
+			Headers: stringMapValue(webhook.Headers),
+		})
+	}
+
+	return blocks, nil
+}
+
+// stringMapElements pulls the known values out of a map of strings, or nil
+// when there are none.
+func stringMapElements(m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() || len(m.Elements()) == 0 {
+		return nil
+	}
+
+	values := make(map[string]string, len(m.Elements()))
+	for key, value := range m.Elements() {
+		str, ok := value.(types.String)
+		if !ok || str.IsNull() || str.IsUnknown() {
+			continue
+		}
+		values[key] = str.ValueString()
+	}
+	return values
+}
+
+// stringMapValue is the reverse of stringMapElements: an empty map comes back
+// null, as an unset block attribute would be.
+func stringMapValue(values map[string]string) types.Map {
+	if len(values) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
 // mapResponseToModel translates the API's response into Terraform state,
 // setting null for any optional fields that came back empty from the territory.
 func (r *RunRuleResource) mapResponseToModel(result *runRuleAPIResponse, data *RunRuleResourceModel) {
@@ -543,8 +1144,10 @@ func (r *RunRuleResource) mapResponseToModel(result *runRuleAPIResponse, data *R
 	}
 	if result.Filter != "" {
 		data.Filter = types.StringValue(result.Filter)
+		data.NormalizedFilter = types.StringValue(canonicalizeFilter(result.Filter))
 	} else {
 		data.Filter = types.StringNull()
+		data.NormalizedFilter = types.StringNull()
 	}
 	if result.TraceFilter != "" {
 		data.TraceFilter = types.StringValue(result.TraceFilter)
@@ -590,6 +1193,19 @@ func (r *RunRuleResource) mapResponseToModel(result *runRuleAPIResponse, data *R
 		data.GroupBy = types.StringNull()
 	}
 	// JSON fields -- Doc Adams keeps meticulous records and so do we.
+	// Typed blocks are refreshed when they're in use, or on import, when
+	// nothing is known yet. A rule still on the deprecated JSON keeps its
+	// blocks empty.
+	if len(data.Evaluator) > 0 || data.Evaluators.IsNull() {
+		if blocks, err := decomposeRunRuleEvaluators(result.Evaluators); err == nil {
+			data.Evaluator = blocks
+		}
+	}
+	if len(data.Webhook) > 0 || data.Webhooks.IsNull() {
+		if blocks, err := decomposeRunRuleWebhooks(result.Webhooks); err == nil {
+			data.Webhook = blocks
+		}
+	}
 	if len(result.Evaluators) > 0 && string(result.Evaluators) != "null" {
 		data.Evaluators = types.StringValue(string(result.Evaluators))
 	} else {
@@ -0,0 +1,453 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestRunRuleResource_samplingRate checks the sampling rate stays inside the
+// fence: both ends of 0.0–1.0 are fine, anything past them is turned away.
+func TestRunRuleResource_samplingRate(t *testing.T) {
+	cases := map[string]struct {
+		rate    float64
+		wantErr bool
+	}{
+		"zero":          {rate: 0.0},
+		"one":           {rate: 1.0},
+		"half":          {rate: 0.5},
+		"negative":      {rate: -0.1, wantErr: true},
+		"just over one": {rate: 1.0001, wantErr: true},
+		"percent typo":  {rate: 50, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_run_rule", map[string]tftypes.Value{
+				"display_name":  tftypes.NewValue(tftypes.String, "sampler"),
+				"sampling_rate": tftypes.NewValue(tftypes.Number, tc.rate),
+			})
+			gotErr := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "sampling_rate")
+			if gotErr != tc.wantErr {
+				t.Errorf("expected error=%t, got diagnostics %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestRunRuleResource_filterValidation checks broken filters are caught at
+// plan time instead of quietly matching no runs, on all three filters.
+func TestRunRuleResource_filterValidation(t *testing.T) {
+	cases := map[string]struct {
+		filter  string
+		wantErr bool
+	}{
+		"simple":           {filter: `eq(status, "error")`},
+		"nested":           {filter: `and(eq(feedback_key, 'correctness'), gte(latency, 5))`},
+		"list":             {filter: `in(run_type, ["llm", "chain"])`},
+		"unbalanced":       {filter: `and(eq(status, "error")`, wantErr: true},
+		"unterminated":     {filter: `eq(status, "error)`, wantErr: true},
+		"trailing garbage": {filter: `eq(status, "error"))`, wantErr: true},
+		"bare field":       {filter: `status`, wantErr: true},
+		"missing argument": {filter: `eq(status, )`, wantErr: true},
+		"empty":            {filter: ``, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		for _, attr := range []string{"filter", "trace_filter", "tree_filter"} {
+			t.Run(name+"/"+attr, func(t *testing.T) {
+				diags := testValidateResourceConfig(t, "langsmith_run_rule", map[string]tftypes.Value{
+					"display_name":  tftypes.NewValue(tftypes.String, "filtered"),
+					"sampling_rate": tftypes.NewValue(tftypes.Number, 1.0),
+					attr:            tftypes.NewValue(tftypes.String, tc.filter),
+				})
+				gotErr := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "Invalid Filter")
+				if gotErr != tc.wantErr {
+					t.Errorf("expected error=%t, got diagnostics %v", tc.wantErr, diags)
+				}
+			})
+		}
+	}
+}
+
+// TestRunRuleResource_evaluatorAndWebhookBlocks checks typed evaluator and
+// webhook blocks are sent as the API's JSON, and that the API's answer breaks
+// back down into the same blocks.
+func TestRunRuleResource_evaluatorAndWebhookBlocks(t *testing.T) {
+	evaluators := []runRuleEvaluatorModel{
+		{
+			EvaluatorType: types.StringValue("structured"),
+			Model:         types.StringValue(`{"model":"gpt-4o","temperature":0}`),
+			Prompt:        types.StringValue(`[["system","Grade the answer."]]`),
+			VariableMapping: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"input": types.StringValue("input.question"),
+			}),
+		},
+		{
+			EvaluatorType:   types.StringValue("hub"),
+			Model:           types.StringNull(),
+			Prompt:          types.StringValue("dodge/correctness"),
+			VariableMapping: types.MapNull(types.StringType),
+		},
+	}
+
+	raw, err := buildRunRuleEvaluators(evaluators)
+	if err != nil {
+		t.Fatalf("building evaluators: %s", err)
+	}
+	if !jsonSemanticallyEqual(string(raw), `[
+		{"structured": {"model": {"model": "gpt-4o", "temperature": 0}, "prompt": [["system", "Grade the answer."]], "variable_mapping": {"input": "input.question"}}},
+		{"hub": {"prompt": "dodge/correctness"}}
+	]`) {
+		t.Errorf("unexpected evaluators JSON: %s", raw)
+	}
+
+	back, err := decomposeRunRuleEvaluators(raw)
+	if err != nil {
+		t.Fatalf("decomposing evaluators: %s", err)
+	}
+	if len(back) != len(evaluators) {
+		t.Fatalf("expected %d evaluator blocks, got %+v", len(evaluators), back)
+	}
+	for i := range evaluators {
+		if !back[i].EvaluatorType.Equal(evaluators[i].EvaluatorType) || !back[i].Model.Equal(evaluators[i].Model) ||
+			!back[i].Prompt.Equal(evaluators[i].Prompt) || !back[i].VariableMapping.Equal(evaluators[i].VariableMapping) {
+			t.Errorf("evaluator %d: expected %+v, got %+v", i, evaluators[i], back[i])
+		}
+	}
+
+	webhooks := []runRuleWebhookModel{
+		{
+			URL: types.StringValue("https://hooks.example.com/runs"),
+			Headers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Authorization": types.StringValue("Bearer marshal"),
+			}),
+		},
+		{
+			URL:     types.StringValue("https://longbranch.example/runs"),
+			Headers: types.MapNull(types.StringType),
+		},
+	}
+
+	raw, err = buildRunRuleWebhooks(webhooks)
+	if err != nil {
+		t.Fatalf("building webhooks: %s", err)
+	}
+	if !jsonSemanticallyEqual(string(raw), `[
+		{"url": "https://hooks.example.com/runs", "headers": {"Authorization": "Bearer marshal"}},
+		{"url": "https://longbranch.example/runs"}
+	]`) {
+		t.Errorf("unexpected webhooks JSON: %s", raw)
+	}
+
+	hooks, err := decomposeRunRuleWebhooks(raw)
+	if err != nil {
+		t.Fatalf("decomposing webhooks: %s", err)
+	}
+	if len(hooks) != len(webhooks) {
+		t.Fatalf("expected %d webhook blocks, got %+v", len(webhooks), hooks)
+	}
+	for i := range webhooks {
+		if !hooks[i].URL.Equal(webhooks[i].URL) || !hooks[i].Headers.Equal(webhooks[i].Headers) {
+			t.Errorf("webhook %d: expected %+v, got %+v", i, webhooks[i], hooks[i])
+		}
+	}
+}
+
+// TestRunRuleResource_blocksMapping checks which rules get their typed blocks
+// refreshed: imported ones, but not a rule still on the deprecated JSON.
+func TestRunRuleResource_blocksMapping(t *testing.T) {
+	r := &RunRuleResource{}
+	result := &runRuleAPIResponse{
+		ID:         "r1",
+		Evaluators: json.RawMessage(`[{"structured": {"prompt": "Is it correct?"}}]`),
+		Webhooks:   json.RawMessage(`[{"url": "https://hooks.example.com/runs"}]`),
+	}
+
+	var imported RunRuleResourceModel
+	r.mapResponseToModel(result, &imported)
+	if len(imported.Evaluator) != 1 || imported.Evaluator[0].EvaluatorType.ValueString() != "structured" {
+		t.Errorf("expected an imported rule to get its evaluator blocks, got %+v", imported.Evaluator)
+	}
+	if len(imported.Webhook) != 1 || imported.Webhook[0].URL.ValueString() != "https://hooks.example.com/runs" {
+		t.Errorf("expected an imported rule to get its webhook blocks, got %+v", imported.Webhook)
+	}
+
+	deprecated := RunRuleResourceModel{
+		Evaluators: types.StringValue(`[]`),
+		Webhooks:   types.StringValue(`[]`),
+	}
+	r.mapResponseToModel(result, &deprecated)
+	if len(deprecated.Evaluator) != 0 || len(deprecated.Webhook) != 0 {
+		t.Errorf("expected a rule on the deprecated JSON to keep its blocks empty, got %+v and %+v", deprecated.Evaluator, deprecated.Webhook)
+	}
+	if deprecated.Evaluators.ValueString() != string(result.Evaluators) {
+		t.Errorf("expected evaluators to follow the API, got %s", deprecated.Evaluators)
+	}
+}
+
+// TestRunRuleResource_blocksConflict checks typed blocks and the deprecated
+// JSON attributes can't be used together.
+func TestRunRuleResource_blocksConflict(t *testing.T) {
+	webhookType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"url":     tftypes.String,
+		"headers": tftypes.Map{ElementType: tftypes.String},
+	}}
+	webhook := tftypes.NewValue(webhookType, map[string]tftypes.Value{
+		"url":     tftypes.NewValue(tftypes.String, "https://hooks.example.com/runs"),
+		"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	})
+
+	attrs := func() map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"display_name":  tftypes.NewValue(tftypes.String, "hooked"),
+			"sampling_rate": tftypes.NewValue(tftypes.Number, 1),
+			"webhook":       tftypes.NewValue(tftypes.List{ElementType: webhookType}, []tftypes.Value{webhook}),
+		}
+	}
+
+	if diags := testValidateResourceConfig(t, "langsmith_run_rule", attrs()); len(diags) != 0 {
+		t.Errorf("expected webhook blocks alone to be fine, got %v", diags)
+	}
+
+	both := attrs()
+	both["webhooks"] = tftypes.NewValue(tftypes.String, "[]")
+	diags := testValidateResourceConfig(t, "langsmith_run_rule", both)
+	if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "Conflicting Run Rule Webhooks") {
+		t.Errorf("expected a conflict error, got %v", diags)
+	}
+
+	deprecated := attrs()
+	delete(deprecated, "webhook")
+	deprecated["evaluators"] = tftypes.NewValue(tftypes.String, "[]")
+	diags = testValidateResourceConfig(t, "langsmith_run_rule", deprecated)
+	if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") || !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityWarning, "evaluator blocks") {
+		t.Errorf("expected only a deprecation warning, got %v", diags)
+	}
+}
+
+// TestRunRuleResource_backfillValidation checks a backfill can't be triggered
+// without a starting point, waited on without a trigger, or given a timeout
+// we can't read.
+func TestRunRuleResource_backfillValidation(t *testing.T) {
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr string
+	}{
+		"trigger with start": {
+			attrs: map[string]tftypes.Value{
+				"backfill_from":     tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+				"trigger_backfill":  tftypes.NewValue(tftypes.Bool, true),
+				"wait_for_backfill": tftypes.NewValue(tftypes.Bool, true),
+				"backfill_timeout":  tftypes.NewValue(tftypes.String, "1h"),
+			},
+		},
+		"start without trigger": {
+			attrs: map[string]tftypes.Value{
+				"backfill_from": tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+			},
+		},
+		"trigger without start": {
+			attrs: map[string]tftypes.Value{
+				"trigger_backfill": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantErr: "backfill_from",
+		},
+		"wait without trigger": {
+			attrs: map[string]tftypes.Value{
+				"backfill_from":     tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+				"wait_for_backfill": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantErr: "trigger_backfill",
+		},
+		"bad timeout": {
+			attrs: map[string]tftypes.Value{
+				"backfill_from":     tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+				"trigger_backfill":  tftypes.NewValue(tftypes.Bool, true),
+				"wait_for_backfill": tftypes.NewValue(tftypes.Bool, true),
+				"backfill_timeout":  tftypes.NewValue(tftypes.String, "a while"),
+			},
+			wantErr: "positive duration",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attrs := map[string]tftypes.Value{
+				"display_name":  tftypes.NewValue(tftypes.String, "sampler"),
+				"sampling_rate": tftypes.NewValue(tftypes.Number, 0.5),
+			}
+			for k, v := range tc.attrs {
+				attrs[k] = v
+			}
+			diags := testValidateResourceConfig(t, "langsmith_run_rule", attrs)
+			if tc.wantErr == "" {
+				if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") {
+					t.Errorf("expected no errors, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantErr) {
+				t.Errorf("expected an error mentioning %q, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// TestRunRuleResource_backfill checks a triggered backfill is watched until
+// it finishes, that a failure comes back as an error carrying the API's
+// reason, and that without wait_for_backfill apply moves on after the
+// trigger.
+func TestRunRuleResource_backfill(t *testing.T) {
+	defer func(interval time.Duration) { runRuleBackfillPollInterval = interval }(runRuleBackfillPollInterval)
+	runRuleBackfillPollInterval = time.Millisecond
+
+	cases := map[string]struct {
+		wait       bool
+		statuses   []string
+		wantStatus string
+		wantErr    string
+		wantGets   int
+	}{
+		"completes": {
+			wait:       true,
+			statuses:   []string{"pending", "running", "running", "completed"},
+			wantStatus: "completed",
+			wantGets:   3,
+		},
+		"fails": {
+			wait:       true,
+			statuses:   []string{"running", "failed"},
+			wantStatus: "failed",
+			wantErr:    "Run Rule Backfill Failed",
+			wantGets:   1,
+		},
+		"no wait": {
+			statuses:   []string{"pending"},
+			wantStatus: "pending",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var posted runRuleBackfillRequest
+			calls, gets := 0, 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/runs/rules/r1/backfill" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				switch r.Method {
+				case http.MethodPost:
+					_ = json.NewDecoder(r.Body).Decode(&posted)
+				case http.MethodGet:
+					gets++
+				}
+				status := tc.statuses[min(calls, len(tc.statuses)-1)]
+				calls++
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": status, "error": "evaluator model not found"})
+			}))
+			defer srv.Close()
+
+			r := &RunRuleResource{client: client.NewClient(srv.URL, "key", "")}
+			data := RunRuleResourceModel{
+				ID:              types.StringValue("r1"),
+				BackfillFrom:    types.StringValue("2025-01-01T00:00:00Z"),
+				TriggerBackfill: types.BoolValue(true),
+				WaitForBackfill: types.BoolValue(tc.wait),
+				BackfillTimeout: types.StringValue("1m"),
+				BackfillStatus:  types.StringNull(),
+			}
+			var diags diag.Diagnostics
+			r.backfill(context.Background(), &data, &diags)
+
+			if posted.BackfillFrom != "2025-01-01T00:00:00Z" {
+				t.Errorf("unexpected backfill request %+v", posted)
+			}
+			if data.BackfillStatus.ValueString() != tc.wantStatus {
+				t.Errorf("expected status %q, got %s", tc.wantStatus, data.BackfillStatus)
+			}
+			if gets != tc.wantGets {
+				t.Errorf("expected %d status checks, got %d", tc.wantGets, gets)
+			}
+			if tc.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags[0].Summary() != tc.wantErr {
+				t.Fatalf("expected %q, got %v", tc.wantErr, diags)
+			}
+			if detail := diags[0].Detail(); detail != "The backfill of run rule r1 failed. evaluator model not found" {
+				t.Errorf("unexpected detail %q", detail)
+			}
+		})
+	}
+}
+
+// TestRunRuleResource_findBySession checks a rule is looked for among its
+// project's rules across every page, falls back to the whole workspace, and
+// only counts as gone when it's nowhere to be found.
+func TestRunRuleResource_findBySession(t *testing.T) {
+	const session = "5d4c3b2a-1f0e-4d9c-8b7a-6f5e4d3c2b1a"
+	var sessionQueries, fullQueries int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		var rules []runRuleAPIResponse
+		if q.Get("session") == session {
+			sessionQueries++
+			// A full first page, then the rule on the second.
+			if offset == 0 {
+				for i := 0; i < client.DefaultPageSize; i++ {
+					rules = append(rules, runRuleAPIResponse{ID: fmt.Sprintf("other-%d", i)})
+				}
+			} else if offset == client.DefaultPageSize {
+				rules = append(rules, runRuleAPIResponse{ID: "deep"})
+			}
+		} else {
+			fullQueries++
+			if offset == 0 {
+				rules = append(rules, runRuleAPIResponse{ID: "moved"})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(rules)
+	}))
+	defer srv.Close()
+
+	c := client.NewClient(srv.URL, "key", "")
+	ctx := context.Background()
+
+	found, err := findRunRule(ctx, c, "deep", session)
+	if err != nil || found == nil || found.ID != "deep" {
+		t.Fatalf("expected the rule on the project's second page, got %v (%v)", found, err)
+	}
+	if sessionQueries != 2 || fullQueries != 0 {
+		t.Errorf("expected two project pages and no full listing, got %d and %d", sessionQueries, fullQueries)
+	}
+
+	if found, err := findRunRule(ctx, c, "moved", session); err != nil || found == nil {
+		t.Errorf("expected a rule missing from the project's list to be found in the workspace, got %v (%v)", found, err)
+	}
+	if found, err := findRunRule(ctx, c, "gone", session); err != nil || found != nil {
+		t.Errorf("expected a rule missing everywhere to be gone, got %v (%v)", found, err)
+	}
+}
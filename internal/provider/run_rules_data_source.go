@@ -0,0 +1,157 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &RunRulesDataSource{}
+
+// NewRunRulesDataSource returns a new RunRulesDataSource, for reading every
+// law posted in one town.
+func NewRunRulesDataSource() datasource.DataSource {
+	return &RunRulesDataSource{}
+}
+
+// RunRulesDataSource lists the automation rules attached to a project, for
+// auditing what acts on its runs.
+type RunRulesDataSource struct {
+	client *client.Client
+}
+
+// RunRulesDataSourceModel holds the project, the enabled filter, and the
+// rules found.
+type RunRulesDataSourceModel struct {
+	SessionID   types.String        `tfsdk:"session_id"`
+	IsEnabled   types.Bool          `tfsdk:"is_enabled"`
+	Rules       []runRuleEntryModel `tfsdk:"rules"`
+	WorkspaceID types.String        `tfsdk:"workspace_id"`
+}
+
+// runRuleEntryModel is one rule on the project.
+type runRuleEntryModel struct {
+	ID             types.String  `tfsdk:"id"`
+	DisplayName    types.String  `tfsdk:"display_name"`
+	SamplingRate   types.Float64 `tfsdk:"sampling_rate"`
+	IsEnabled      types.Bool    `tfsdk:"is_enabled"`
+	AddToDatasetID types.String  `tfsdk:"add_to_dataset_id"`
+}
+
+func (d *RunRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_rules"
+}
+
+func (d *RunRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the automation rules attached to a LangSmith project. To look up a single rule, see `langsmith_run_rule`.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"session_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project whose rules are listed.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only return rules that are enabled, when `true`, or disabled, when `false`. Every rule is returned when unset.",
+				Optional:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The rules found, in the order the API lists them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The rule's ID.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The rule's display name.",
+							Computed:            true,
+						},
+						"sampling_rate": schema.Float64Attribute{
+							MarkdownDescription: "The fraction of matching runs the rule acts on.",
+							Computed:            true,
+						},
+						"is_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the rule is enabled.",
+							Computed:            true,
+						},
+						"add_to_dataset_id": schema.StringAttribute{
+							MarkdownDescription: "The dataset matching runs are added to, if any.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RunRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *RunRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RunRulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+	sessionID := data.SessionID.ValueString()
+
+	data.Rules = []runRuleEntryModel{}
+	err := pageRunRules(ctx, d.client, url.Values{"session": {sessionID}}, func(batch []runRuleAPIResponse) bool {
+		for _, rule := range batch {
+			// The API's project filter is only trusted so far.
+			if rule.SessionID != sessionID {
+				continue
+			}
+			if !data.IsEnabled.IsNull() && rule.IsEnabled != data.IsEnabled.ValueBool() {
+				continue
+			}
+			data.Rules = append(data.Rules, runRuleEntryModel{
+				ID:             types.StringValue(rule.ID),
+				DisplayName:    types.StringValue(rule.DisplayName),
+				SamplingRate:   types.Float64Value(rule.SamplingRate),
+				IsEnabled:      types.BoolValue(rule.IsEnabled),
+				AddToDatasetID: optionalString(rule.AddToDatasetID),
+			})
+		}
+		return false
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading run rules", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read run rules data source", map[string]interface{}{"session_id": sessionID, "rules": len(data.Rules)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestRunRulesDataSource_read checks every page of the project's rules is
+// read, a rule from another project slipping through the filter is left out,
+// and is_enabled narrows the list.
+func TestRunRulesDataSource_read(t *testing.T) {
+	const session = "3f6c1e2a-1a3c-4e5b-8d7f-0a1b2c3d4e5f"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/runs/rules" || r.URL.Query().Get("session") != session {
+			t.Errorf("unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		rules := []runRuleAPIResponse{}
+		for i := offset; i < client.DefaultPageSize+2 && i < offset+client.DefaultPageSize; i++ {
+			rule := runRuleAPIResponse{ID: fmt.Sprintf("r%d", i), DisplayName: fmt.Sprintf("rule %d", i), SessionID: session, SamplingRate: 1, IsEnabled: i%2 == 0}
+			switch i {
+			case 0:
+				rule.AddToDatasetID = "d1"
+			case client.DefaultPageSize + 1:
+				rule.SessionID = "someone-else"
+			}
+			rules = append(rules, rule)
+		}
+		_ = json.NewEncoder(w).Encode(rules)
+	}))
+	defer srv.Close()
+
+	d := &RunRulesDataSource{client: client.NewClient(srv.URL, "key", "")}
+
+	resp := testDataSourceRead(t, d, &RunRulesDataSourceModel{SessionID: types.StringValue(session), IsEnabled: types.BoolNull(), WorkspaceID: types.StringNull()})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", resp.Diagnostics)
+	}
+	var got RunRulesDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if len(got.Rules) != client.DefaultPageSize+1 {
+		t.Fatalf("expected the project's rules across both pages, got %d", len(got.Rules))
+	}
+	if got.Rules[0].AddToDatasetID.ValueString() != "d1" || !got.Rules[1].AddToDatasetID.IsNull() {
+		t.Errorf("unexpected datasets %s, %s", got.Rules[0].AddToDatasetID, got.Rules[1].AddToDatasetID)
+	}
+
+	resp = testDataSourceRead(t, d, &RunRulesDataSourceModel{SessionID: types.StringValue(session), IsEnabled: types.BoolValue(false), WorkspaceID: types.StringNull()})
+	resp.State.Get(context.Background(), &got)
+	if len(got.Rules) != client.DefaultPageSize/2 {
+		t.Errorf("expected only the disabled rules, got %d", len(got.Rules))
+	}
+	for _, rule := range got.Rules {
+		if rule.IsEnabled.ValueBool() {
+			t.Errorf("expected only disabled rules, got %s", rule.ID)
+		}
+	}
+}
@@ -38,9 +38,11 @@ type SecretResource struct {
 
 // SecretResourceModel describes the Terraform state for a workspace secret.
 type SecretResourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Key   types.String `tfsdk:"key"`
-	Value types.String `tfsdk:"value"`
+	ID          types.String `tfsdk:"id"`
+	Key         types.String `tfsdk:"key"`
+	Value       types.String `tfsdk:"value"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
 }
 
 // secretUpsertItem is a single entry in the upsert array. The API expects
@@ -61,7 +63,8 @@ type secretDeleteItem struct {
 // secretKeyResponse is what the API reveals when you ask about secrets --
 // just the key name and nothing more. The value stays under lock and key.
 type secretKeyResponse struct {
-	Key string `json:"key"`
+	Key       string `json:"key"`
+	CreatedAt string `json:"created_at"`
 }
 
 func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -70,8 +73,9 @@ func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a LangSmith workspace secret (key/value pair). The value is write-only and never returned by the API.",
+		MarkdownDescription: "Manages a LangSmith workspace secret (key/value pair), such as the `OPENAI_API_KEY` or `ANTHROPIC_API_KEY` the playground and LLM-as-judge evaluators use. The value is write-only and never returned by the API.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the secret (same as the key name).",
 				Computed:            true,
@@ -91,6 +95,13 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 				Sensitive:           true,
 			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the secret was created, when the API reports one.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -100,16 +111,16 @@ func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,6 +130,8 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := []secretUpsertItem{{
 		Key:   data.Key.ValueString(),
 		Value: data.Value.ValueString(),
@@ -132,7 +145,15 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Ride back to the list for the creation time; the upsert won't say.
+	found, err := r.findSecret(ctx, data.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading secret after create", err.Error())
+		return
+	}
+
 	data.ID = types.StringValue(data.Key.ValueString())
+	data.CreatedAt = secretCreatedAt(found)
 	tflog.Trace(ctx, "created secret resource", map[string]interface{}{"key": data.Key.ValueString()})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -145,11 +166,9 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// The API only hands back a list of key names -- no individual
-	// lookups, and definitely no values. You have to round up the
-	// whole herd and find your steer by brand.
-	var results []secretKeyResponse
-	err := r.client.Get(ctx, "/api/v1/workspaces/current/secrets", nil, &results)
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	found, err := r.findSecret(ctx, data.Key.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -159,15 +178,7 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	var found bool
-	for _, s := range results {
-		if s.Key == data.Key.ValueString() {
-			found = true
-			break
-		}
-	}
-
-	if !found {
+	if found == nil {
 		// The secret has skipped town -- remove it from state.
 		resp.State.RemoveResource(ctx)
 		return
@@ -177,6 +188,7 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// the state already holds. Like a good bartender at the Long Branch
 	// Saloon, we keep what we know to ourselves.
 	data.ID = types.StringValue(data.Key.ValueString())
+	data.CreatedAt = secretCreatedAt(found)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -188,6 +200,8 @@ func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := []secretUpsertItem{{
 		Key:   data.Key.ValueString(),
 		Value: data.Value.ValueString(),
@@ -214,6 +228,8 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	// To delete a secret, we POST with value=null. It's the frontier
 	// way of saying "this one's been buried at Boot Hill."
 	body := []secretDeleteItem{{
@@ -231,8 +247,38 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	// Import passes the ID through, which maps to the key name.
 	// Fair warning: the secret value won't be available after import --
 	// like asking Chester to recall last month's dispatch word-for-word.
+	// The next apply writes the configured value over whatever is there.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), req.ID)...)
+}
+
+// findSecret looks a secret up by key. The API only hands back a list of
+// key names -- no individual lookups, and definitely no values. You have to
+// round up the whole herd and find your steer by brand. A secret that isn't
+// there comes back nil.
+func (r *SecretResource) findSecret(ctx context.Context, key string) (*secretKeyResponse, error) {
+	var results []secretKeyResponse
+	if err := r.client.Get(ctx, "/api/v1/workspaces/current/secrets", nil, &results); err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		if results[i].Key == key {
+			return &results[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// secretCreatedAt maps the creation time of a found secret, which older
+// API versions leave off.
+func secretCreatedAt(found *secretKeyResponse) types.String {
+	if found == nil || found.CreatedAt == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(found.CreatedAt)
 }
@@ -4,9 +4,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // TestAccSecretResource_basic rides into town with a secret and makes sure
@@ -30,3 +40,73 @@ func TestAccSecretResource_basic(t *testing.T) {
 		},
 	})
 }
+
+// TestSecretResource_createAndImport checks a new secret picks up its
+// creation time, and an imported one is found by its key without the value
+// ever being read.
+func TestSecretResource_createAndImport(t *testing.T) {
+	var upserted []secretUpsertItem
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&upserted)
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`[
+				{"key": "OPENAI_API_KEY", "created_at": "2025-06-01T00:00:00Z"},
+				{"key": "ANTHROPIC_API_KEY"}
+			]`))
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &SecretResource{client: client.NewClient(ts.URL, "key", "")}
+
+	plan := testResourceState(t, r, &SecretResourceModel{
+		ID:        types.StringUnknown(),
+		Key:       types.StringValue("OPENAI_API_KEY"),
+		Value:     types.StringValue("sk-doc-adams"),
+		CreatedAt: types.StringUnknown(),
+	})
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{
+		Schema: plan.Schema,
+		Raw:    tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+	if len(upserted) != 1 || upserted[0].Value != "sk-doc-adams" {
+		t.Errorf("unexpected upsert %+v", upserted)
+	}
+
+	var created SecretResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.CreatedAt.ValueString() != "2025-06-01T00:00:00Z" || created.Value.ValueString() != "sk-doc-adams" {
+		t.Errorf("expected the creation time and the configured value, got %+v", created)
+	}
+
+	imported := testResourceState(t, r, &SecretResourceModel{
+		ID:        types.StringNull(),
+		Key:       types.StringNull(),
+		Value:     types.StringNull(),
+		CreatedAt: types.StringNull(),
+	})
+	importResp := &fwresource.ImportStateResponse{State: imported}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "ANTHROPIC_API_KEY"}, importResp)
+
+	readResp := &fwresource.ReadResponse{State: importResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	if readResp.State.Raw.IsNull() {
+		t.Fatal("expected the imported secret to be found by its key")
+	}
+
+	var got SecretResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.Key.ValueString() != "ANTHROPIC_API_KEY" || !got.Value.IsNull() || !got.CreatedAt.IsNull() {
+		t.Errorf("expected the key with no value or creation time, got %+v", got)
+	}
+}
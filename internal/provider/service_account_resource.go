@@ -114,6 +114,7 @@ func (r *ServiceAccountResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "JSON-encoded array of workspace assignments, e.g. `[{\"workspace_id\": \"uuid\", \"role_id\": \"uuid\"}]`.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
+					jsonNormalize(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -126,16 +127,16 @@ func (r *ServiceAccountResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -155,7 +156,7 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 	}
 
 	var result serviceAccountAPIResponse
-	err := r.client.Post(ctx, "/api/v1/service-accounts", body, &result)
+	err := r.client.Create(ctx, "/api/v1/service-accounts", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating service account", err.Error())
 		return
@@ -6,15 +6,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -22,8 +27,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &ServiceKeyResource{}
-	_ resource.ResourceWithImportState = &ServiceKeyResource{}
+	_ resource.Resource                   = &ServiceKeyResource{}
+	_ resource.ResourceWithImportState    = &ServiceKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &ServiceKeyResource{}
+	_ resource.ResourceWithValidateConfig = &ServiceKeyResource{}
 )
 
 // NewServiceKeyResource constructs a fresh ServiceKeyResource. Like a one-time
@@ -35,7 +42,8 @@ func NewServiceKeyResource() resource.Resource {
 // ServiceKeyResource manages a LangSmith service key (API key) — the
 // credential that gets you through the door at the Long Branch.
 type ServiceKeyResource struct {
-	client *client.Client
+	client        *client.Client
+	defaultRoleID string
 }
 
 // ServiceKeyResourceModel holds the Terraform state for a service key. The
@@ -49,8 +57,12 @@ type ServiceKeyResourceModel struct {
 	Key                types.String `tfsdk:"key"`
 	CreatedAt          types.String `tfsdk:"created_at"`
 	ExpiresAt          types.String `tfsdk:"expires_at"`
+	ExpiresAtComputed  types.String `tfsdk:"expires_at_computed"`
+	DaysUntilExpiry    types.Int64  `tfsdk:"days_until_expiry"`
 	DefaultWorkspaceID types.String `tfsdk:"default_workspace_id"`
 	RoleID             types.String `tfsdk:"role_id"`
+	Keepers            types.Map    `tfsdk:"keepers"`
+	RevokeGracePeriod  types.String `tfsdk:"revoke_grace_period"`
 }
 
 // serviceKeyAPICreateRequest is the wire format for minting a new service key.
@@ -67,22 +79,24 @@ type serviceKeyAPICreateRequest struct {
 // serviceKeyAPICreateResponse is the one-time response that includes the full
 // API key — guard it like gold dust.
 type serviceKeyAPICreateResponse struct {
-	ID          string `json:"id"`
-	Description string `json:"description"`
-	ReadOnly    bool   `json:"read_only"`
-	ShortKey    string `json:"short_key"`
-	Key         string `json:"key"`
-	CreatedAt   string `json:"created_at"`
+	ID          string  `json:"id"`
+	Description string  `json:"description"`
+	ReadOnly    bool    `json:"read_only"`
+	ShortKey    string  `json:"short_key"`
+	Key         string  `json:"key"`
+	CreatedAt   string  `json:"created_at"`
+	ExpiresAt   *string `json:"expires_at"`
 }
 
 // serviceKeyAPIListItem is a single service key from the list response. The
 // full key is long gone — only the short key remains as a calling card.
 type serviceKeyAPIListItem struct {
-	ID          string `json:"id"`
-	Description string `json:"description"`
-	ReadOnly    bool   `json:"read_only"`
-	ShortKey    string `json:"short_key"`
-	CreatedAt   string `json:"created_at"`
+	ID          string  `json:"id"`
+	Description string  `json:"description"`
+	ReadOnly    bool    `json:"read_only"`
+	ShortKey    string  `json:"short_key"`
+	CreatedAt   string  `json:"created_at"`
+	ExpiresAt   *string `json:"expires_at"`
 }
 
 // serviceKeyAPIListResponse is the full roster of service keys, minus their
@@ -95,7 +109,9 @@ func (r *ServiceKeyResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *ServiceKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a LangSmith service key (API key). Service keys cannot be updated; changing any mutable attribute will force recreation. The full API key is only available at creation time.",
+		MarkdownDescription: "Manages a LangSmith service key (API key). Service keys cannot be updated; changing any mutable attribute will force recreation. The full API key is only available at creation time.\n\n" +
+			"To rotate a key without an outage, set `lifecycle { create_before_destroy = true }` so the new key exists, and everything using it has been updated, before the old one is revoked. " +
+			"Change `keepers` to rotate on demand, and set `revoke_grace_period` to give running workloads time to pick up the new key before the old one stops working.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the service key.",
@@ -139,12 +155,27 @@ func (r *ServiceKeyResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 			},
 			"expires_at": schema.StringAttribute{
-				MarkdownDescription: "ISO 8601 timestamp when the service key expires.",
+				MarkdownDescription: "RFC3339 timestamp when the service key expires, e.g. `2026-01-01T00:00:00Z`. Must be in the future when the key is created.",
 				Optional:            true,
+				Validators:          []validator.String{validTimestamp()},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"expires_at_computed": schema.StringAttribute{
+				MarkdownDescription: "When the service key expires, as reported by the API. Null for a key that never expires, so a mismatch with `expires_at` shows the expiry didn't take.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"days_until_expiry": schema.Int64Attribute{
+				MarkdownDescription: "Whole days left until the key expires, as of the last refresh, rounded down; negative once it has expired. Null for a key that never expires. Handy in a `check` block to flag keys due for rotation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"default_workspace_id": schema.StringAttribute{
 				MarkdownDescription: "The default workspace ID for the service key.",
 				Optional:            true,
@@ -153,12 +184,26 @@ func (r *ServiceKeyResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"role_id": schema.StringAttribute{
-				MarkdownDescription: "The role ID to assign to the service key.",
+				MarkdownDescription: "The role ID to assign to the service key. Falls back to the provider's `default_role_id` when unset. Changing the provider default later doesn't replace existing keys.",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"keepers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that, when changed, replace the key with a new one. Point this at a rotation schedule, such as a `time_rotating` resource's `id`, to rotate keys on a timetable.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"revoke_grace_period": schema.StringAttribute{
+				MarkdownDescription: "How long to wait before revoking the key when it's destroyed, as a duration such as `5m`. Used with `create_before_destroy`, the old key keeps working this long after its replacement is in place. The wait holds the apply, and the state lock, for its whole length, so it's capped at 10 minutes. The wait uses the value in state, so a change takes effect for the key's eventual replacement after the next apply.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -168,16 +213,17 @@ func (r *ServiceKeyResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
+	r.defaultRoleID = data.defaultRoleID
 }
 
 func (r *ServiceKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -207,7 +253,7 @@ func (r *ServiceKeyResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	var result serviceKeyAPICreateResponse
-	err := r.client.Post(ctx, "/api/v1/orgs/current/service-keys", body, &result)
+	err := r.client.Create(ctx, "/api/v1/orgs/current/service-keys", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating service key", err.Error())
 		return
@@ -219,6 +265,21 @@ func (r *ServiceKeyResource) Create(ctx context.Context, req resource.CreateRequ
 	data.ShortKey = types.StringValue(result.ShortKey)
 	data.Key = types.StringValue(result.Key)
 	data.CreatedAt = types.StringValue(result.CreatedAt)
+	data.ExpiresAtComputed = serviceKeyExpiresAt(result.ExpiresAt)
+	data.DaysUntilExpiry = serviceKeyDaysUntilExpiry(data.ExpiresAtComputed, time.Now())
+
+	// The API has been known to shrug off an expiry it can't use; say so
+	// rather than leave a key that never expires looking like one that does.
+	if !data.ExpiresAt.IsNull() && data.ExpiresAtComputed.IsNull() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Service Key Expiry Not Applied",
+			fmt.Sprintf("expires_at was set to %s, but the API reports the key %s never expires.", data.ExpiresAt.ValueString(), result.ID),
+		)
+	}
+	if data.RoleID.IsUnknown() {
+		data.RoleID = types.StringNull()
+	}
 
 	tflog.Trace(ctx, "created service key resource", map[string]interface{}{"id": result.ID})
 
@@ -232,8 +293,7 @@ func (r *ServiceKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	var listResult serviceKeyAPIListResponse
-	err := r.client.Get(ctx, "/api/v1/orgs/current/service-keys", nil, &listResult)
+	listResult, err := listServiceKeys(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading service keys", err.Error())
 		return
@@ -257,17 +317,74 @@ func (r *ServiceKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.ReadOnly = types.BoolValue(found.ReadOnly)
 	data.ShortKey = types.StringValue(found.ShortKey)
 	data.CreatedAt = types.StringValue(found.CreatedAt)
+	data.ExpiresAtComputed = serviceKeyExpiresAt(found.ExpiresAt)
+	data.DaysUntilExpiry = serviceKeyDaysUntilExpiry(data.ExpiresAtComputed, time.Now())
 	// The full key is never returned on read — that was a one-time reveal.
 	// UseStateForUnknown keeps the original safe in state.
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ModifyPlan fills in the provider's default_role_id for a new service key
+// that doesn't name a role of its own. A role set on the resource always wins.
+func (r *ServiceKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// A key about to be minted, fresh or as a replacement, mustn't be born
+	// expired. Keys already out in the world are left alone once their
+	// expiry passes.
+	if req.State.Raw.IsNull() || len(resp.RequiresReplace) > 0 {
+		var expiresAt types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+		if !expiresAt.IsNull() && !expiresAt.IsUnknown() {
+			if t, err := time.Parse(time.RFC3339Nano, expiresAt.ValueString()); err == nil && !t.After(time.Now()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("expires_at"),
+					"Expiry In The Past",
+					fmt.Sprintf("expires_at is %s, which has already passed; a new key would be expired before it's ever used.", expiresAt.ValueString()),
+				)
+			}
+		}
+	}
+
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	var roleID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role_id"), &roleID)...)
+	if resp.Diagnostics.HasError() || !roleID.IsNull() || r.client == nil {
+		return
+	}
+
+	planned := types.StringNull()
+	if r.defaultRoleID != "" {
+		planned = types.StringValue(r.defaultRoleID)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_id"), planned)...)
+}
+
+// Update only ever sees a change to revoke_grace_period, which lives in state
+// alone; everything the API knows about forces a new key.
 func (r *ServiceKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Service keys cannot be updated. This is unexpected — all mutable attributes should have RequiresReplace set.",
-	)
+	var data, state ServiceKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Description.Equal(state.Description) || !data.ReadOnly.Equal(state.ReadOnly) || !data.Keepers.Equal(state.Keepers) {
+		resp.Diagnostics.AddError(
+			"Update Not Supported",
+			"Service keys cannot be updated. This is unexpected — all mutable attributes should have RequiresReplace set.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ServiceKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -277,6 +394,20 @@ func (r *ServiceKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Hold the door a while, so workloads can move over to the new key.
+	if !data.RevokeGracePeriod.IsNull() {
+		if grace, err := time.ParseDuration(data.RevokeGracePeriod.ValueString()); err == nil && grace > 0 {
+			grace = min(grace, serviceKeyMaxRevokeGracePeriod)
+			tflog.Info(ctx, "waiting out the grace period before revoking the service key", map[string]interface{}{"id": data.ID.ValueString(), "grace_period": grace.String()})
+			select {
+			case <-ctx.Done():
+				resp.Diagnostics.AddError("Error deleting service key", fmt.Sprintf("interrupted during the revoke grace period: %s", ctx.Err()))
+				return
+			case <-time.After(grace):
+			}
+		}
+	}
+
 	err := r.client.Delete(ctx, "/api/v1/orgs/current/service-keys/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting service key", err.Error())
@@ -286,6 +417,63 @@ func (r *ServiceKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 	tflog.Trace(ctx, "deleted service key resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+// serviceKeyMaxRevokeGracePeriod caps revoke_grace_period. Service keys can't
+// have their expiry changed once they're issued, so the grace period is a
+// wait inside Delete, and that wait holds the apply and the state lock.
+const serviceKeyMaxRevokeGracePeriod = 10 * time.Minute
+
+// ValidateConfig makes sure the grace period is a duration we can read, and
+// no longer than we're willing to stand around for.
+func (r *ServiceKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var grace types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("revoke_grace_period"), &grace)...)
+	if resp.Diagnostics.HasError() || grace.IsNull() || grace.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(grace.ValueString()); err != nil || d < 0 || d > serviceKeyMaxRevokeGracePeriod {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("revoke_grace_period"),
+			"Invalid Grace Period",
+			fmt.Sprintf("revoke_grace_period must be a duration no longer than \"10m\", such as \"5m\", got %q.", grace.ValueString()),
+		)
+	}
+}
+
 func (r *ServiceKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// listServiceKeys rounds up every service key in the organization, page by
+// page. None of them carry their full key.
+func listServiceKeys(ctx context.Context, c *client.Client) (serviceKeyAPIListResponse, error) {
+	return client.GetAll[serviceKeyAPIListItem](ctx, c, "/api/v1/orgs/current/service-keys", nil)
+}
+
+// serviceKeyExpiresAt maps the API's expiry, which is null for keys that
+// never expire.
+func serviceKeyExpiresAt(expiresAt *string) types.String {
+	if expiresAt == nil || *expiresAt == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*expiresAt)
+}
+
+// serviceKeyExpiryLayouts are the spellings of an expiry the API has been
+// seen to use, with and without a zone.
+var serviceKeyExpiryLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"}
+
+// serviceKeyDaysUntilExpiry counts the whole days from now until expiresAt,
+// rounded down, so a key that expired an hour ago reads -1. It's null when
+// the key never expires or the expiry can't be read.
+func serviceKeyDaysUntilExpiry(expiresAt types.String, now time.Time) types.Int64 {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return types.Int64Null()
+	}
+	for _, layout := range serviceKeyExpiryLayouts {
+		if t, err := time.Parse(layout, expiresAt.ValueString()); err == nil {
+			return types.Int64Value(int64(math.Floor(t.Sub(now).Hours() / 24)))
+		}
+	}
+	return types.Int64Null()
+}
@@ -0,0 +1,234 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestServiceKeyResource_defaultRoleID checks a new service key picks up the
+// provider's default role unless it names its own.
+func TestServiceKeyResource_defaultRoleID(t *testing.T) {
+	key := func(roleID types.String) *ServiceKeyResourceModel {
+		return &ServiceKeyResourceModel{
+			ID:                 types.StringUnknown(),
+			Description:        types.StringValue("ci"),
+			ReadOnly:           types.BoolValue(false),
+			ShortKey:           types.StringUnknown(),
+			Key:                types.StringUnknown(),
+			CreatedAt:          types.StringUnknown(),
+			ExpiresAt:          types.StringNull(),
+			ExpiresAtComputed:  types.StringUnknown(),
+			DefaultWorkspaceID: types.StringNull(),
+			RoleID:             roleID,
+			Keepers:            types.MapNull(types.StringType),
+			RevokeGracePeriod:  types.StringNull(),
+		}
+	}
+
+	cases := map[string]struct {
+		defaultRoleID string
+		roleID        types.String
+		want          types.String
+	}{
+		"resource role wins": {defaultRoleID: "viewer", roleID: types.StringValue("admin"), want: types.StringValue("admin")},
+		"falls back":         {defaultRoleID: "viewer", roleID: types.StringNull(), want: types.StringValue("viewer")},
+		"no default":         {roleID: types.StringNull(), want: types.StringNull()},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &ServiceKeyResource{}
+			r.Configure(context.Background(), resource.ConfigureRequest{
+				ProviderData: &providerData{client: client.NewClient("http://localhost", "key", ""), defaultRoleID: tc.defaultRoleID},
+			}, &resource.ConfigureResponse{})
+
+			resp := testModifyPlan(t, r, key(tc.roleID))
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var planned types.String
+			resp.Plan.GetAttribute(context.Background(), path.Root("role_id"), &planned)
+			if !planned.Equal(tc.want) {
+				t.Errorf("expected role_id %s, got %s", tc.want, planned)
+			}
+		})
+	}
+}
+
+// TestServiceKeyResource_revokeGracePeriod checks the grace period must be a
+// duration no longer than the cap, and that a destroyed key isn't revoked
+// until it has passed.
+func TestServiceKeyResource_revokeGracePeriod(t *testing.T) {
+	for grace, wantErr := range map[string]bool{"0s": false, "5m": false, "five minutes": true, "-1m": true, "10m": false, "1h": true} {
+		diags := testValidateResourceConfig(t, "langsmith_service_key", map[string]tftypes.Value{
+			"revoke_grace_period": tftypes.NewValue(tftypes.String, grace),
+		})
+		if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "Invalid Grace Period"); got != wantErr {
+			t.Errorf("%q: expected error %v, got %v", grace, wantErr, diags)
+		}
+	}
+
+	var revokedAt time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/orgs/current/service-keys/k1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		revokedAt = time.Now()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	r := &ServiceKeyResource{client: client.NewClient(ts.URL, "key", "")}
+	state := testResourceState(t, r, &ServiceKeyResourceModel{
+		ID:                 types.StringValue("k1"),
+		Description:        types.StringValue("ci"),
+		ReadOnly:           types.BoolValue(false),
+		ShortKey:           types.StringValue("lsv2_sk_...a1"),
+		Key:                types.StringValue("lsv2_sk_a1"),
+		CreatedAt:          types.StringValue("2025-01-01T00:00:00Z"),
+		ExpiresAt:          types.StringNull(),
+		ExpiresAtComputed:  types.StringNull(),
+		DefaultWorkspaceID: types.StringNull(),
+		RoleID:             types.StringNull(),
+		Keepers:            types.MapNull(types.StringType),
+		RevokeGracePeriod:  types.StringValue("50ms"),
+	})
+
+	start := time.Now()
+	resp := &resource.DeleteResponse{}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("deleting: %v", resp.Diagnostics)
+	}
+	if revokedAt.IsZero() || revokedAt.Sub(start) < 50*time.Millisecond {
+		t.Errorf("expected the key to be revoked after the grace period, got %s", revokedAt.Sub(start))
+	}
+
+	// Changing the grace period alone is an in-place update, not an error.
+	var planned ServiceKeyResourceModel
+	state.Get(context.Background(), &planned)
+	planned.RevokeGracePeriod = types.StringValue("10m")
+	plan := testResourceState(t, r, &planned)
+	updateResp := &resource.UpdateResponse{State: state}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: state}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("updating the grace period: %v", updateResp.Diagnostics)
+	}
+	var got types.String
+	updateResp.State.GetAttribute(context.Background(), path.Root("revoke_grace_period"), &got)
+	if got.ValueString() != "10m" {
+		t.Errorf("expected the new grace period in state, got %s", got)
+	}
+}
+
+// TestServiceKeyResource_expiresAt checks expires_at must be an RFC3339
+// timestamp in the future, and that the expiry the API reports lands in state.
+func TestServiceKeyResource_expiresAt(t *testing.T) {
+	for expiresAt, wantErr := range map[string]bool{"2030-01-01T00:00:00Z": false, "2030-01-01": true, "next tuesday": true} {
+		diags := testValidateResourceConfig(t, "langsmith_service_key", map[string]tftypes.Value{
+			"expires_at": tftypes.NewValue(tftypes.String, expiresAt),
+		})
+		if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "Invalid Timestamp"); got != wantErr {
+			t.Errorf("%q: expected error %v, got %v", expiresAt, wantErr, diags)
+		}
+	}
+
+	key := func(expiresAt string) *ServiceKeyResourceModel {
+		return &ServiceKeyResourceModel{
+			ID:                 types.StringUnknown(),
+			Description:        types.StringValue("ci"),
+			ReadOnly:           types.BoolValue(false),
+			ShortKey:           types.StringUnknown(),
+			Key:                types.StringUnknown(),
+			CreatedAt:          types.StringUnknown(),
+			ExpiresAt:          types.StringValue(expiresAt),
+			ExpiresAtComputed:  types.StringUnknown(),
+			DefaultWorkspaceID: types.StringNull(),
+			RoleID:             types.StringNull(),
+			Keepers:            types.MapNull(types.StringType),
+			RevokeGracePeriod:  types.StringNull(),
+		}
+	}
+
+	r := &ServiceKeyResource{client: client.NewClient("http://localhost", "key", "")}
+	if resp := testModifyPlan(t, r, key("2020-01-01T00:00:00Z")); !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Expiry In The Past" {
+		t.Errorf("expected a past expiry to be rejected, got %v", resp.Diagnostics)
+	}
+	if resp := testModifyPlan(t, r, key("2030-01-01T00:00:00Z")); resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error for a future expiry: %v", resp.Diagnostics)
+	}
+
+	for name, tc := range map[string]struct {
+		reported string
+		want     types.String
+		warn     bool
+	}{
+		"applied": {reported: `"2030-01-01T00:00:00Z"`, want: types.StringValue("2030-01-01T00:00:00Z")},
+		"dropped": {reported: `null`, want: types.StringNull(), warn: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":"k1","description":"ci","read_only":false,"short_key":"lsv2_sk_...a1","key":"lsv2_sk_a1","created_at":"2025-01-01T00:00:00Z","expires_at":` + tc.reported + `}`))
+			}))
+			defer ts.Close()
+
+			r := &ServiceKeyResource{client: client.NewClient(ts.URL, "key", "")}
+			plan := testResourceState(t, r, key("2030-01-01T00:00:00Z"))
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+			r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("creating: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.warn {
+				t.Errorf("expected warning %v, got %v", tc.warn, resp.Diagnostics)
+			}
+
+			var got types.String
+			resp.State.GetAttribute(context.Background(), path.Root("expires_at_computed"), &got)
+			if !got.Equal(tc.want) {
+				t.Errorf("expected expires_at_computed %s, got %s", tc.want, got)
+			}
+			var days types.Int64
+			resp.State.GetAttribute(context.Background(), path.Root("days_until_expiry"), &days)
+			if days.IsNull() != tc.want.IsNull() || (!days.IsNull() && days.ValueInt64() <= 0) {
+				t.Errorf("expected days_until_expiry to follow the expiry, got %s", days)
+			}
+		})
+	}
+}
+
+// TestServiceKeyDaysUntilExpiry checks the days left round down, go negative
+// once the key has expired, and stay null for a key that never expires.
+func TestServiceKeyDaysUntilExpiry(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	for expiresAt, want := range map[types.String]types.Int64{
+		types.StringValue("2025-06-11T12:00:00Z"):        types.Int64Value(10),
+		types.StringValue("2025-06-11T11:00:00Z"):        types.Int64Value(9),
+		types.StringValue("2025-06-01T13:00:00+02:00"):   types.Int64Value(-1),
+		types.StringValue("2025-05-01T12:00:00.123456"):  types.Int64Value(-31),
+		types.StringValue("2025-06-02T12:00:00.000000Z"): types.Int64Value(1),
+		types.StringValue("soon"):                        types.Int64Null(),
+		types.StringNull():                               types.Int64Null(),
+	} {
+		if got := serviceKeyDaysUntilExpiry(expiresAt, now); !got.Equal(want) {
+			t.Errorf("%s: expected %s, got %s", expiresAt, want, got)
+		}
+	}
+}
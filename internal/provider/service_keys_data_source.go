@@ -0,0 +1,149 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &ServiceKeysDataSource{}
+
+// NewServiceKeysDataSource returns a new ServiceKeysDataSource, for taking
+// stock of every key that opens a door in the organization.
+func NewServiceKeysDataSource() datasource.DataSource {
+	return &ServiceKeysDataSource{}
+}
+
+// ServiceKeysDataSource lists the organization's service keys, so keys made
+// outside Terraform can be found by ID -- say, to retire an old one once its
+// replacement is in place.
+type ServiceKeysDataSource struct {
+	client *client.Client
+}
+
+// ServiceKeysDataSourceModel holds the description filter and the keys found.
+type ServiceKeysDataSourceModel struct {
+	Description types.String           `tfsdk:"description"`
+	ServiceKeys []serviceKeyEntryModel `tfsdk:"service_keys"`
+}
+
+// serviceKeyEntryModel is one service key, without its full key, which the
+// API only ever reveals at creation.
+type serviceKeyEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	ReadOnly    types.Bool   `tfsdk:"read_only"`
+	ShortKey    types.String `tfsdk:"short_key"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (d *ServiceKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_keys"
+}
+
+func (d *ServiceKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the organization's service keys. The full key is never returned; only the `short_key` shown in the LangSmith UI.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Only return the service key with exactly this description. It's an error for the description to match no key, or more than one.",
+				Optional:            true,
+			},
+			"service_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The service keys found, in the order the API lists them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the service key.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the service key.",
+							Computed:            true,
+						},
+						"read_only": schema.BoolAttribute{
+							MarkdownDescription: "Whether the service key is read-only.",
+							Computed:            true,
+						},
+						"short_key": schema.StringAttribute{
+							MarkdownDescription: "The short, displayable form of the key.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The timestamp when the service key was created.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServiceKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *ServiceKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := listServiceKeys(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading service keys", err.Error())
+		return
+	}
+
+	filtered := !data.Description.IsNull()
+	data.ServiceKeys = make([]serviceKeyEntryModel, 0, len(keys))
+	for _, sk := range keys {
+		if filtered && sk.Description != data.Description.ValueString() {
+			continue
+		}
+		data.ServiceKeys = append(data.ServiceKeys, serviceKeyEntryModel{
+			ID:          types.StringValue(sk.ID),
+			Description: types.StringValue(sk.Description),
+			ReadOnly:    types.BoolValue(sk.ReadOnly),
+			ShortKey:    types.StringValue(sk.ShortKey),
+			CreatedAt:   types.StringValue(sk.CreatedAt),
+		})
+	}
+
+	if filtered && len(data.ServiceKeys) != 1 {
+		resp.Diagnostics.AddError(
+			"Service Key Not Found",
+			fmt.Sprintf("Expected exactly one service key with description %q, found %d.", data.Description.ValueString(), len(data.ServiceKeys)),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "read service keys data source", map[string]interface{}{"count": len(data.ServiceKeys)})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
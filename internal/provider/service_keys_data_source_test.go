@@ -0,0 +1,61 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestServiceKeysDataSource_read checks every key is listed without a
+// filter, and a description picks out exactly one or says why it can't.
+func TestServiceKeysDataSource_read(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/orgs/current/service-keys" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": "k1", "description": "ci", "read_only": false, "short_key": "lsv2_sk_...a1", "created_at": "2025-01-01T00:00:00Z"},
+			{"id": "k2", "description": "dashboards", "read_only": true, "short_key": "lsv2_sk_...b2", "created_at": "2025-02-01T00:00:00Z"},
+			{"id": "k3", "description": "dashboards", "read_only": true, "short_key": "lsv2_sk_...c3", "created_at": "2025-03-01T00:00:00Z"}
+		]`))
+	}))
+	defer srv.Close()
+
+	d := &ServiceKeysDataSource{client: client.NewClient(srv.URL, "key", "")}
+	read := func(description types.String) (ServiceKeysDataSourceModel, bool) {
+		resp := testDataSourceRead(t, d, &ServiceKeysDataSourceModel{Description: description})
+		var got ServiceKeysDataSourceModel
+		resp.State.Get(context.Background(), &got)
+		return got, resp.Diagnostics.HasError()
+	}
+
+	all, failed := read(types.StringNull())
+	if failed || len(all.ServiceKeys) != 3 {
+		t.Fatalf("expected all three keys, got %+v", all.ServiceKeys)
+	}
+	if k := all.ServiceKeys[1]; k.ID.ValueString() != "k2" || !k.ReadOnly.ValueBool() || k.ShortKey.ValueString() != "lsv2_sk_...b2" {
+		t.Errorf("unexpected second key %+v", k)
+	}
+
+	ci, failed := read(types.StringValue("ci"))
+	if failed || len(ci.ServiceKeys) != 1 || ci.ServiceKeys[0].ID.ValueString() != "k1" {
+		t.Errorf("expected the ci key alone, got %+v", ci.ServiceKeys)
+	}
+
+	if _, failed := read(types.StringValue("nobody")); !failed {
+		t.Error("expected an error for a description matching no key")
+	}
+	if _, failed := read(types.StringValue("dashboards")); !failed {
+		t.Error("expected an error for a description matching two keys")
+	}
+}
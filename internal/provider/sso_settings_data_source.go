@@ -0,0 +1,144 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &SSOSettingsDataSource{}
+
+// NewSSOSettingsDataSource returns a new SSOSettingsDataSource, for looking
+// over the single sign-on pass without taking charge of it.
+func NewSSOSettingsDataSource() datasource.DataSource {
+	return &SSOSettingsDataSource{}
+}
+
+// SSOSettingsDataSource reads the organization's SSO configuration, so a
+// read-only module can audit it without managing a langsmith_sso_settings.
+type SSOSettingsDataSource struct {
+	client *client.Client
+}
+
+// SSOSettingsDataSourceModel holds the organization's SSO configuration. The
+// metadata XML is left out; it's a secret the data source has no call to
+// spread around.
+type SSOSettingsDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	OrganizationID         types.String `tfsdk:"organization_id"`
+	ProviderID             types.String `tfsdk:"provider_id"`
+	DefaultWorkspaceRoleID types.String `tfsdk:"default_workspace_role_id"`
+	DefaultWorkspaceIDs    types.List   `tfsdk:"default_workspace_ids"`
+	MetadataURL            types.String `tfsdk:"metadata_url"`
+}
+
+func (d *SSOSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_settings"
+}
+
+func (d *SSOSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to read the organization's SSO settings without managing them. The read fails if the organization has no SSO provider configured, or more than one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the SSO settings.",
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID that owns these SSO settings.",
+				Computed:            true,
+			},
+			"provider_id": schema.StringAttribute{
+				MarkdownDescription: "The SSO provider ID.",
+				Computed:            true,
+			},
+			"default_workspace_role_id": schema.StringAttribute{
+				MarkdownDescription: "Default role ID for SSO-provisioned users.",
+				Computed:            true,
+			},
+			"default_workspace_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the workspaces SSO-provisioned users are added to by default.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"metadata_url": schema.StringAttribute{
+				MarkdownDescription: "The SAML metadata URL.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SSOSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *SSOSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SSOSettingsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := listSSOSettings(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SSO settings", err.Error())
+		return
+	}
+
+	if len(settings) == 0 {
+		resp.Diagnostics.AddError(
+			"No SSO Settings Found",
+			"The organization has no SSO provider configured. Set one up with the langsmith_sso_settings resource, or drop this data source from modules that run against organizations without SSO.",
+		)
+		return
+	}
+	if len(settings) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple SSO Settings Found",
+			fmt.Sprintf("The organization has %d SSO providers configured, and this data source can only read one.", len(settings)),
+		)
+		return
+	}
+
+	// The resource's mapping already knows how to read the API's answer;
+	// borrow it and keep the parts worth showing.
+	mapped := SSOSettingsResourceModel{DefaultWorkspaceIDs: types.ListNull(types.StringType)}
+	mapSSOSettingsResponseToState(ctx, &mapped, &settings[0], &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = mapped.ID
+	data.OrganizationID = mapped.OrganizationID
+	data.ProviderID = mapped.ProviderID
+	data.DefaultWorkspaceRoleID = mapped.DefaultWorkspaceRoleID
+	data.DefaultWorkspaceIDs = mapped.DefaultWorkspaceIDs
+	data.MetadataURL = mapped.MetadataURL
+
+	tflog.Trace(ctx, "read SSO settings data source", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
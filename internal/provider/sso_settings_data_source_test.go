@@ -0,0 +1,67 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestSSOSettingsDataSource_read checks the one configured provider is read,
+// and that finding none or several fails with a clear message.
+func TestSSOSettingsDataSource_read(t *testing.T) {
+	cases := map[string]struct {
+		body        string
+		wantSummary string
+	}{
+		"configured": {
+			body: `[{"id": "s1", "organization_id": "o1", "provider_id": "p1", "default_workspace_role_id": "r1", "default_workspace_ids": ["0f4c2d8e-7a1b-4c3d-9e5f-6a7b8c9d0e1f"], "metadata_url": "https://idp.example.com/metadata", "metadata_xml": "<secret/>"}]`,
+		},
+		"none":    {body: `[]`, wantSummary: "No SSO Settings Found"},
+		"several": {body: `[{"id": "s1"}, {"id": "s2"}]`, wantSummary: "Multiple SSO Settings Found"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/orgs/current/sso-settings" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if r.URL.Query().Get("offset") != "0" {
+					_, _ = w.Write([]byte(`[]`))
+					return
+				}
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			d := &SSOSettingsDataSource{client: client.NewClient(srv.URL, "key", "")}
+			resp := testDataSourceRead(t, d, &SSOSettingsDataSourceModel{DefaultWorkspaceIDs: types.ListNull(types.StringType)})
+			if tc.wantSummary != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != tc.wantSummary {
+					t.Errorf("expected %q, got %v", tc.wantSummary, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading: %v", resp.Diagnostics)
+			}
+
+			var got SSOSettingsDataSourceModel
+			resp.State.Get(context.Background(), &got)
+			if got.ID.ValueString() != "s1" || got.ProviderID.ValueString() != "p1" || got.DefaultWorkspaceRoleID.ValueString() != "r1" ||
+				got.MetadataURL.ValueString() != "https://idp.example.com/metadata" || len(got.DefaultWorkspaceIDs.Elements()) != 1 {
+				t.Errorf("unexpected SSO settings %+v", got)
+			}
+		})
+	}
+}
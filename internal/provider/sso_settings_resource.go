@@ -7,12 +7,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -20,8 +24,9 @@ import (
 )
 
 var (
-	_ resource.Resource                = &SSOSettingsResource{}
-	_ resource.ResourceWithImportState = &SSOSettingsResource{}
+	_ resource.Resource                 = &SSOSettingsResource{}
+	_ resource.ResourceWithImportState  = &SSOSettingsResource{}
+	_ resource.ResourceWithUpgradeState = &SSOSettingsResource{}
 )
 
 // NewSSOSettingsResource returns a new SSOSettingsResource -- the gatekeeper
@@ -40,7 +45,7 @@ type SSOSettingsResource struct {
 type SSOSettingsResourceModel struct {
 	ID                     types.String `tfsdk:"id"`
 	DefaultWorkspaceRoleID types.String `tfsdk:"default_workspace_role_id"`
-	DefaultWorkspaceIDs    types.String `tfsdk:"default_workspace_ids"`
+	DefaultWorkspaceIDs    types.List   `tfsdk:"default_workspace_ids"`
 	MetadataURL            types.String `tfsdk:"metadata_url"`
 	MetadataXML            types.String `tfsdk:"metadata_xml"`
 	ProviderID             types.String `tfsdk:"provider_id"`
@@ -90,6 +95,7 @@ func (r *SSOSettingsResource) Metadata(ctx context.Context, req resource.Metadat
 func (r *SSOSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages LangSmith SSO settings.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the SSO settings.",
@@ -102,9 +108,13 @@ func (r *SSOSettingsResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Default role ID for SSO-provisioned users.",
 				Optional:            true,
 			},
-			"default_workspace_ids": schema.StringAttribute{
-				MarkdownDescription: "JSON-encoded array of default workspace IDs for SSO-provisioned users.",
+			"default_workspace_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the workspaces SSO-provisioned users are added to by default.",
 				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validUUID()),
+				},
 			},
 			"metadata_url": schema.StringAttribute{
 				MarkdownDescription: "The SAML metadata URL.",
@@ -116,7 +126,7 @@ func (r *SSOSettingsResource) Schema(ctx context.Context, req resource.SchemaReq
 				Sensitive:           true,
 			},
 			"provider_id": schema.StringAttribute{
-				MarkdownDescription: "The SSO provider ID.",
+				MarkdownDescription: "The SSO provider ID. Existing settings can be imported by it with an import ID of `provider:<provider_id>`.",
 				Computed:            true,
 			},
 			"organization_id": schema.StringAttribute{
@@ -132,16 +142,16 @@ func (r *SSOSettingsResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *SSOSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -158,9 +168,10 @@ func (r *SSOSettingsResource) Create(ctx context.Context, req resource.CreateReq
 		body.DefaultWorkspaceRoleID = &v
 	}
 
-	// Default workspace IDs ride along as raw JSON -- a whole wagon train of UUIDs.
-	if !data.DefaultWorkspaceIDs.IsNull() && !data.DefaultWorkspaceIDs.IsUnknown() {
-		body.DefaultWorkspaceIDs = json.RawMessage(data.DefaultWorkspaceIDs.ValueString())
+	// Default workspace IDs ride along as a JSON array -- a whole wagon train of UUIDs.
+	body.DefaultWorkspaceIDs = buildSSODefaultWorkspaceIDs(ctx, data.DefaultWorkspaceIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !data.MetadataURL.IsNull() && !data.MetadataURL.IsUnknown() {
@@ -174,13 +185,25 @@ func (r *SSOSettingsResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	var result ssoSettingsAPIResponse
-	err := r.client.Post(ctx, "/api/v1/orgs/current/sso-settings", body, &result)
+	err := r.client.Create(ctx, "/api/v1/orgs/current/sso-settings", body, &result)
+	adopted := client.IsConflict(err)
+	if adopted {
+		// The organization already has SSO settings, most likely from an
+		// earlier create whose answer never made it back. Adopt them.
+		result, err = r.adoptSSOSettings(ctx, ssoSettingsUpdateRequest(body))
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating SSO settings", err.Error())
 		return
 	}
+	if adopted {
+		resp.Diagnostics.AddWarning(
+			"Adopted Existing SSO Settings",
+			fmt.Sprintf("The organization already had SSO settings, so instead of creating new ones the provider adopted the existing configuration %s and updated it to match. If it was managed elsewhere, it's managed here now.", result.ID),
+		)
+	}
 
-	mapSSOSettingsResponseToState(&data, &result)
+	mapSSOSettingsResponseToState(ctx, &data, &result, &resp.Diagnostics)
 	tflog.Trace(ctx, "created SSO settings resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -195,8 +218,7 @@ func (r *SSOSettingsResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Like rounding up strays, we have to fetch the whole herd and pick ours
 	// out by brand -- the API only offers a list endpoint.
-	var listResult ssoSettingsListAPIResponse
-	err := r.client.Get(ctx, "/api/v1/orgs/current/sso-settings", nil, &listResult)
+	listResult, err := listSSOSettings(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SSO settings", err.Error())
 		return
@@ -216,7 +238,7 @@ func (r *SSOSettingsResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	mapSSOSettingsResponseToState(&data, found)
+	mapSSOSettingsResponseToState(ctx, &data, found, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -235,8 +257,9 @@ func (r *SSOSettingsResource) Update(ctx context.Context, req resource.UpdateReq
 		body.DefaultWorkspaceRoleID = &v
 	}
 
-	if !data.DefaultWorkspaceIDs.IsNull() && !data.DefaultWorkspaceIDs.IsUnknown() {
-		body.DefaultWorkspaceIDs = json.RawMessage(data.DefaultWorkspaceIDs.ValueString())
+	body.DefaultWorkspaceIDs = buildSSODefaultWorkspaceIDs(ctx, data.DefaultWorkspaceIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !data.MetadataURL.IsNull() && !data.MetadataURL.IsUnknown() {
@@ -256,7 +279,7 @@ func (r *SSOSettingsResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	mapSSOSettingsResponseToState(&data, &result)
+	mapSSOSettingsResponseToState(ctx, &data, &result, &resp.Diagnostics)
 	tflog.Trace(ctx, "updated SSO settings resource", map[string]interface{}{"id": result.ID})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -278,14 +301,159 @@ func (r *SSOSettingsResource) Delete(ctx context.Context, req resource.DeleteReq
 	tflog.Trace(ctx, "deleted SSO settings resource", map[string]interface{}{"id": data.ID.ValueString()})
 }
 
+// ImportState takes the settings ID, or `provider:<provider_id>` for those
+// who only know the SAML provider it's wired to.
 func (r *SSOSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	providerID, ok := strings.CutPrefix(req.ID, "provider:")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	settings, err := listSSOSettings(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SSO settings", err.Error())
+		return
+	}
+
+	var matches []string
+	for _, sso := range settings {
+		if sso.ProviderID == providerID {
+			matches = append(matches, sso.ID)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), matches[0])...)
+	case 0:
+		resp.Diagnostics.AddError(
+			"SSO Settings Not Found",
+			fmt.Sprintf("No SSO settings in the organization use provider %q.", providerID),
+		)
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous SSO Provider",
+			fmt.Sprintf("Found %d SSO settings using provider %q; import by settings ID instead: %s.", len(matches), providerID, strings.Join(matches, ", ")),
+		)
+	}
+}
+
+// UpgradeState carries version 0 state, where default_workspace_ids was a
+// JSON-encoded string, forward to the typed list.
+func (r *SSOSettingsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                        schema.StringAttribute{Computed: true},
+					"default_workspace_role_id": schema.StringAttribute{Optional: true},
+					"default_workspace_ids":     schema.StringAttribute{Optional: true},
+					"metadata_url":              schema.StringAttribute{Optional: true},
+					"metadata_xml":              schema.StringAttribute{Optional: true, Sensitive: true},
+					"provider_id":               schema.StringAttribute{Computed: true},
+					"organization_id":           schema.StringAttribute{Computed: true},
+				},
+			},
+			StateUpgrader: upgradeSSOSettingsStateV0,
+		},
+	}
+}
+
+// ssoSettingsResourceModelV0 is the state as version 0 of the schema left it.
+type ssoSettingsResourceModelV0 struct {
+	ID                     types.String `tfsdk:"id"`
+	DefaultWorkspaceRoleID types.String `tfsdk:"default_workspace_role_id"`
+	DefaultWorkspaceIDs    types.String `tfsdk:"default_workspace_ids"`
+	MetadataURL            types.String `tfsdk:"metadata_url"`
+	MetadataXML            types.String `tfsdk:"metadata_xml"`
+	ProviderID             types.String `tfsdk:"provider_id"`
+	OrganizationID         types.String `tfsdk:"organization_id"`
+}
+
+func upgradeSSOSettingsStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior ssoSettingsResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := SSOSettingsResourceModel{
+		ID:                     prior.ID,
+		DefaultWorkspaceRoleID: prior.DefaultWorkspaceRoleID,
+		DefaultWorkspaceIDs:    types.ListNull(types.StringType),
+		MetadataURL:            prior.MetadataURL,
+		MetadataXML:            prior.MetadataXML,
+		ProviderID:             prior.ProviderID,
+		OrganizationID:         prior.OrganizationID,
+	}
+
+	if !prior.DefaultWorkspaceIDs.IsNull() && prior.DefaultWorkspaceIDs.ValueString() != "" {
+		var ids []string
+		if err := json.Unmarshal([]byte(prior.DefaultWorkspaceIDs.ValueString()), &ids); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_workspace_ids"),
+				"Unable to Upgrade SSO Settings State",
+				fmt.Sprintf("default_workspace_ids is not a JSON array of strings: %s", err),
+			)
+			return
+		}
+		list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(diags...)
+		upgraded.DefaultWorkspaceIDs = list
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+}
+
+// adoptSSOSettings takes over the organization's one existing SSO
+// configuration and patches it to match body. With more than one on file
+// there's no telling which was meant, so the conflict stands.
+func (r *SSOSettingsResource) adoptSSOSettings(ctx context.Context, body ssoSettingsUpdateRequest) (ssoSettingsAPIResponse, error) {
+	var result ssoSettingsAPIResponse
+
+	existing, err := listSSOSettings(ctx, r.client)
+	if err != nil {
+		return result, err
+	}
+	if len(existing) != 1 {
+		return result, fmt.Errorf("SSO settings already exist and %d configurations are on file; import the one to manage", len(existing))
+	}
+
+	tflog.Info(ctx, "SSO settings already exist, adopting them", map[string]interface{}{"id": existing[0].ID})
+	err = r.client.Patch(ctx, "/api/v1/orgs/current/sso-settings/"+existing[0].ID, body, &result)
+	return result, err
+}
+
+// listSSOSettings fetches every SSO configuration the organization has on file.
+func listSSOSettings(ctx context.Context, c *client.Client) (ssoSettingsListAPIResponse, error) {
+	return client.GetAll[ssoSettingsAPIResponse](ctx, c, "/api/v1/orgs/current/sso-settings", nil)
+}
+
+// buildSSODefaultWorkspaceIDs encodes the workspace ID list as the JSON array
+// the API expects, or returns nil when there's nothing to send.
+func buildSSODefaultWorkspaceIDs(ctx context.Context, list types.List, diags *diag.Diagnostics) json.RawMessage {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	ids := []string{}
+	diags.Append(list.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	raw, err := json.Marshal(ids)
+	if err != nil {
+		diags.AddError("Error encoding default_workspace_ids", err.Error())
+		return nil
+	}
+	return raw
 }
 
 // mapSSOSettingsResponseToState maps the API response onto Terraform state,
 // leaving optional fields null when the API sends back nothing -- like an
 // empty hitching post outside the Long Branch.
-func mapSSOSettingsResponseToState(data *SSOSettingsResourceModel, result *ssoSettingsAPIResponse) {
+func mapSSOSettingsResponseToState(ctx context.Context, data *SSOSettingsResourceModel, result *ssoSettingsAPIResponse, diagnostics *diag.Diagnostics) {
 	data.ID = types.StringValue(result.ID)
 	data.OrganizationID = types.StringValue(result.OrganizationID)
 	data.ProviderID = types.StringValue(result.ProviderID)
@@ -296,10 +464,21 @@ func mapSSOSettingsResponseToState(data *SSOSettingsResourceModel, result *ssoSe
 		data.DefaultWorkspaceRoleID = types.StringNull()
 	}
 
-	if len(result.DefaultWorkspaceIDs) > 0 && string(result.DefaultWorkspaceIDs) != "null" {
-		data.DefaultWorkspaceIDs = types.StringValue(string(result.DefaultWorkspaceIDs))
+	var ids []string
+	if len(result.DefaultWorkspaceIDs) > 0 {
+		if err := json.Unmarshal(result.DefaultWorkspaceIDs, &ids); err != nil {
+			diagnostics.AddError("Error reading default_workspace_ids", err.Error())
+			return
+		}
+	}
+	// An empty list from the API stays null unless the user asked for an
+	// empty list, so leaving the attribute unset doesn't read as drift.
+	if ids == nil || (len(ids) == 0 && data.DefaultWorkspaceIDs.IsNull()) {
+		data.DefaultWorkspaceIDs = types.ListNull(types.StringType)
 	} else {
-		data.DefaultWorkspaceIDs = types.StringNull()
+		list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+		diagnostics.Append(diags...)
+		data.DefaultWorkspaceIDs = list
 	}
 
 	if result.MetadataURL != "" {
@@ -4,7 +4,22 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // TestAccSSOSettingsResource_basic swings the saloon doors open with a
@@ -15,3 +30,206 @@ import (
 func TestAccSSOSettingsResource_basic(t *testing.T) {
 	t.Skip("Requires organization:manage permission (enterprise tier)")
 }
+
+// TestSSOSettingsResource_defaultWorkspaceIDs checks the workspace ID list
+// survives the round trip through the API's JSON array, and that an empty
+// array doesn't turn an unset attribute into drift.
+func TestSSOSettingsResource_defaultWorkspaceIDs(t *testing.T) {
+	ctx := context.Background()
+	ids := []string{"00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002"}
+	list, _ := types.ListValueFrom(ctx, types.StringType, ids)
+
+	var diags diag.Diagnostics
+	raw := buildSSODefaultWorkspaceIDs(ctx, list, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	var sent []string
+	if err := json.Unmarshal(raw, &sent); err != nil || len(sent) != 2 || sent[0] != ids[0] || sent[1] != ids[1] {
+		t.Fatalf("expected the IDs as a JSON array, got %s", raw)
+	}
+	if raw := buildSSODefaultWorkspaceIDs(ctx, types.ListNull(types.StringType), &diags); raw != nil {
+		t.Errorf("expected nothing sent for a null list, got %s", raw)
+	}
+
+	data := SSOSettingsResourceModel{DefaultWorkspaceIDs: types.ListNull(types.StringType)}
+	mapSSOSettingsResponseToState(ctx, &data, &ssoSettingsAPIResponse{ID: "sso1", DefaultWorkspaceIDs: raw}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !data.DefaultWorkspaceIDs.Equal(list) {
+		t.Errorf("expected %s back from the API, got %s", list, data.DefaultWorkspaceIDs)
+	}
+
+	for _, empty := range []string{"[]", "null"} {
+		data := SSOSettingsResourceModel{DefaultWorkspaceIDs: types.ListNull(types.StringType)}
+		mapSSOSettingsResponseToState(ctx, &data, &ssoSettingsAPIResponse{ID: "sso1", DefaultWorkspaceIDs: json.RawMessage(empty)}, &diags)
+		if !data.DefaultWorkspaceIDs.IsNull() {
+			t.Errorf("expected %s to read back as null, got %s", empty, data.DefaultWorkspaceIDs)
+		}
+	}
+}
+
+// TestSSOSettingsResource_defaultWorkspaceIDsValidation checks every workspace
+// ID has to be a UUID.
+func TestSSOSettingsResource_defaultWorkspaceIDsValidation(t *testing.T) {
+	idList := func(ids ...string) tftypes.Value {
+		var elems []tftypes.Value
+		for _, id := range ids {
+			elems = append(elems, tftypes.NewValue(tftypes.String, id))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elems)
+	}
+
+	diags := testValidateResourceConfig(t, "langsmith_sso_settings", map[string]tftypes.Value{
+		"default_workspace_ids": idList("00000000-0000-0000-0000-000000000001"),
+	})
+	if len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+
+	diags = testValidateResourceConfig(t, "langsmith_sso_settings", map[string]tftypes.Value{
+		"default_workspace_ids": idList("00000000-0000-0000-0000-000000000001", "front-street"),
+	})
+	if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "UUID") {
+		t.Errorf("expected a UUID error, got %v", diags)
+	}
+}
+
+// TestSSOSettingsResource_upgradeStateV0 checks state written when the IDs
+// were a JSON string comes forward as a list.
+func TestSSOSettingsResource_upgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &SSOSettingsResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	prior := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw:    tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil),
+	}
+	if diags := prior.Set(ctx, &ssoSettingsResourceModelV0{
+		ID:                     types.StringValue("sso1"),
+		DefaultWorkspaceRoleID: types.StringNull(),
+		DefaultWorkspaceIDs:    types.StringValue(`["00000000-0000-0000-0000-000000000001"]`),
+		MetadataURL:            types.StringValue("https://idp.example.com/metadata"),
+		MetadataXML:            types.StringNull(),
+		ProviderID:             types.StringValue("p1"),
+		OrganizationID:         types.StringValue("o1"),
+	}); diags.HasError() {
+		t.Fatalf("setting prior state: %v", diags)
+	}
+
+	resp := &resource.UpgradeStateResponse{State: testResourceState(t, r, &SSOSettingsResourceModel{
+		ID:                     types.StringNull(),
+		DefaultWorkspaceRoleID: types.StringNull(),
+		DefaultWorkspaceIDs:    types.ListNull(types.StringType),
+		MetadataURL:            types.StringNull(),
+		MetadataXML:            types.StringNull(),
+		ProviderID:             types.StringNull(),
+		OrganizationID:         types.StringNull(),
+	})}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got SSOSettingsResourceModel
+	resp.State.Get(ctx, &got)
+	want, _ := types.ListValueFrom(ctx, types.StringType, []string{"00000000-0000-0000-0000-000000000001"})
+	if !got.DefaultWorkspaceIDs.Equal(want) || got.MetadataURL.ValueString() != "https://idp.example.com/metadata" {
+		t.Errorf("unexpected upgraded state: %+v", got)
+	}
+}
+
+// TestSSOSettingsResource_importByProvider checks settings can be imported by
+// the SAML provider they use, and by their own ID as before.
+func TestSSOSettingsResource_importByProvider(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "0" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id": "s1", "provider_id": "okta"}, {"id": "s2", "provider_id": "twin"}, {"id": "s3", "provider_id": "twin"}]`))
+	}))
+	defer ts.Close()
+
+	r := &SSOSettingsResource{client: client.NewClient(ts.URL, "key", "")}
+	for id, want := range map[string]string{"provider:okta": "s1", "s9": "s9"} {
+		resp := testImportState(t, r, id)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: import: %v", id, resp.Diagnostics)
+		}
+		var got types.String
+		resp.State.GetAttribute(context.Background(), path.Root("id"), &got)
+		if got.ValueString() != want {
+			t.Errorf("%s: expected id %s, got %s", id, want, got)
+		}
+	}
+
+	for id, want := range map[string]string{"provider:gone": "SSO Settings Not Found", "provider:twin": "Ambiguous SSO Provider"} {
+		resp := testImportState(t, r, id)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != want {
+			t.Errorf("%s: expected %q, got %v", id, want, resp.Diagnostics)
+		}
+	}
+}
+
+// TestSSOSettingsResource_adoptOnConflict checks a create refused because the
+// organization already has SSO settings adopts and patches the one on file,
+// and leaves the conflict standing when there's more than one.
+func TestSSOSettingsResource_adoptOnConflict(t *testing.T) {
+	existing := `[{"id": "s1", "provider_id": "okta"}]`
+	var patched []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"detail": "SSO settings already exist"}`))
+		case http.MethodGet:
+			if r.URL.Query().Get("offset") != "0" {
+				_, _ = w.Write([]byte(`[]`))
+				return
+			}
+			_, _ = w.Write([]byte(existing))
+		case http.MethodPatch:
+			patched = append(patched, r.URL.Path)
+			_, _ = w.Write([]byte(`{"id": "s1", "provider_id": "okta", "organization_id": "o1", "metadata_url": "https://idp.example.com/metadata"}`))
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &SSOSettingsResource{client: client.NewClient(ts.URL, "key", "")}
+	plan := testResourceState(t, r, &SSOSettingsResourceModel{
+		ID:                     types.StringUnknown(),
+		DefaultWorkspaceRoleID: types.StringNull(),
+		DefaultWorkspaceIDs:    types.ListNull(types.StringType),
+		MetadataURL:            types.StringValue("https://idp.example.com/metadata"),
+		MetadataXML:            types.StringNull(),
+		ProviderID:             types.StringUnknown(),
+		OrganizationID:         types.StringUnknown(),
+	})
+	create := func() *resource.CreateResponse {
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(ctx), nil)}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+		return resp
+	}
+
+	resp := create()
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", resp.Diagnostics)
+	}
+	var got types.String
+	resp.State.GetAttribute(ctx, path.Root("id"), &got)
+	if got.ValueString() != "s1" || len(patched) != 1 || patched[0] != "/api/v1/orgs/current/sso-settings/s1" {
+		t.Errorf("expected the existing settings to be adopted, got id %s and patches %v", got, patched)
+	}
+	if warnings := resp.Diagnostics.Warnings(); len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "s1") {
+		t.Errorf("expected a warning naming the adopted settings, got %v", resp.Diagnostics)
+	}
+
+	existing = `[{"id": "s1"}, {"id": "s2"}]`
+	if resp := create(); !resp.Diagnostics.HasError() || len(patched) != 1 {
+		t.Errorf("expected the conflict to stand with two configurations on file, got %v", resp.Diagnostics)
+	}
+}
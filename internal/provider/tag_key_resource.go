@@ -41,6 +41,7 @@ type TagKeyResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
 }
 
 // tagKeyCreateRequest is the order form for forging a new tag key.
@@ -72,6 +73,7 @@ func (r *TagKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith tag key.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the tag key.",
 				Computed:            true,
@@ -104,16 +106,16 @@ func (r *TagKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *TagKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -123,6 +125,8 @@ func (r *TagKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := tagKeyCreateRequest{
 		Key: data.Key.ValueString(),
 	}
@@ -133,7 +137,7 @@ func (r *TagKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	var result tagKeyAPIResponse
-	err := r.client.Post(ctx, "/api/v1/workspaces/current/tag-keys", body, &result)
+	err := r.client.Create(ctx, "/api/v1/workspaces/current/tag-keys", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating tag key", err.Error())
 		return
@@ -152,6 +156,8 @@ func (r *TagKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result tagKeyAPIResponse
 	err := r.client.Get(ctx, "/api/v1/workspaces/current/tag-keys/"+data.ID.ValueString(), nil, &result)
 	if err != nil {
@@ -175,6 +181,8 @@ func (r *TagKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := tagKeyUpdateRequest{
 		Key: data.Key.ValueString(),
 	}
@@ -204,6 +212,8 @@ func (r *TagKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/workspaces/current/tag-keys/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting tag key", err.Error())
@@ -214,6 +224,7 @@ func (r *TagKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *TagKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -43,6 +43,7 @@ type TagValueResourceModel struct {
 	Description types.String `tfsdk:"description"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
 }
 
 // tagValueCreateRequest is the payload for minting a new tag value.
@@ -74,6 +75,7 @@ func (r *TagValueResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith tag value within a tag key.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the tag value.",
 				Computed:            true,
@@ -113,16 +115,16 @@ func (r *TagValueResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *TagValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -132,6 +134,8 @@ func (r *TagValueResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := tagValueCreateRequest{
 		Value: data.Value.ValueString(),
 	}
@@ -144,7 +148,7 @@ func (r *TagValueResource) Create(ctx context.Context, req resource.CreateReques
 	apiPath := fmt.Sprintf("/api/v1/workspaces/current/tag-keys/%s/tag-values", data.TagKeyID.ValueString())
 
 	var result tagValueAPIResponse
-	err := r.client.Post(ctx, apiPath, body, &result)
+	err := r.client.Create(ctx, apiPath, body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating tag value", err.Error())
 		return
@@ -163,6 +167,8 @@ func (r *TagValueResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	apiPath := fmt.Sprintf("/api/v1/workspaces/current/tag-keys/%s/tag-values/%s",
 		data.TagKeyID.ValueString(), data.ID.ValueString())
 
@@ -189,6 +195,8 @@ func (r *TagValueResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := tagValueUpdateRequest{
 		Value: data.Value.ValueString(),
 	}
@@ -221,6 +229,8 @@ func (r *TagValueResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	apiPath := fmt.Sprintf("/api/v1/workspaces/current/tag-keys/%s/tag-values/%s",
 		data.TagKeyID.ValueString(), data.ID.ValueString())
 
@@ -236,6 +246,7 @@ func (r *TagValueResource) Delete(ctx context.Context, req resource.DeleteReques
 // ImportState handles importing a tag value resource.
 // The import ID format is "tag_key_id/tag_value_id" -- two-part brand, one slash.
 func (r *TagValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	parts := strings.SplitN(req.ID, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
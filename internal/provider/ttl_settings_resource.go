@@ -44,6 +44,7 @@ type TTLSettingsResourceModel struct {
 	LonglivedTTLDays types.Int64  `tfsdk:"longlived_ttl_days"`
 	IsCustom         types.Bool   `tfsdk:"is_custom"`
 	TenantID         types.String `tfsdk:"tenant_id"`
+	WorkspaceID      types.String `tfsdk:"workspace_id"`
 }
 
 // ttlSettingsUpdateRequest is the request body for updating TTL settings --
@@ -68,6 +69,7 @@ func (r *TTLSettingsResource) Schema(ctx context.Context, req resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages LangSmith workspace trace retention (TTL) settings. This is a singleton resource that always exists per workspace.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the TTL settings (set to the tenant ID).",
 				Computed:            true,
@@ -96,16 +98,16 @@ func (r *TTLSettingsResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *TTLSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -115,6 +117,8 @@ func (r *TTLSettingsResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	// TTL settings always exist -- like the marshal's office, they're
 	// part of the town whether you built them or not. So "create" is
 	// really just laying down the law with a PUT.
@@ -147,6 +151,8 @@ func (r *TTLSettingsResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	r.readTTLSettings(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -162,6 +168,8 @@ func (r *TTLSettingsResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := ttlSettingsUpdateRequest{
 		LonglivedTTLDays: data.LonglivedTTLDays.ValueInt64(),
 	}
@@ -190,6 +198,7 @@ func (r *TTLSettingsResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *TTLSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
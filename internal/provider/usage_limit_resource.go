@@ -39,12 +39,13 @@ type UsageLimitResource struct {
 // UsageLimitResourceModel holds the Terraform state for a usage limit,
 // including the limit type, value, and audit timestamps.
 type UsageLimitResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	LimitType  types.String `tfsdk:"limit_type"`
-	LimitValue types.Int64  `tfsdk:"limit_value"`
-	TenantID   types.String `tfsdk:"tenant_id"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
+	ID          types.String `tfsdk:"id"`
+	LimitType   types.String `tfsdk:"limit_type"`
+	LimitValue  types.Int64  `tfsdk:"limit_value"`
+	TenantID    types.String `tfsdk:"tenant_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
 }
 
 // usageLimitAPIRequest is the request body for creating/updating a usage limit.
@@ -71,6 +72,7 @@ func (r *UsageLimitResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith usage limit.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the usage limit.",
 				Computed:            true,
@@ -110,16 +112,16 @@ func (r *UsageLimitResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
 }
 
 func (r *UsageLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -129,6 +131,8 @@ func (r *UsageLimitResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := usageLimitAPIRequest{
 		LimitType:  data.LimitType.ValueString(),
 		LimitValue: data.LimitValue.ValueInt64(),
@@ -154,6 +158,8 @@ func (r *UsageLimitResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var results []usageLimitAPIResponse
 	err := r.client.Get(ctx, "/api/v1/usage-limits", nil, &results)
 	if err != nil {
@@ -190,6 +196,8 @@ func (r *UsageLimitResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := usageLimitAPIRequest{
 		LimitType:  data.LimitType.ValueString(),
 		LimitValue: data.LimitValue.ValueInt64(),
@@ -215,6 +223,8 @@ func (r *UsageLimitResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/usage-limits/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting usage limit", err.Error())
@@ -225,6 +235,7 @@ func (r *UsageLimitResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *UsageLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
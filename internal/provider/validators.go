@@ -0,0 +1,150 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// uuidRegexp matches a canonical, hyphenated UUID in either case.
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validUUID checks that a string attribute holds a well-formed UUID before
+// it's ever sent to the API. Every ID LangSmith hands out wears this brand.
+func validUUID() validator.String {
+	return stringvalidator.RegexMatches(uuidRegexp, "must be a valid UUID")
+}
+
+// validEndpoint checks that a string attribute holds a URL LangSmith can be
+// reached at: an http or https scheme and a host.
+func validEndpoint() validator.String {
+	return endpointValidator{}
+}
+
+// endpointValidator is the validator behind validEndpoint.
+type endpointValidator struct{}
+
+func (v endpointValidator) Description(ctx context.Context) string {
+	return "must be an http or https URL with a host"
+}
+
+func (v endpointValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v endpointValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := normalizeEndpoint(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Endpoint", err.Error())
+	}
+}
+
+// validHTTPSURL checks that a string attribute holds an https URL with a
+// host, for anywhere LangSmith sends payloads that shouldn't cross the wire
+// in the clear.
+func validHTTPSURL() validator.String {
+	return httpsURLValidator{}
+}
+
+// httpsURLValidator is the validator behind validHTTPSURL.
+type httpsURLValidator struct{}
+
+func (v httpsURLValidator) Description(ctx context.Context) string {
+	return "must be an https URL with a host"
+}
+
+func (v httpsURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v httpsURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	raw := req.ConfigValue.ValueString()
+	u, err := url.Parse(raw)
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL", fmt.Sprintf("%q isn't a valid URL: %s", raw, err))
+	case u.Scheme != "https":
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL", fmt.Sprintf("%q must start with https://", raw))
+	case u.Host == "":
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL", fmt.Sprintf("%q has no host", raw))
+	}
+}
+
+// validTimestamp checks that a string attribute holds an RFC3339 timestamp,
+// the way LangSmith expects a point in time to be written down.
+func validTimestamp() validator.String {
+	return timestampValidator{}
+}
+
+// timestampValidator is the validator behind validTimestamp.
+type timestampValidator struct{}
+
+func (v timestampValidator) Description(ctx context.Context) string {
+	return "must be an RFC3339 timestamp"
+}
+
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timestamp",
+			fmt.Sprintf("%q isn't an RFC3339 timestamp, e.g. 2025-01-02T15:04:05Z: %s", req.ConfigValue.ValueString(), err))
+	}
+}
+
+// validFilter checks that a string attribute holds a filter expression that
+// parses, such as `and(eq(status, "error"), gt(latency, 5))`. LangSmith
+// takes a broken filter without complaint and then matches nothing, so a
+// stray paren is better caught at plan time than after a week of silence.
+func validFilter() validator.String {
+	return filterValidator{}
+}
+
+// filterValidator is the validator behind validFilter.
+type filterValidator struct{}
+
+func (v filterValidator) Description(ctx context.Context) string {
+	return "must be a LangSmith filter expression, e.g. eq(status, \"error\")"
+}
+
+func (v filterValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be a LangSmith filter expression, e.g. `eq(status, \"error\")`"
+}
+
+func (v filterValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	filter := req.ConfigValue.ValueString()
+	p := &filterParser{input: filter}
+	node, err := p.parse()
+	if err == nil && node.call == "" {
+		err = fmt.Errorf("a filter must be an operator call, such as eq(field, value)")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Filter",
+			fmt.Sprintf("%q isn't a valid filter expression: %s", filter, err))
+	}
+}
@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -24,6 +27,9 @@ var (
 	_ resource.ResourceWithImportState = &WebhookResource{}
 )
 
+// webhookTriggers are the prompt events a webhook can fire on.
+var webhookTriggers = []string{"commit", "tag"}
+
 // NewWebhookResource returns a new WebhookResource, ready to keep watch.
 func NewWebhookResource() resource.Resource {
 	return &WebhookResource{}
@@ -40,18 +46,21 @@ type WebhookResourceModel struct {
 	ID             types.String `tfsdk:"id"`
 	URL            types.String `tfsdk:"url"`
 	Headers        types.Map    `tfsdk:"headers"`
+	Secret         types.String `tfsdk:"secret"`
 	Triggers       types.List   `tfsdk:"triggers"`
 	IncludePrompts types.List   `tfsdk:"include_prompts"`
 	ExcludePrompts types.List   `tfsdk:"exclude_prompts"`
 	TenantID       types.String `tfsdk:"tenant_id"`
 	CreatedAt      types.String `tfsdk:"created_at"`
 	UpdatedAt      types.String `tfsdk:"updated_at"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
 }
 
 // webhookCreateRequest is the payload for stringing up a new webhook.
 type webhookCreateRequest struct {
 	URL            string            `json:"url"`
 	Headers        map[string]string `json:"headers,omitempty"`
+	Secret         string            `json:"secret,omitempty"`
 	Triggers       []string          `json:"triggers,omitempty"`
 	IncludePrompts []string          `json:"include_prompts,omitempty"`
 	ExcludePrompts []string          `json:"exclude_prompts,omitempty"`
@@ -78,24 +87,38 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a prompt webhook in LangSmith.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the webhook.",
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "The webhook URL.",
+				MarkdownDescription: "The webhook URL. Must be an `https` URL.",
 				Required:            true,
+				Validators:          []validator.String{validHTTPSURL()},
 			},
 			"headers": schema.MapAttribute{
 				MarkdownDescription: "Custom headers to include in webhook requests.",
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret LangSmith signs each webhook payload with, so the receiver can check it came from LangSmith. " +
+					"The API never returns it, so the value is kept in state as last sent, and it's only sent again when it changes. " +
+					"Removing it from the configuration leaves the stored secret in place.",
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
 			"triggers": schema.ListAttribute{
-				MarkdownDescription: "Trigger events for the webhook.",
+				MarkdownDescription: "Trigger events for the webhook. Each must be one of `commit` or `tag`.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(webhookTriggers...)),
+				},
 			},
 			"include_prompts": schema.ListAttribute{
 				MarkdownDescription: "Prompt names to include.",
@@ -127,13 +150,13 @@ func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureR
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData))
 		return
 	}
-	r.client = c
+	r.client = data.client
 }
 
 func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -143,6 +166,8 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := webhookCreateRequest{
 		URL: data.URL.ValueString(),
 	}
@@ -154,6 +179,9 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		body.Headers = headers
 	}
+	if !data.Secret.IsNull() && !data.Secret.IsUnknown() {
+		body.Secret = data.Secret.ValueString()
+	}
 	if !data.Triggers.IsNull() {
 		var triggers []string
 		resp.Diagnostics.Append(data.Triggers.ElementsAs(ctx, &triggers, false)...)
@@ -180,13 +208,18 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var result webhookAPIResponse
-	err := r.client.Post(ctx, "/api/v1/prompt-webhooks", body, &result)
+	err := r.client.Create(ctx, "/api/v1/prompt-webhooks", body, &result)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating webhook", err.Error())
 		return
 	}
 
 	r.mapResponseToModel(ctx, &result, &data, &resp.Diagnostics)
+	// A secret left out of the configuration was never sent; there's
+	// nothing to remember.
+	if data.Secret.IsUnknown() {
+		data.Secret = types.StringNull()
+	}
 
 	tflog.Trace(ctx, "created webhook resource", map[string]interface{}{"id": result.ID})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -199,6 +232,8 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	var result webhookAPIResponse
 	err := r.client.Get(ctx, fmt.Sprintf("/api/v1/prompt-webhooks/%s", data.ID.ValueString()), nil, &result)
 	if err != nil {
@@ -215,12 +250,15 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data WebhookResourceModel
+	var data, state WebhookResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := webhookCreateRequest{
 		URL: data.URL.ValueString(),
 	}
@@ -232,6 +270,11 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 		body.Headers = headers
 	}
+	// The secret can't be read back to compare, so it only rides along
+	// when the configuration changes it.
+	if !data.Secret.IsNull() && !data.Secret.IsUnknown() && !data.Secret.Equal(state.Secret) {
+		body.Secret = data.Secret.ValueString()
+	}
 	if !data.Triggers.IsNull() {
 		var triggers []string
 		resp.Diagnostics.Append(data.Triggers.ElementsAs(ctx, &triggers, false)...)
@@ -275,6 +318,8 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, fmt.Sprintf("/api/v1/prompt-webhooks/%s", data.ID.ValueString()))
 	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error deleting webhook", err.Error())
@@ -282,6 +327,7 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
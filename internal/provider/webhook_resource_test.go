@@ -0,0 +1,122 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestWebhookResource_secret checks the signing secret is sent when created
+// and when changed, never otherwise, and survives a Read the API leaves it
+// out of.
+func TestWebhookResource_secret(t *testing.T) {
+	var sent []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			sent = append(sent, body)
+		}
+		_ = json.NewEncoder(w).Encode(webhookAPIResponse{ID: "w1", URL: "https://hooks.example.com/langsmith", TenantID: "t1"})
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	r := &WebhookResource{client: client.NewClient(srv.URL, "key", "")}
+	model := WebhookResourceModel{
+		ID:             types.StringUnknown(),
+		URL:            types.StringValue("https://hooks.example.com/langsmith"),
+		Headers:        types.MapNull(types.StringType),
+		Secret:         types.StringValue("first"),
+		Triggers:       types.ListNull(types.StringType),
+		IncludePrompts: types.ListNull(types.StringType),
+		ExcludePrompts: types.ListNull(types.StringType),
+		TenantID:       types.StringUnknown(),
+		CreatedAt:      types.StringUnknown(),
+		UpdatedAt:      types.StringUnknown(),
+		WorkspaceID:    types.StringNull(),
+	}
+	plan := testResourceState(t, r, &model)
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	var got WebhookResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.Secret.ValueString() != "first" {
+		t.Errorf("expected the secret to be kept in state, got %s", got.Secret)
+	}
+
+	for _, secret := range []string{"first", "second"} {
+		planned := got
+		planned.Secret = types.StringValue(secret)
+		plan := testResourceState(t, r, &planned)
+		updateResp := &resource.UpdateResponse{State: readResp.State}
+		r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: readResp.State}, updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("updating: %v", updateResp.Diagnostics)
+		}
+	}
+
+	if len(sent) != 3 || sent[0]["secret"] != "first" || sent[1]["secret"] != nil || sent[2]["secret"] != "second" {
+		t.Errorf("expected the secret on create and on change only, got %v", sent)
+	}
+}
+
+// TestWebhookResource_validation checks a webhook must be sent over https and
+// may only fire on known events.
+func TestWebhookResource_validation(t *testing.T) {
+	triggers := func(names ...string) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(names))
+		for _, name := range names {
+			values = append(values, tftypes.NewValue(tftypes.String, name))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		url       string
+		triggers  tftypes.Value
+		wantError string
+	}{
+		{name: "valid", url: "https://hooks.example.com/langsmith", triggers: triggers("commit", "tag")},
+		{name: "http", url: "http://hooks.example.com/langsmith", triggers: triggers("commit"), wantError: "url"},
+		{name: "no host", url: "https:///langsmith", triggers: triggers("commit"), wantError: "url"},
+		{name: "unparseable", url: "https://hooks example.com/%zz", triggers: triggers("commit"), wantError: "url"},
+		{name: "unknown trigger", url: "https://hooks.example.com/langsmith", triggers: triggers("on_commit"), wantError: "triggers"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := testValidateResourceConfig(t, "langsmith_webhook", map[string]tftypes.Value{
+				"url":      tftypes.NewValue(tftypes.String, tc.url),
+				"triggers": tc.triggers,
+			})
+			if tc.wantError == "" {
+				if testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, "") {
+					t.Errorf("expected no errors, got %v", diags)
+				}
+				return
+			}
+			if !testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, tc.wantError) {
+				t.Errorf("expected an error on %s, got %v", tc.wantError, diags)
+			}
+		})
+	}
+}
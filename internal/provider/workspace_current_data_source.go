@@ -0,0 +1,117 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var _ datasource.DataSource = &WorkspaceCurrentDataSource{}
+
+// NewWorkspaceCurrentDataSource returns a new WorkspaceCurrentDataSource, for
+// finding out which corner of the territory the provider's key rides in.
+func NewWorkspaceCurrentDataSource() datasource.DataSource {
+	return &WorkspaceCurrentDataSource{}
+}
+
+// WorkspaceCurrentDataSource reads the workspace the provider is working in:
+// the one named by the provider's tenant_id, or the API key's own workspace
+// when none is set. Modules use it to learn the tenant ID without being told.
+type WorkspaceCurrentDataSource struct {
+	client *client.Client
+}
+
+// WorkspaceCurrentDataSourceModel holds the current workspace's identity.
+type WorkspaceCurrentDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	TenantHandle   types.String `tfsdk:"tenant_handle"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
+}
+
+func (d *WorkspaceCurrentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_current"
+}
+
+func (d *WorkspaceCurrentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to read the workspace the provider is working in: the one set by the provider's `tenant_id`, or otherwise the API key's own. Its `id` is the tenant ID many resources report.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the workspace, also known as the tenant ID.",
+				Computed:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the workspace.",
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID that owns this workspace.",
+				Computed:            true,
+			},
+			"tenant_handle": schema.StringAttribute{
+				MarkdownDescription: "The tenant handle of the workspace.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkspaceCurrentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *WorkspaceCurrentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkspaceCurrentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result workspaceDataSourceAPIResponse
+	err := d.client.Get(ctx, "/api/v1/workspaces/current", nil, &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading current workspace", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(result.ID)
+	data.DisplayName = types.StringValue(result.DisplayName)
+	data.TenantHandle = types.StringValue(result.TenantHandle)
+
+	if result.OrganizationID != nil {
+		data.OrganizationID = types.StringValue(*result.OrganizationID)
+	} else {
+		data.OrganizationID = types.StringNull()
+	}
+
+	tflog.Trace(ctx, "read current workspace data source", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// TestWorkspaceCurrentDataSource_read checks the current workspace is read
+// for whichever tenant the client carries.
+func TestWorkspaceCurrentDataSource_read(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/workspaces/current" || r.Header.Get("X-Tenant-Id") != "t1" {
+			t.Errorf("unexpected request %s for tenant %q", r.URL.Path, r.Header.Get("X-Tenant-Id"))
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "t1", "display_name": "Dodge City", "tenant_handle": "dodge-city", "organization_id": "o1", "is_personal": false}`))
+	}))
+	defer srv.Close()
+
+	d := &WorkspaceCurrentDataSource{client: client.NewClient(srv.URL, "key", "t1")}
+	resp := testDataSourceRead(t, d, &WorkspaceCurrentDataSourceModel{})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", resp.Diagnostics)
+	}
+
+	var got WorkspaceCurrentDataSourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != "t1" || got.DisplayName.ValueString() != "Dodge City" ||
+		got.TenantHandle.ValueString() != "dodge-city" || got.OrganizationID.ValueString() != "o1" {
+		t.Errorf("unexpected workspace %+v", got)
+	}
+}
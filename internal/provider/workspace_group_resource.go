@@ -0,0 +1,336 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+var (
+	_ resource.Resource                = &WorkspaceGroupResource{}
+	_ resource.ResourceWithImportState = &WorkspaceGroupResource{}
+)
+
+// NewWorkspaceGroupResource returns a new WorkspaceGroupResource -- a whole
+// posse sworn in at once, instead of one deputy at a time.
+func NewWorkspaceGroupResource() resource.Resource {
+	return &WorkspaceGroupResource{}
+}
+
+// WorkspaceGroupResource manages a set of workspace members who share a
+// role. LangSmith has no groups of its own, so the group lives in Terraform
+// and each of its users is a workspace member added through the members API.
+type WorkspaceGroupResource struct {
+	client *client.Client
+}
+
+// WorkspaceGroupResourceModel describes the Terraform state for a group.
+type WorkspaceGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	RoleID      types.String `tfsdk:"role_id"`
+	UserIDs     types.Set    `tfsdk:"user_ids"`
+	MemberIDs   types.Map    `tfsdk:"member_ids"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+func (r *WorkspaceGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_group"
+}
+
+func (r *WorkspaceGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a group of LangSmith workspace members who share a role, for adding hundreds of users without a `langsmith_workspace_member` each. " +
+			"LangSmith has no groups API, so the group is kept by Terraform: each user becomes a workspace member, and users already in the workspace are taken over and given the group's role. " +
+			"Don't manage the same user with both this resource and `langsmith_workspace_member`. " +
+			"Import with `role_id/name` to adopt every member holding the role.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the group, the same as its name.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group. It only labels the group in Terraform; LangSmith never sees it.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The role ID every member of the group holds.",
+				Required:            true,
+				Validators:          []validator.String{validUUID()},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "The user IDs of the group's members. Users added to the set join the workspace, and users taken out of it leave.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validUUID()),
+				},
+			},
+			"member_ids": schema.MapAttribute{
+				MarkdownDescription: "The workspace member ID (identity_id) of each user in the group, keyed by user ID.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *WorkspaceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *WorkspaceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	r.reconcile(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "created workspace group resource", map[string]interface{}{"name": data.Name.ValueString(), "members": len(data.MemberIDs.Elements())})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	members, err := listWorkspaceMembers(ctx, r.client, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+		return
+	}
+
+	// An import names only the role; the group is everyone who holds it.
+	var userIDs []string
+	if data.UserIDs.IsNull() {
+		for _, m := range members {
+			if m.RoleID == data.RoleID.ValueString() {
+				userIDs = append(userIDs, m.UserID)
+			}
+		}
+	} else {
+		userIDs = workspaceGroupUserIDs(data.UserIDs)
+	}
+
+	mapWorkspaceGroupMembersToState(&data, userIDs, members)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	r.reconcile(ctx, &data, workspaceGroupUserIDs(state.UserIDs), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "updated workspace group resource", map[string]interface{}{"name": data.Name.ValueString(), "members": len(data.MemberIDs.Elements())})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkspaceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	members, err := listWorkspaceMembers(ctx, r.client, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+		return
+	}
+	byUser := workspaceMembersByUser(members)
+
+	for _, userID := range workspaceGroupUserIDs(data.UserIDs) {
+		m, ok := byUser[userID]
+		if !ok {
+			continue
+		}
+		err := r.client.Delete(ctx, "/api/v1/workspaces/current/members/"+m.ID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Error deleting workspace member", fmt.Sprintf("Removing user %s from the workspace: %s", userID, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted workspace group resource", map[string]interface{}{"name": data.Name.ValueString()})
+}
+
+// ImportState handles importing a group. The import ID is "role_id/name":
+// every workspace member holding the role joins the group.
+func (r *WorkspaceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	roleID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || !uuidRegexp.MatchString(roleID) || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format 'role_id/name', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+}
+
+// reconcile brings the workspace in line with the planned group: users new
+// to the group join (or, if already in the workspace, take its role), users
+// dropped from it leave, and members holding the wrong role are set right.
+// prior is the group's users before the change.
+func (r *WorkspaceGroupResource) reconcile(ctx context.Context, data *WorkspaceGroupResourceModel, prior []string, diags *diag.Diagnostics) {
+	members, err := listWorkspaceMembers(ctx, r.client, nil)
+	if err != nil {
+		diags.AddError("Error reading workspace members", err.Error())
+		return
+	}
+	byUser := workspaceMembersByUser(members)
+
+	roleID := data.RoleID.ValueString()
+	userIDs := workspaceGroupUserIDs(data.UserIDs)
+	wanted := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		wanted[userID] = true
+
+		m, ok := byUser[userID]
+		switch {
+		case !ok:
+			var created workspaceMemberCreateResponse
+			body := workspaceMemberCreateRequest{UserID: userID, RoleID: roleID}
+			if err := r.client.Create(ctx, "/api/v1/workspaces/current/members", body, &created); err != nil {
+				diags.AddError("Error creating workspace member", fmt.Sprintf("Adding user %s to the workspace: %s", userID, err))
+				return
+			}
+			byUser[userID] = workspaceMemberAPIResponse{ID: created.ID, UserID: userID, RoleID: roleID}
+		case m.RoleID != roleID:
+			body := workspaceMemberUpdateRequest{RoleID: roleID}
+			if err := r.client.Patch(ctx, "/api/v1/workspaces/current/members/"+m.ID, body, nil); err != nil {
+				diags.AddError("Error updating workspace member", fmt.Sprintf("Setting the role of user %s: %s", userID, err))
+				return
+			}
+			m.RoleID = roleID
+			byUser[userID] = m
+		}
+	}
+
+	for _, userID := range prior {
+		m, ok := byUser[userID]
+		if wanted[userID] || !ok {
+			continue
+		}
+		err := r.client.Delete(ctx, "/api/v1/workspaces/current/members/"+m.ID)
+		if err != nil && !client.IsNotFound(err) {
+			diags.AddError("Error deleting workspace member", fmt.Sprintf("Removing user %s from the workspace: %s", userID, err))
+			return
+		}
+	}
+
+	current := make([]workspaceMemberAPIResponse, 0, len(byUser))
+	for _, m := range byUser {
+		current = append(current, m)
+	}
+	mapWorkspaceGroupMembersToState(data, userIDs, current)
+}
+
+// mapWorkspaceGroupMembersToState records which of the group's users are
+// still workspace members, and under what member IDs. A user who's left the
+// workspace drops out of user_ids, and a member whose role was changed
+// elsewhere shows up in role_id, so both surface as drift.
+func mapWorkspaceGroupMembersToState(data *WorkspaceGroupResourceModel, userIDs []string, members []workspaceMemberAPIResponse) {
+	byUser := workspaceMembersByUser(members)
+
+	present := make([]attr.Value, 0, len(userIDs))
+	memberIDs := make(map[string]attr.Value, len(userIDs))
+	for _, userID := range userIDs {
+		m, ok := byUser[userID]
+		if !ok {
+			continue
+		}
+		present = append(present, types.StringValue(userID))
+		memberIDs[userID] = types.StringValue(m.ID)
+		if m.RoleID != data.RoleID.ValueString() {
+			data.RoleID = types.StringValue(m.RoleID)
+		}
+	}
+
+	data.ID = data.Name
+	data.UserIDs = types.SetValueMust(types.StringType, present)
+	data.MemberIDs = types.MapValueMust(types.StringType, memberIDs)
+}
+
+// workspaceMembersByUser indexes workspace members by user ID.
+func workspaceMembersByUser(members []workspaceMemberAPIResponse) map[string]workspaceMemberAPIResponse {
+	byUser := make(map[string]workspaceMemberAPIResponse, len(members))
+	for _, m := range members {
+		byUser[m.UserID] = m
+	}
+	return byUser
+}
+
+// workspaceGroupUserIDs lists the user IDs in a set, sorted so the members
+// are added and removed in a steady order.
+func workspaceGroupUserIDs(set types.Set) []string {
+	userIDs := make([]string, 0, len(set.Elements()))
+	for _, v := range set.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			userIDs = append(userIDs, s.ValueString())
+		}
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
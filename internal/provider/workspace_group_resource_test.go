@@ -0,0 +1,196 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const (
+	testGroupRoleViewer = "0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9"
+	testGroupRoleAdmin  = "9e8d7c6b-5a49-4837-a625-14f3e2d1c0b9"
+	testGroupUserDillon = "11111111-2222-4333-8444-555555555555"
+	testGroupUserKitty  = "66666666-7777-4888-8999-aaaaaaaaaaaa"
+	testGroupUserDoc    = "bbbbbbbb-cccc-4ddd-8eee-ffffffffffff"
+)
+
+// testWorkspaceGroupServer stands in for the workspace members API, holding
+// the roster keyed by member ID.
+type testWorkspaceGroupServer struct {
+	members map[string]workspaceMemberAPIResponse
+	nextID  int
+}
+
+func (s *testWorkspaceGroupServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const base = "/api/v1/workspaces/current/members"
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == base:
+			list := workspaceMemberListAPIResponse{Members: []workspaceMemberAPIResponse{}}
+			if r.URL.Query().Get("offset") == "0" {
+				for _, m := range s.members {
+					list.Members = append(list.Members, m)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodPost && r.URL.Path == base:
+			var body workspaceMemberCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			s.nextID++
+			id := fmt.Sprintf("m%d", s.nextID)
+			s.members[id] = workspaceMemberAPIResponse{ID: id, UserID: body.UserID, RoleID: body.RoleID}
+			_, _ = fmt.Fprintf(w, `{"id": %q}`, id)
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, base+"/"):
+			id := strings.TrimPrefix(r.URL.Path, base+"/")
+			var body workspaceMemberUpdateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			m := s.members[id]
+			m.RoleID = body.RoleID
+			s.members[id] = m
+			_ = json.NewEncoder(w).Encode(m)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, base+"/"):
+			delete(s.members, strings.TrimPrefix(r.URL.Path, base+"/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+// roles reports each member's role, keyed by user ID.
+func (s *testWorkspaceGroupServer) roles() map[string]string {
+	roles := map[string]string{}
+	for _, m := range s.members {
+		roles[m.UserID] = m.RoleID
+	}
+	return roles
+}
+
+func testWorkspaceGroupUsers(userIDs ...string) types.Set {
+	set, _ := types.SetValueFrom(context.Background(), types.StringType, userIDs)
+	return set
+}
+
+// TestWorkspaceGroupResource_membership walks a group through create, drift,
+// update and delete, checking the roster follows the group each time.
+func TestWorkspaceGroupResource_membership(t *testing.T) {
+	srv := &testWorkspaceGroupServer{members: map[string]workspaceMemberAPIResponse{
+		"m0": {ID: "m0", UserID: testGroupUserKitty, RoleID: testGroupRoleAdmin},
+	}}
+	ts := httptest.NewServer(srv.handler(t))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &WorkspaceGroupResource{client: client.NewClient(ts.URL, "key", "")}
+	emptyState := func(s tfsdk.State) tfsdk.State {
+		return tfsdk.State{Schema: s.Schema, Raw: tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)}
+	}
+
+	planned := WorkspaceGroupResourceModel{
+		ID:        types.StringUnknown(),
+		Name:      types.StringValue("posse"),
+		RoleID:    types.StringValue(testGroupRoleViewer),
+		UserIDs:   testWorkspaceGroupUsers(testGroupUserDillon, testGroupUserKitty),
+		MemberIDs: types.MapUnknown(types.StringType),
+	}
+	plan := testResourceState(t, r, &planned)
+	createResp := &resource.CreateResponse{State: emptyState(plan)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("creating: %v", createResp.Diagnostics)
+	}
+	if roles := srv.roles(); len(roles) != 2 || roles[testGroupUserDillon] != testGroupRoleViewer || roles[testGroupUserKitty] != testGroupRoleViewer {
+		t.Fatalf("expected both users in the workspace as viewers, got %v", roles)
+	}
+	var got WorkspaceGroupResourceModel
+	createResp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "posse" || len(got.MemberIDs.Elements()) != 2 || got.MemberIDs.Elements()[testGroupUserKitty].String() != `"m0"` {
+		t.Errorf("unexpected group %+v", got)
+	}
+
+	// Someone promotes Kitty and removes Dillon behind Terraform's back.
+	for id, m := range srv.members {
+		switch m.UserID {
+		case testGroupUserKitty:
+			m.RoleID = testGroupRoleAdmin
+			srv.members[id] = m
+		case testGroupUserDillon:
+			delete(srv.members, id)
+		}
+	}
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	readResp.State.Get(ctx, &got)
+	if !got.UserIDs.Equal(testWorkspaceGroupUsers(testGroupUserKitty)) || got.RoleID.ValueString() != testGroupRoleAdmin {
+		t.Errorf("expected the departed member and changed role to show as drift, got %s with role %s", got.UserIDs, got.RoleID)
+	}
+
+	updated := planned
+	updated.UserIDs = testWorkspaceGroupUsers(testGroupUserDoc)
+	updatePlan := testResourceState(t, r, &updated)
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(updatePlan), State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("updating: %v", updateResp.Diagnostics)
+	}
+	if roles := srv.roles(); len(roles) != 1 || roles[testGroupUserDoc] != testGroupRoleViewer {
+		t.Errorf("expected Doc alone as a viewer, got %v", roles)
+	}
+
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &resource.DeleteResponse{})
+	if len(srv.members) != 0 {
+		t.Errorf("expected the group's members to leave the workspace, got %v", srv.roles())
+	}
+}
+
+// TestWorkspaceGroupResource_import checks an imported group takes in every
+// member holding the role.
+func TestWorkspaceGroupResource_import(t *testing.T) {
+	srv := &testWorkspaceGroupServer{members: map[string]workspaceMemberAPIResponse{
+		"m1": {ID: "m1", UserID: testGroupUserDillon, RoleID: testGroupRoleViewer},
+		"m2": {ID: "m2", UserID: testGroupUserKitty, RoleID: testGroupRoleAdmin},
+		"m3": {ID: "m3", UserID: testGroupUserDoc, RoleID: testGroupRoleViewer},
+	}}
+	ts := httptest.NewServer(srv.handler(t))
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &WorkspaceGroupResource{client: client.NewClient(ts.URL, "key", "")}
+	imported := testImportState(t, r, testGroupRoleViewer+"/viewers")
+	if imported.Diagnostics.HasError() {
+		t.Fatalf("importing: %v", imported.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: imported.State}
+	r.Read(ctx, resource.ReadRequest{State: imported.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("reading: %v", readResp.Diagnostics)
+	}
+	var got WorkspaceGroupResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.Name.ValueString() != "viewers" || !got.UserIDs.Equal(testWorkspaceGroupUsers(testGroupUserDillon, testGroupUserDoc)) {
+		t.Errorf("expected the viewers group, got %+v", got)
+	}
+
+	if bad := testImportState(t, r, "viewers"); !bad.Diagnostics.HasError() {
+		t.Error("expected an import ID without a role to be refused")
+	}
+}
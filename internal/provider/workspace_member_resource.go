@@ -5,8 +5,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,8 +22,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &WorkspaceMemberResource{}
-	_ resource.ResourceWithImportState = &WorkspaceMemberResource{}
+	_ resource.Resource                     = &WorkspaceMemberResource{}
+	_ resource.ResourceWithImportState      = &WorkspaceMemberResource{}
+	_ resource.ResourceWithModifyPlan       = &WorkspaceMemberResource{}
+	_ resource.ResourceWithConfigValidators = &WorkspaceMemberResource{}
 )
 
 // NewWorkspaceMemberResource returns a new WorkspaceMemberResource -- ready to
@@ -33,17 +38,19 @@ func NewWorkspaceMemberResource() resource.Resource {
 // needs good hands, and this resource handles who's on the roster and what
 // badge they wear.
 type WorkspaceMemberResource struct {
-	client *client.Client
+	client        *client.Client
+	defaultRoleID string
 }
 
 // WorkspaceMemberResourceModel describes the Terraform state for a workspace member.
 type WorkspaceMemberResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	UserID    types.String `tfsdk:"user_id"`
-	RoleID    types.String `tfsdk:"role_id"`
-	Email     types.String `tfsdk:"email"`
-	FullName  types.String `tfsdk:"full_name"`
-	CreatedAt types.String `tfsdk:"created_at"`
+	ID          types.String `tfsdk:"id"`
+	UserID      types.String `tfsdk:"user_id"`
+	RoleID      types.String `tfsdk:"role_id"`
+	Email       types.String `tfsdk:"email"`
+	FullName    types.String `tfsdk:"full_name"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
 }
 
 // workspaceMemberCreateRequest is the summons to bring a new member into the
@@ -90,6 +97,7 @@ func (r *WorkspaceMemberResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a LangSmith workspace member.",
 		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier of the workspace member (identity_id).",
 				Computed:            true,
@@ -98,19 +106,27 @@ func (r *WorkspaceMemberResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"user_id": schema.StringAttribute{
-				MarkdownDescription: "The user ID of the member to add to the workspace.",
-				Required:            true,
+				MarkdownDescription: "The user ID of the member to add to the workspace. Exactly one of `user_id` or `email` must be set.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"role_id": schema.StringAttribute{
-				MarkdownDescription: "The role ID to assign to the member.",
-				Required:            true,
+				MarkdownDescription: "The role ID to assign to the member. Falls back to the provider's `default_role_id` when unset; one of the two must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "The email address of the member.",
+				MarkdownDescription: "The email address of the member. Set it instead of `user_id` to add an organization member by email; it's resolved to a user ID when the member is created.",
+				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"full_name": schema.StringAttribute{
 				MarkdownDescription: "The member's full name.",
@@ -129,16 +145,123 @@ func (r *WorkspaceMemberResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.client
+	r.defaultRoleID = data.defaultRoleID
+}
+
+func (r *WorkspaceMemberResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("user_id"), path.MatchRoot("email")),
+	}
+}
+
+// resolveUserID finds the user behind an email on the organization roster,
+// a page at a time, and stops at the first page holding a match. Only hands
+// who've accepted their invite have a user ID to add.
+func (r *WorkspaceMemberResource) resolveUserID(ctx context.Context, email string) (string, error) {
+	var matches []string
+	err := r.client.GetAllPages(ctx, "/api/v1/orgs/current/members", nil, func(page json.RawMessage) (int, error) {
+		var roster organizationMembersAPIResponse
+		if err := json.Unmarshal(page, &roster); err != nil {
+			return 0, err
+		}
+		for _, m := range roster.Members {
+			if strings.EqualFold(m.Email, email) {
+				matches = append(matches, m.UserID)
+			}
+		}
+		if len(matches) > 0 {
+			return 0, client.ErrStopPaging
+		}
+		return len(roster.Members) + len(roster.Pending), nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("listing organization members: %w", err)
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no organization member has the email %q; invite them to the organization first", email)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%d organization members have the email %q; set user_id instead", len(matches), email)
+	}
+}
+
+// listWorkspaceMembers calls roll on the bunkhouse a page at a time, since
+// there's no single-member endpoint. When found is set, the ride ends on the
+// first page holding a member it picks out.
+func listWorkspaceMembers(ctx context.Context, c *client.Client, found func(*workspaceMemberAPIResponse) bool) ([]workspaceMemberAPIResponse, error) {
+	var members []workspaceMemberAPIResponse
+	err := c.GetAllPages(ctx, "/api/v1/workspaces/current/members", nil, func(page json.RawMessage) (int, error) {
+		var listResult workspaceMemberListAPIResponse
+		if err := json.Unmarshal(page, &listResult); err != nil {
+			return 0, err
+		}
+		members = append(members, listResult.Members...)
+
+		if found != nil {
+			for i := range listResult.Members {
+				if found(&listResult.Members[i]) {
+					return 0, client.ErrStopPaging
+				}
+			}
+		}
+		return len(listResult.Members), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// findWorkspaceMember pages through the roster for the member with the ID,
+// returning nil when they're not on it.
+func (r *WorkspaceMemberResource) findWorkspaceMember(ctx context.Context, id string) (*workspaceMemberAPIResponse, error) {
+	byID := func(m *workspaceMemberAPIResponse) bool { return m.ID == id }
+
+	members, err := listWorkspaceMembers(ctx, r.client, byID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if byID(&members[i]) {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// findWorkspaceMemberByEmail picks the one workspace member with the email,
+// for imports that only know who they're looking for by address.
+func findWorkspaceMemberByEmail(members []workspaceMemberAPIResponse, email string) (*workspaceMemberAPIResponse, error) {
+	var found *workspaceMemberAPIResponse
+	count := 0
+	for i := range members {
+		if strings.EqualFold(members[i].Email, email) {
+			found = &members[i]
+			count++
+		}
+	}
+
+	switch count {
+	case 0:
+		return nil, fmt.Errorf("no workspace member has the email %q", email)
+	case 1:
+		return found, nil
+	default:
+		return nil, fmt.Errorf("%d workspace members have the email %q; import by member ID instead", count, email)
+	}
 }
 
 func (r *WorkspaceMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -148,13 +271,24 @@ func (r *WorkspaceMemberResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	if data.UserID.IsNull() || data.UserID.IsUnknown() {
+		userID, err := r.resolveUserID(ctx, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "Error resolving member email", err.Error())
+			return
+		}
+		data.UserID = types.StringValue(userID)
+	}
+
 	body := workspaceMemberCreateRequest{
 		UserID: data.UserID.ValueString(),
 		RoleID: data.RoleID.ValueString(),
 	}
 
 	var createResult workspaceMemberCreateResponse
-	err := r.client.Post(ctx, "/api/v1/workspaces/current/members", body, &createResult)
+	err := r.client.Create(ctx, "/api/v1/workspaces/current/members", body, &createResult)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating workspace member", err.Error())
 		return
@@ -164,21 +298,12 @@ func (r *WorkspaceMemberResource) Create(ctx context.Context, req resource.Creat
 	// this cowhand. Now we ride back to the roster for the full picture.
 	data.ID = types.StringValue(createResult.ID)
 
-	var listResult workspaceMemberListAPIResponse
-	err = r.client.Get(ctx, "/api/v1/workspaces/current/members", nil, &listResult)
+	found, err := r.findWorkspaceMember(ctx, createResult.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading workspace member after create", err.Error())
 		return
 	}
 
-	var found *workspaceMemberAPIResponse
-	for i := range listResult.Members {
-		if listResult.Members[i].ID == createResult.ID {
-			found = &listResult.Members[i]
-			break
-		}
-	}
-
 	if found == nil {
 		resp.Diagnostics.AddError(
 			"Error reading workspace member after create",
@@ -200,20 +325,30 @@ func (r *WorkspaceMemberResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// No single-member endpoint -- we have to call roll on the whole bunkhouse.
-	var listResult workspaceMemberListAPIResponse
-	err := r.client.Get(ctx, "/api/v1/workspaces/current/members", nil, &listResult)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// An import by email hasn't got a member ID yet; find them by address,
+	// and say so plainly if the address doesn't pick out exactly one.
+	if data.ID.IsNull() {
+		members, err := listWorkspaceMembers(ctx, r.client, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+			return
+		}
+		found, err := findWorkspaceMemberByEmail(members, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error importing workspace member", err.Error())
+			return
+		}
+		mapWorkspaceMemberResponseToState(&data, found)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
-	var found *workspaceMemberAPIResponse
-	for i := range listResult.Members {
-		if listResult.Members[i].ID == data.ID.ValueString() {
-			found = &listResult.Members[i]
-			break
-		}
+	found, err := r.findWorkspaceMember(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace members", err.Error())
+		return
 	}
 
 	if found == nil {
@@ -234,6 +369,8 @@ func (r *WorkspaceMemberResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	body := workspaceMemberUpdateRequest{
 		RoleID: data.RoleID.ValueString(),
 	}
@@ -258,6 +395,8 @@ func (r *WorkspaceMemberResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
 	err := r.client.Delete(ctx, "/api/v1/workspaces/current/members/"+data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting workspace member", err.Error())
@@ -268,9 +407,46 @@ func (r *WorkspaceMemberResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *WorkspaceMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	// Import by member ID, or by email -- Read looks the address up on the roster.
+	if strings.Contains(req.ID, "@") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+		return
+	}
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ModifyPlan fills in the provider's default_role_id for members that don't
+// name a role of their own. A role set on the resource always wins.
+func (r *WorkspaceMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var roleID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role_id"), &roleID)...)
+	if resp.Diagnostics.HasError() || !roleID.IsNull() {
+		return
+	}
+
+	// Without a configured client the provider settings aren't known yet;
+	// the check runs again at apply time.
+	if r.client == nil {
+		return
+	}
+
+	if r.defaultRoleID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_id"),
+			"Missing Role",
+			"Set role_id on the workspace member, or default_role_id on the provider.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_id"), r.defaultRoleID)...)
+}
+
 // mapWorkspaceMemberResponseToState maps the API response onto Terraform state.
 // A good deputy keeps accurate records -- Kitty Russell would expect nothing less
 // from anyone working Front Street.
@@ -279,10 +455,12 @@ func mapWorkspaceMemberResponseToState(data *WorkspaceMemberResourceModel, resul
 	data.UserID = types.StringValue(result.UserID)
 	data.RoleID = types.StringValue(result.RoleID)
 
-	if result.Email != "" {
-		data.Email = types.StringValue(result.Email)
-	} else {
+	// Keep the email as configured when the API only differs in case.
+	switch {
+	case result.Email == "":
 		data.Email = types.StringNull()
+	case !strings.EqualFold(data.Email.ValueString(), result.Email):
+		data.Email = types.StringValue(result.Email)
 	}
 
 	if result.FullName != nil {
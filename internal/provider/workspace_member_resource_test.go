@@ -4,7 +4,23 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
 )
 
 // TestAccWorkspaceMemberResource_basic invites a new hand to the outfit and
@@ -15,3 +31,333 @@ import (
 func TestAccWorkspaceMemberResource_basic(t *testing.T) {
 	t.Skip("Requires a second user and team/enterprise tier to add workspace members")
 }
+
+// TestWorkspaceMemberResource_defaultRoleID checks the provider's default role
+// fills in for a member without one, and never overrides a role that's set.
+func TestWorkspaceMemberResource_defaultRoleID(t *testing.T) {
+	member := func(roleID types.String) *WorkspaceMemberResourceModel {
+		return &WorkspaceMemberResourceModel{
+			ID:        types.StringUnknown(),
+			UserID:    types.StringValue("u1"),
+			RoleID:    roleID,
+			Email:     types.StringUnknown(),
+			FullName:  types.StringUnknown(),
+			CreatedAt: types.StringUnknown(),
+		}
+	}
+
+	cases := map[string]struct {
+		defaultRoleID string
+		roleID        types.String
+		want          string
+		wantErr       bool
+	}{
+		"resource role wins":  {defaultRoleID: "viewer", roleID: types.StringValue("admin"), want: "admin"},
+		"falls back":          {defaultRoleID: "viewer", roleID: types.StringNull(), want: "viewer"},
+		"resource role only":  {roleID: types.StringValue("admin"), want: "admin"},
+		"neither is an error": {roleID: types.StringNull(), wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &WorkspaceMemberResource{}
+			r.Configure(context.Background(), resource.ConfigureRequest{
+				ProviderData: &providerData{client: client.NewClient("http://localhost", "key", ""), defaultRoleID: tc.defaultRoleID},
+			}, &resource.ConfigureResponse{})
+
+			resp := testModifyPlan(t, r, member(tc.roleID))
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("expected error=%t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			var planned types.String
+			resp.Plan.GetAttribute(context.Background(), path.Root("role_id"), &planned)
+			if planned.ValueString() != tc.want {
+				t.Errorf("expected role_id %q, got %s", tc.want, planned)
+			}
+		})
+	}
+}
+
+// TestWorkspaceMemberResource_userOrEmail checks a member names exactly one
+// of user_id or email.
+func TestWorkspaceMemberResource_userOrEmail(t *testing.T) {
+	cases := map[string]struct {
+		attrs   map[string]tftypes.Value
+		wantErr bool
+	}{
+		"user_id": {attrs: map[string]tftypes.Value{"user_id": tftypes.NewValue(tftypes.String, "u1")}},
+		"email":   {attrs: map[string]tftypes.Value{"email": tftypes.NewValue(tftypes.String, "matt@dodgecity.gov")}},
+		"both": {attrs: map[string]tftypes.Value{
+			"user_id": tftypes.NewValue(tftypes.String, "u1"),
+			"email":   tftypes.NewValue(tftypes.String, "matt@dodgecity.gov"),
+		}, wantErr: true},
+		"neither": {attrs: map[string]tftypes.Value{}, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.attrs["role_id"] = tftypes.NewValue(tftypes.String, "r1")
+			diags := testValidateResourceConfig(t, "langsmith_workspace_member", tc.attrs)
+			if got := testDiagnosticsHaveSeverity(diags, tfprotov6.DiagnosticSeverityError, ""); got != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+// testWorkspaceMemberServer answers for the organization and workspace
+// rosters, recording the user ID each new member is added with.
+func testWorkspaceMemberServer(t *testing.T, added *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/orgs/current/members":
+			_, _ = w.Write([]byte(`{
+				"members": [
+					{"id": "o1", "user_id": "u1", "email": "Matt@DodgeCity.gov"},
+					{"id": "o2", "user_id": "u2", "email": "festus@dodgecity.gov"},
+					{"id": "o3", "user_id": "u3", "email": "festus@dodgecity.gov"}
+				],
+				"pending": [{"id": "o4", "email": "chester@dodgecity.gov"}]
+			}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/workspaces/current/members":
+			var body workspaceMemberCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			*added = body.UserID
+			_, _ = w.Write([]byte(`{"id": "m1"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/workspaces/current/members":
+			_, _ = w.Write([]byte(`{"members": [
+				{"id": "m1", "user_id": "u1", "email": "Matt@DodgeCity.gov", "role_id": "r1"},
+				{"id": "m2", "user_id": "u2", "email": "festus@dodgecity.gov", "role_id": "r1"},
+				{"id": "m3", "user_id": "u3", "email": "festus@dodgecity.gov", "role_id": "r1"}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// TestWorkspaceMemberResource_createByEmail checks an email is resolved to
+// the one organization member wearing it, and refused when it picks out
+// nobody or more than one.
+func TestWorkspaceMemberResource_createByEmail(t *testing.T) {
+	cases := map[string]struct {
+		email   string
+		wantErr string
+	}{
+		"resolves ignoring case": {email: "matt@dodgecity.gov"},
+		"nobody":                 {email: "doc@dodgecity.gov", wantErr: "no organization member"},
+		"pending invite":         {email: "chester@dodgecity.gov", wantErr: "no organization member"},
+		"more than one":          {email: "festus@dodgecity.gov", wantErr: "2 organization members"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added string
+			ts := testWorkspaceMemberServer(t, &added)
+			defer ts.Close()
+
+			r := &WorkspaceMemberResource{client: client.NewClient(ts.URL, "key", "")}
+			plan := testResourceState(t, r, &WorkspaceMemberResourceModel{
+				ID:        types.StringUnknown(),
+				UserID:    types.StringUnknown(),
+				RoleID:    types.StringValue("r1"),
+				Email:     types.StringValue(tc.email),
+				FullName:  types.StringUnknown(),
+				CreatedAt: types.StringUnknown(),
+			})
+			resp := &resource.CreateResponse{State: tfsdk.State{
+				Schema: plan.Schema,
+				Raw:    tftypes.NewValue(plan.Schema.Type().TerraformType(context.Background()), nil),
+			}}
+			r.Create(context.Background(), resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+
+			if tc.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), tc.wantErr) {
+					t.Fatalf("expected an error mentioning %q, got %v", tc.wantErr, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("creating: %v", resp.Diagnostics)
+			}
+
+			var got WorkspaceMemberResourceModel
+			resp.State.Get(context.Background(), &got)
+			if added != "u1" || got.UserID.ValueString() != "u1" || got.ID.ValueString() != "m1" {
+				t.Errorf("expected user u1 added as m1, got %q and %+v", added, got)
+			}
+			if got.Email.ValueString() != tc.email {
+				t.Errorf("expected the configured email to be kept, got %q", got.Email.ValueString())
+			}
+		})
+	}
+}
+
+// TestWorkspaceMemberResource_resolveUserIDPaginates checks an email is
+// looked up past the first page of the organization roster, and that the
+// search stops on the page that holds it.
+func TestWorkspaceMemberResource_resolveUserIDPaginates(t *testing.T) {
+	const total = client.DefaultPageSize*2 + 50
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		roster := organizationMembersAPIResponse{Members: []organizationMemberAPIResponse{}}
+		for i := offset; i < offset+limit && i < total; i++ {
+			roster.Members = append(roster.Members, organizationMemberAPIResponse{
+				UserID: fmt.Sprintf("u%d", i),
+				Email:  fmt.Sprintf("deputy%d@dodgecity.gov", i),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(roster)
+	}))
+	defer ts.Close()
+
+	r := &WorkspaceMemberResource{client: client.NewClient(ts.URL, "key", "")}
+	userID, err := r.resolveUserID(context.Background(), "Deputy150@dodgecity.gov")
+	if err != nil || userID != "u150" {
+		t.Fatalf("expected u150, got %q, %v", userID, err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the search to stop on the second page, got %d requests", requests)
+	}
+
+	requests = 0
+	if _, err := r.resolveUserID(context.Background(), "doc@dodgecity.gov"); err == nil {
+		t.Error("expected an error for an email on no page")
+	}
+	if requests != 4 {
+		t.Errorf("expected every page and the empty one past it to be read, got %d requests", requests)
+	}
+}
+
+// TestWorkspaceMemberResource_importByEmail checks an import by email finds
+// the member on the workspace roster, and errors rather than guessing.
+func TestWorkspaceMemberResource_importByEmail(t *testing.T) {
+	cases := map[string]struct {
+		email   string
+		wantID  string
+		wantErr string
+	}{
+		"found":         {email: "matt@dodgecity.gov", wantID: "m1"},
+		"nobody":        {email: "doc@dodgecity.gov", wantErr: "no workspace member"},
+		"more than one": {email: "festus@dodgecity.gov", wantErr: "2 workspace members"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added string
+			ts := testWorkspaceMemberServer(t, &added)
+			defer ts.Close()
+
+			r := &WorkspaceMemberResource{client: client.NewClient(ts.URL, "key", "")}
+			imported := testResourceState(t, r, &WorkspaceMemberResourceModel{
+				ID:        types.StringNull(),
+				UserID:    types.StringNull(),
+				RoleID:    types.StringNull(),
+				Email:     types.StringNull(),
+				FullName:  types.StringNull(),
+				CreatedAt: types.StringNull(),
+			})
+			importResp := &resource.ImportStateResponse{State: imported}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: tc.email}, importResp)
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("importing: %v", importResp.Diagnostics)
+			}
+
+			resp := &resource.ReadResponse{State: importResp.State}
+			r.Read(context.Background(), resource.ReadRequest{State: importResp.State}, resp)
+
+			if tc.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), tc.wantErr) {
+					t.Fatalf("expected an error mentioning %q, got %v", tc.wantErr, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading: %v", resp.Diagnostics)
+			}
+
+			var got WorkspaceMemberResourceModel
+			resp.State.Get(context.Background(), &got)
+			if got.ID.ValueString() != tc.wantID || got.UserID.ValueString() != "u1" || got.RoleID.ValueString() != "r1" {
+				t.Errorf("expected member %s, got %+v", tc.wantID, got)
+			}
+		})
+	}
+}
+
+// TestWorkspaceMemberResource_readPaginates checks a member far down a big
+// roster is still found, and the roll call stops once they answer.
+func TestWorkspaceMemberResource_readPaginates(t *testing.T) {
+	const total = client.DefaultPageSize*2 + 10
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		resp := workspaceMemberListAPIResponse{Members: []workspaceMemberAPIResponse{}}
+		for i := offset; i < offset+limit && i < total; i++ {
+			resp.Members = append(resp.Members, workspaceMemberAPIResponse{
+				ID:     fmt.Sprintf("m%d", i),
+				UserID: fmt.Sprintf("u%d", i),
+				Email:  fmt.Sprintf("hand%d@dodgecity.gov", i),
+				RoleID: "r1",
+			})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer ts.Close()
+
+	r := &WorkspaceMemberResource{client: client.NewClient(ts.URL, "key", "")}
+	read := func(id string) *resource.ReadResponse {
+		state := testResourceState(t, r, &WorkspaceMemberResourceModel{
+			ID:        types.StringValue(id),
+			UserID:    types.StringValue("u"),
+			RoleID:    types.StringValue("r1"),
+			Email:     types.StringNull(),
+			FullName:  types.StringNull(),
+			CreatedAt: types.StringNull(),
+		})
+		resp := &resource.ReadResponse{State: state}
+		r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("reading %s: %v", id, resp.Diagnostics)
+		}
+		return resp
+	}
+
+	last := fmt.Sprintf("m%d", total-1)
+	resp := read(last)
+	var got WorkspaceMemberResourceModel
+	resp.State.Get(context.Background(), &got)
+	if got.ID.ValueString() != last || got.UserID.ValueString() != fmt.Sprintf("u%d", total-1) {
+		t.Errorf("expected the last member to stay in state, got %+v", got)
+	}
+	if requests != 3 {
+		t.Errorf("expected three pages to be read, got %d", requests)
+	}
+
+	requests = 0
+	read("m5")
+	if requests != 1 {
+		t.Errorf("expected the roll call to stop once found, got %d requests", requests)
+	}
+
+	requests = 0
+	if resp := read("gone"); !resp.State.Raw.IsNull() {
+		t.Error("expected a member missing from every page to be removed from state")
+	}
+	if requests != 4 {
+		t.Errorf("expected every page and the empty one past it to be read before giving up, got %d requests", requests)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// workspaceRoleScope is the access scope of a workspace role, and of the
+// permissions it can grant.
+const workspaceRoleScope = "workspace"
+
+var (
+	_ resource.Resource                = &WorkspaceRoleResource{}
+	_ resource.ResourceWithImportState = &WorkspaceRoleResource{}
+	_ resource.ResourceWithModifyPlan  = &WorkspaceRoleResource{}
+)
+
+// NewWorkspaceRoleResource returns a new WorkspaceRoleResource -- the badge a
+// deputy wears inside one workspace, as opposed to across the whole territory.
+func NewWorkspaceRoleResource() resource.Resource {
+	return &WorkspaceRoleResource{}
+}
+
+// WorkspaceRoleResource manages workspace-scoped roles, the kind handed to
+// service keys and workspace members through their role_id.
+type WorkspaceRoleResource struct {
+	client *client.Client
+}
+
+// WorkspaceRoleResourceModel describes the Terraform state for a workspace role.
+type WorkspaceRoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	Name        types.String `tfsdk:"name"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+// workspaceRoleRequest is sent to create or update a workspace role.
+type workspaceRoleRequest struct {
+	DisplayName string   `json:"display_name"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	AccessScope string   `json:"access_scope"`
+}
+
+func (r *WorkspaceRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_role"
+}
+
+func (r *WorkspaceRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a LangSmith workspace role for RBAC. Workspace roles are what `langsmith_workspace_member` and `langsmith_service_key` take as `role_id`; organization-wide roles are managed with `langsmith_org_role`.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": workspaceIDResourceAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the role, used as `role_id` elsewhere.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the role.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the role.",
+				Optional:            true,
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "The permissions granted by the role, such as `projects:read`. Each must be a workspace-scoped permission from the organization's catalog, checked at plan time; the `langsmith_organization_role` data source lists them with their scope.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The internal name of the role.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WorkspaceRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.providerData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.client
+}
+
+// request builds the create or update body from the plan.
+func (r *WorkspaceRoleResource) request(data *WorkspaceRoleResourceModel) workspaceRoleRequest {
+	return workspaceRoleRequest{
+		DisplayName: data.DisplayName.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Permissions: orgRolePermissionNames(data.Permissions),
+		AccessScope: workspaceRoleScope,
+	}
+}
+
+func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkspaceRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result orgRoleAPIResponse
+	err := r.client.Create(ctx, "/api/v1/workspaces/current/roles", r.request(&data), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating workspace role", err.Error())
+		return
+	}
+
+	// permissions isn't computed; keep it as planned so apply stays consistent.
+	permissions := data.Permissions
+	mapWorkspaceRoleResponseToState(&data, &result)
+	data.Permissions = permissions
+	tflog.Trace(ctx, "created workspace role resource", map[string]interface{}{"id": result.ID})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkspaceRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	// Like organization roles, workspace roles are only listed, never looked
+	// up one at a time.
+	var roles orgRoleListAPIResponse
+	err := r.client.Get(ctx, "/api/v1/workspaces/current/roles", nil, &roles)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace roles", err.Error())
+		return
+	}
+
+	var found *orgRoleAPIResponse
+	for i := range roles {
+		if roles[i].ID == data.ID.ValueString() {
+			found = &roles[i]
+			break
+		}
+	}
+	if found == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapWorkspaceRoleResponseToState(&data, found)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorkspaceRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	var result orgRoleAPIResponse
+	err := r.client.Patch(ctx, "/api/v1/workspaces/current/roles/"+data.ID.ValueString(), r.request(&data), &result)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating workspace role", err.Error())
+		return
+	}
+
+	permissions := data.Permissions
+	mapWorkspaceRoleResponseToState(&data, &result)
+	data.Permissions = permissions
+	tflog.Trace(ctx, "updated workspace role resource", map[string]interface{}{"id": data.ID.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkspaceRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = withWorkspace(ctx, data.WorkspaceID)
+
+	err := r.client.Delete(ctx, "/api/v1/workspaces/current/roles/"+data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting workspace role", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "deleted workspace role resource", map[string]interface{}{"id": data.ID.ValueString()})
+}
+
+// ModifyPlan checks each permission is in the organization's catalog and can
+// be granted inside a workspace.
+func (r *WorkspaceRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var permissions types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	if resp.Diagnostics.HasError() || permissions.IsNull() || permissions.IsUnknown() {
+		return
+	}
+
+	checkRolePermissions(ctx, r.client, path.Root("permissions"), orgRolePermissionNames(permissions), workspaceRoleScope, &resp.Diagnostics)
+}
+
+func (r *WorkspaceRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, req.ID = importWorkspaceID(ctx, req.ID, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapWorkspaceRoleResponseToState maps the API's role onto the Terraform state.
+func mapWorkspaceRoleResponseToState(data *WorkspaceRoleResourceModel, result *orgRoleAPIResponse) {
+	data.ID = types.StringValue(result.ID)
+	data.DisplayName = types.StringValue(result.DisplayName)
+	data.Name = types.StringValue(result.Name)
+
+	if result.Description != "" {
+		data.Description = types.StringValue(result.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	if names, err := permissionNames(result.Permissions); err == nil {
+		data.Permissions = orgRolePermissionSet(names)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// testWorkspaceRoleServer serves a permission catalog with both scopes and a
+// workspace role roster, recording the last role written.
+func testWorkspaceRoleServer(t *testing.T, written *workspaceRoleRequest) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/orgs/permissions":
+			_, _ = w.Write([]byte(`[
+				{"name": "projects:read", "access_scope": "workspace"},
+				{"name": "datasets:create", "access_scope": "workspace"},
+				{"name": "organization:manage", "access_scope": "organization"}
+			]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/workspaces/current/roles":
+			_ = json.NewDecoder(r.Body).Decode(written)
+			_, _ = w.Write([]byte(`{"id": "wr1", "name": "CUSTOM", "display_name": "Deputy", "permissions": ["projects:read", "datasets:create"], "access_scope": "workspace"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/workspaces/current/roles":
+			_, _ = w.Write([]byte(`[
+				{"id": "wr0", "name": "WORKSPACE_ADMIN", "display_name": "Admin", "permissions": ["projects:read"], "access_scope": "workspace"},
+				{"id": "wr1", "name": "CUSTOM", "display_name": "Deputy", "description": "Keeps the peace", "permissions": ["datasets:create", "projects:read"], "access_scope": "workspace"}
+			]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// testWorkspaceRoleModel plans a workspace role granting the given permissions.
+func testWorkspaceRoleModel(permissions ...string) *WorkspaceRoleResourceModel {
+	return &WorkspaceRoleResourceModel{
+		ID:          types.StringUnknown(),
+		DisplayName: types.StringValue("Deputy"),
+		Description: types.StringNull(),
+		Permissions: testOrgRolePermissions(permissions...),
+		Name:        types.StringUnknown(),
+	}
+}
+
+// TestWorkspaceRoleResource_scope checks only workspace-scoped permissions
+// from the catalog make it through the plan.
+func TestWorkspaceRoleResource_scope(t *testing.T) {
+	ts := testWorkspaceRoleServer(t, &workspaceRoleRequest{})
+	defer ts.Close()
+	r := &WorkspaceRoleResource{client: client.NewClient(ts.URL, "key", "")}
+
+	if resp := testModifyPlan(t, r, testWorkspaceRoleModel("projects:read", "datasets:create")); resp.Diagnostics.HasError() {
+		t.Errorf("expected workspace permissions to plan, got %v", resp.Diagnostics)
+	}
+
+	resp := testModifyPlan(t, r, testWorkspaceRoleModel("projects:read", "organization:manage"))
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Permission Out Of Scope" {
+		t.Fatalf("expected an out of scope error, got %v", resp.Diagnostics)
+	}
+	if want := `Only workspace-scoped permissions can be granted here. These belong to another scope: "organization:manage" (organization).`; resp.Diagnostics[0].Detail() != want {
+		t.Errorf("unexpected detail %q", resp.Diagnostics[0].Detail())
+	}
+
+	resp = testModifyPlan(t, r, testWorkspaceRoleModel("projects:raed"))
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Unknown Permission" {
+		t.Errorf("expected an unknown permission error, got %v", resp.Diagnostics)
+	}
+}
+
+// TestWorkspaceRoleResource_createAndRead checks a role is created at
+// workspace scope with sorted permissions, and found again on the roster.
+func TestWorkspaceRoleResource_createAndRead(t *testing.T) {
+	var written workspaceRoleRequest
+	ts := testWorkspaceRoleServer(t, &written)
+	defer ts.Close()
+
+	ctx := context.Background()
+	r := &WorkspaceRoleResource{client: client.NewClient(ts.URL, "key", "")}
+
+	plan := testResourceState(t, r, testWorkspaceRoleModel("projects:read", "datasets:create"))
+	createResp := &resource.CreateResponse{State: plan}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+	if written.AccessScope != "workspace" || len(written.Permissions) != 2 || written.Permissions[0] != "datasets:create" {
+		t.Errorf("unexpected role written %+v", written)
+	}
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", readResp.Diagnostics)
+	}
+
+	var got WorkspaceRoleResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.ID.ValueString() != "wr1" || got.Name.ValueString() != "CUSTOM" || got.Description.ValueString() != "Keeps the peace" {
+		t.Errorf("unexpected state after read %+v", got)
+	}
+	if !got.Permissions.Equal(testOrgRolePermissions("projects:read", "datasets:create")) {
+		t.Errorf("unexpected permissions %s", got.Permissions)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+// workspaceIDResourceAttribute is the workspace_id attribute shared by every
+// workspace-scoped resource, letting one provider manage resources across
+// many workspaces.
+func workspaceIDResourceAttribute() rschema.StringAttribute {
+	return rschema.StringAttribute{
+		MarkdownDescription: "The ID of the workspace to manage this resource in, overriding the provider's `tenant_id`. Changing it forces a new resource. " +
+			"To import a resource from another workspace, prefix the import ID with the workspace ID and a colon: `<workspace_id>:<id>`.",
+		Optional: true,
+		Validators: []validator.String{
+			validUUID(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// workspaceIDDataSourceAttribute is workspace_id for data sources that read
+// from a workspace.
+func workspaceIDDataSourceAttribute() dschema.StringAttribute {
+	return dschema.StringAttribute{
+		MarkdownDescription: "The ID of the workspace to read from, overriding the provider's `tenant_id`.",
+		Optional:            true,
+		Validators: []validator.String{
+			validUUID(),
+		},
+	}
+}
+
+// withWorkspace sends every request made with the returned context to the
+// given workspace, or leaves the provider's tenant in charge when it's unset.
+func withWorkspace(ctx context.Context, workspaceID types.String) context.Context {
+	if workspaceID.IsNull() || workspaceID.IsUnknown() {
+		return ctx
+	}
+	return client.WithTenantID(ctx, workspaceID.ValueString())
+}
+
+// importWorkspaceID peels a leading "<workspace_id>:" off an import ID,
+// recording the workspace in state and scoping ctx to it, and hands back the
+// rest of the ID for the resource's own import. An ID without the prefix
+// comes back as it went in.
+func importWorkspaceID(ctx context.Context, id string, resp *resource.ImportStateResponse) (context.Context, string) {
+	workspaceID, rest, ok := strings.Cut(id, ":")
+	if !ok || !uuidRegexp.MatchString(workspaceID) {
+		return ctx, id
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	return client.WithTenantID(ctx, workspaceID), rest
+}
+
+// resolveTenantID fills in tenantID when the API's response didn't say which
+// workspace a resource landed in, asking the client for the workspace ctx's
+// requests go to.
+func resolveTenantID(ctx context.Context, c *client.Client, tenantID *types.String, diags *diag.Diagnostics) {
+	if !tenantID.IsNull() && !tenantID.IsUnknown() {
+		return
+	}
+
+	id, err := c.CurrentTenantID(ctx)
+	if err != nil {
+		diags.AddError("Error reading tenant ID", err.Error())
+		return
+	}
+	*tenantID = types.StringValue(id)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bogware/terraform-provider-langsmith/internal/client"
+)
+
+const testWorkspaceID = "3f6c1e2a-8b4d-4c7e-9a1f-2d3e4f5a6b7c"
+
+// TestWorkspaceScope_read checks a resource with workspace_id set talks to
+// that workspace, and one without it to the provider's.
+func TestWorkspaceScope_read(t *testing.T) {
+	var tenant string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenant = r.Header.Get("X-Tenant-Id")
+		_, _ = w.Write([]byte(`{"id": "tk1", "key": "env"}`))
+	}))
+	defer srv.Close()
+
+	r := &TagKeyResource{client: client.NewClient(srv.URL, "key", "provider-tenant")}
+	for workspaceID, want := range map[types.String]string{
+		types.StringValue(testWorkspaceID): testWorkspaceID,
+		types.StringNull():                 "provider-tenant",
+	} {
+		state := testResourceState(t, r, &TagKeyResourceModel{ID: types.StringValue("tk1"), WorkspaceID: workspaceID})
+		resp := &resource.ReadResponse{State: state}
+		r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("read: %v", resp.Diagnostics)
+		}
+		if tenant != want {
+			t.Errorf("%s: expected tenant %q, got %q", workspaceID, want, tenant)
+		}
+	}
+}
+
+// TestWorkspaceScope_import checks an import ID prefixed with a workspace ID
+// lands the resource in that workspace, and one without stays put.
+func TestWorkspaceScope_import(t *testing.T) {
+	ctx := context.Background()
+	r := &TagKeyResource{}
+
+	for id, want := range map[string]TagKeyResourceModel{
+		testWorkspaceID + ":tk1": {ID: types.StringValue("tk1"), WorkspaceID: types.StringValue(testWorkspaceID)},
+		"tk1":                    {ID: types.StringValue("tk1"), WorkspaceID: types.StringNull()},
+		"not-a-uuid:tk1":         {ID: types.StringValue("not-a-uuid:tk1"), WorkspaceID: types.StringNull()},
+	} {
+		resp := testImportState(t, r, id)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: import: %v", id, resp.Diagnostics)
+		}
+
+		var got TagKeyResourceModel
+		resp.State.Get(ctx, &got)
+		if !got.ID.Equal(want.ID) || !got.WorkspaceID.Equal(want.WorkspaceID) {
+			t.Errorf("%s: expected id %s in workspace %s, got %s in %s", id, want.ID, want.WorkspaceID, got.ID, got.WorkspaceID)
+		}
+	}
+}
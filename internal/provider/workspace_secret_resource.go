@@ -0,0 +1,39 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource                = &WorkspaceSecretResource{}
+	_ resource.ResourceWithImportState = &WorkspaceSecretResource{}
+)
+
+// NewWorkspaceSecretResource returns a new WorkspaceSecretResource -- the
+// same lockbox as langsmith_secret, hung out under the workspace's name.
+func NewWorkspaceSecretResource() resource.Resource {
+	return &WorkspaceSecretResource{}
+}
+
+// WorkspaceSecretResource manages a workspace secret, such as an LLM
+// provider key, as langsmith_workspace_secret. It rides the same trail as
+// SecretResource, and only answers to a different name.
+type WorkspaceSecretResource struct {
+	SecretResource
+}
+
+func (r *WorkspaceSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_secret"
+}
+
+func (r *WorkspaceSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.SecretResource.Schema(ctx, req, resp)
+	resp.Schema.MarkdownDescription = "Manages a LangSmith workspace secret, such as the `OPENAI_API_KEY` or `ANTHROPIC_API_KEY` the playground and LLM-as-judge evaluators use. " +
+		"The value is write-only: it's never read back from the API, so changes made outside Terraform don't show up as drift. " +
+		"This manages the same secrets as `langsmith_secret`; manage each key with only one of the two."
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Bogware, Inc. 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestWorkspaceSecretResource_lifecycle checks langsmith_workspace_secret
+// upserts its key, picks up the creation time, and holds the written value
+// through a refresh the API answers with key names only.
+func TestWorkspaceSecretResource_lifecycle(t *testing.T) {
+	secrets := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var items []secretDeleteItem
+			_ = json.NewDecoder(r.Body).Decode(&items)
+			for _, item := range items {
+				if item.Value == nil {
+					delete(secrets, item.Key)
+					continue
+				}
+				secrets[item.Key] = *item.Value
+			}
+		case http.MethodGet:
+			keys := []secretKeyResponse{}
+			for key := range secrets {
+				keys = append(keys, secretKeyResponse{Key: key, CreatedAt: "2025-06-01T00:00:00Z"})
+			}
+			_ = json.NewEncoder(w).Encode(keys)
+		}
+	}))
+	defer srv.Close()
+
+	config := map[string]tftypes.Value{
+		"key":   tftypes.NewValue(tftypes.String, "OPENAI_API_KEY"),
+		"value": tftypes.NewValue(tftypes.String, "sk-doc-adams"),
+	}
+	s := newTestResourceServer(t, srv.URL, "langsmith_workspace_secret")
+	s.apply(config)
+	if secrets["OPENAI_API_KEY"] != "sk-doc-adams" {
+		t.Fatalf("expected the secret to be upserted, got %v", secrets)
+	}
+	if !s.attribute("created_at").Equal(tftypes.NewValue(tftypes.String, "2025-06-01T00:00:00Z")) {
+		t.Errorf("expected the creation time, got %s", s.attribute("created_at"))
+	}
+
+	s.refresh()
+	if !s.planIsEmpty(config) || !s.attribute("value").Equal(config["value"]) {
+		t.Errorf("expected the written value to hold through a refresh, got %s", s.attribute("value"))
+	}
+
+	config["value"] = tftypes.NewValue(tftypes.String, "sk-kitty-russell")
+	s.apply(config)
+	if secrets["OPENAI_API_KEY"] != "sk-kitty-russell" {
+		t.Errorf("expected the new value to be upserted, got %v", secrets)
+	}
+}